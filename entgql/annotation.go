@@ -43,12 +43,54 @@ type (
 		RelayConnection bool `json:"RelayConnection,omitempty"`
 		// Implements defines a list of interfaces implemented by the type.
 		Implements []string `json:"Implements,omitempty"`
+		// Interfaces defines the interfaces that are generated for the type, and
+		// are implemented by it. Usually, they are defined by the type mixins.
+		Interfaces []InterfaceConfig `json:"Interfaces,omitempty"`
 		// Directives to add on the field/type.
 		Directives []Directive `json:"Directives,omitempty"`
 		// QueryField exposes the generated type with the given string under the Query object.
 		QueryField *FieldConfig `json:"QueryField,omitempty"`
 		// MutationInputs defines the input types for the mutation.
 		MutationInputs []MutationConfig `json:"MutationInputs,omitempty"`
+		// MutationFields exposes the create, update and delete mutations of the type under the Mutation object.
+		MutationFields *MutationFieldsConfig `json:"MutationFields,omitempty"`
+		// Subscriptions exposes the change events of the type under the Subscription object.
+		Subscriptions bool `json:"Subscriptions,omitempty"`
+		// MultiOrder indicates that the type's connections can be ordered by a list of fields.
+		MultiOrder bool `json:"MultiOrder,omitempty"`
+		// OrderEdgeCount exposes the count of the edge neighbors as an ordering field of the type.
+		OrderEdgeCount bool `json:"OrderEdgeCount,omitempty"`
+		// OrderEdgeFields exposes the given fields of the (unique) edge neighbor as ordering fields of the type.
+		OrderEdgeFields []string `json:"OrderEdgeFields,omitempty"`
+		// Aggregate exposes the aggregations of the type under the Query object.
+		Aggregate *FieldConfig `json:"Aggregate,omitempty"`
+		// GroupBy exposes the field as a value of the <T>GroupField enum.
+		GroupBy bool `json:"GroupBy,omitempty"`
+		// Authorize holds the rules that are checked by the AuthorizePolicy
+		// before accessing the type, field or edge.
+		Authorize []string `json:"Authorize,omitempty"`
+		// WhereOps defines additional operators of the field in the WhereInput.
+		WhereOps []WhereOp `json:"WhereOps,omitempty"`
+		// NestedCreate allows creating the edge neighbors in the mutation inputs of the type.
+		NestedCreate bool `json:"NestedCreate,omitempty"`
+		// OffsetPagination exposes the offset-based pagination of the type under the
+		// Query object, or defines the edge as an offset-paginated field.
+		OffsetPagination *FieldConfig `json:"OffsetPagination,omitempty"`
+		// AlwaysLoad indicates that the field is selected by the field collection,
+		// even if it was not requested by the GraphQL query.
+		AlwaysLoad bool `json:"AlwaysLoad,omitempty"`
+		// SoftDelete is the name of the field that marks the nodes of the type as
+		// deleted. Deleted nodes are excluded from the generated queries by default.
+		SoftDelete string `json:"SoftDelete,omitempty"`
+		// OptimisticLock is the name of the version field that guards the
+		// update mutations of the type from overriding concurrent changes.
+		OptimisticLock string `json:"OptimisticLock,omitempty"`
+		// Constraint holds the constraints of the field that are declared with
+		// the @constraint directive in the generated mutation inputs.
+		Constraint *ConstraintConfig `json:"Constraint,omitempty"`
+		// Profiles holds the names of the schema profiles in which the type, field
+		// or edge is visible. An empty list means it is visible in all profiles.
+		Profiles []string `json:"Profiles,omitempty"`
 	}
 
 	// Directive to apply on the field/type.
@@ -74,9 +116,58 @@ type (
 		Directives []Directive `json:"Directives,omitempty"`
 	}
 
+	// InterfaceConfig defines a GraphQL interface that is generated from
+	// the shared fields of the types that are annotated with it.
+	InterfaceConfig struct {
+		// Name is the name of the GraphQL interface.
+		Name string `json:"Name,omitempty"`
+		// Fields are the names of the ent fields that are declared by the interface.
+		Fields []string `json:"Fields,omitempty"`
+		// QueryField exposes the implementations of the interface
+		// as a Relay connection under the Query object.
+		QueryField *FieldConfig `json:"QueryField,omitempty"`
+	}
+
+	// ConstraintConfig holds the arguments of the @constraint directive of a field.
+	// A nil argument means the constraint is not declared.
+	ConstraintConfig struct {
+		// MinLength and MaxLength are the bounds of the length of a string field.
+		MinLength *int `json:"MinLength,omitempty"`
+		MaxLength *int `json:"MaxLength,omitempty"`
+		// Min and Max are the bounds of a numeric field.
+		Min *float64 `json:"Min,omitempty"`
+		Max *float64 `json:"Max,omitempty"`
+		// Pattern is the regular expression that a string field must match.
+		Pattern *string `json:"Pattern,omitempty"`
+	}
+
 	// MutationConfig hold config for mutation
 	MutationConfig struct {
 		IsCreate bool `json:"IsCreate,omitempty"`
+		// IsUpsert indicates the input is an Upsert<T>Input, and ConflictFields
+		// holds the unique field, or the fields of the unique index, that are
+		// used as the conflict target of the upsert.
+		IsUpsert       bool     `json:"IsUpsert,omitempty"`
+		ConflictFields []string `json:"ConflictFields,omitempty"`
+	}
+
+	// MutationFieldsConfig holds the config of the fields exposed under the Mutation object.
+	// A nil field config means the mutation is not exposed.
+	MutationFieldsConfig struct {
+		// Create is the config of the create<T> field.
+		Create *FieldConfig `json:"Create,omitempty"`
+		// Update is the config of the update<T> field.
+		Update *FieldConfig `json:"Update,omitempty"`
+		// Delete is the config of the delete<T> field.
+		Delete *FieldConfig `json:"Delete,omitempty"`
+		// CreateMany is the config of the create<Ts> field.
+		CreateMany *FieldConfig `json:"CreateMany,omitempty"`
+		// UpdateMany is the config of the update<Ts> field.
+		UpdateMany *FieldConfig `json:"UpdateMany,omitempty"`
+		// DeleteMany is the config of the delete<Ts> field.
+		DeleteMany *FieldConfig `json:"DeleteMany,omitempty"`
+		// Upsert is the config of the upsert<T> field.
+		Upsert *FieldConfig `json:"Upsert,omitempty"`
 	}
 )
 
@@ -138,6 +229,37 @@ func OrderField(name string) Annotation {
 	return Annotation{OrderField: name}
 }
 
+// OrderEdgeCount enables ordering in GraphQL by the count of the neighbors of
+// the annotated Ent edge. The ordering field is named <EDGE>_COUNT, and it is
+// supported only on non-unique edges.
+//
+//	edge.To("posts", Post.Type).
+//		Annotations(
+//			entgql.OrderEdgeCount(),
+//		)
+//
+// The example above adds the POSTS_COUNT value to the UserOrderField enum.
+func OrderEdgeCount() Annotation {
+	return Annotation{OrderEdgeCount: true}
+}
+
+// OrderEdgeFields enables ordering in GraphQL by the given fields of the
+// neighbor of the annotated Ent edge. The ordering fields are named
+// <EDGE>_<FIELD>, and they are supported only on unique edges. Note that,
+// the fields must be comparable.
+//
+//	edge.From("owner", User.Type).
+//		Ref("todos").
+//		Unique().
+//		Annotations(
+//			entgql.OrderEdgeFields("name"),
+//		)
+//
+// The example above adds the OWNER_NAME value to the TodoOrderField enum.
+func OrderEdgeFields(fields ...string) Annotation {
+	return Annotation{OrderEdgeFields: fields}
+}
+
 // Bind returns a binding annotation.
 //
 // No-op function to avoid breaking the existing schema.
@@ -267,6 +389,13 @@ func Skip(flags ...SkipMode) Annotation {
 //					Annotation(entgql.RelayConnection()),
 //		}
 //	}
+//
+// When used on an edge schema (e.g. Friendship), the edges that ent adds to the
+// connected types (e.g. User.friendships) are generated as connections of the edge
+// schema type. Hence, the fields of the edge schema are exposed on the nodes of these
+// connections, and not on the <T>Edge types, as the <T>Edge types are shared by all
+// connections of their type. Edge schemas with a composite id (field.ID) cannot be
+// exposed as nodes, and must be skipped with entgql.Skip(entgql.SkipType).
 func RelayConnection() Annotation {
 	return Annotation{RelayConnection: true}
 }
@@ -350,6 +479,378 @@ func (a queryFieldAnnotation) Directives(directives ...Directive) queryFieldAnno
 	return a
 }
 
+type aggregateAnnotation struct {
+	Annotation
+}
+
+type constraintAnnotation struct {
+	Annotation
+}
+
+type offsetPaginationAnnotation struct {
+	Annotation
+}
+
+// Aggregate returns an annotation for exposing the aggregations (count, sum, avg, min
+// and max) of the type on the Query type. The generated field accepts the <T>WhereInput
+// filters and a list of <T>GroupField to group the aggregations by. By default, the
+// field is named <plural(T)>Aggregate (e.g. todosAggregate).
+//
+//	func (Todo) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entgql.Aggregate(),
+//		}
+//	}
+//
+// Fields annotated with entgql.Skip(entgql.SkipType) are not aggregated. The fields
+// that the aggregations can be grouped by are declared with the GroupBy annotation.
+func Aggregate(name ...string) aggregateAnnotation {
+	a := Annotation{Aggregate: &FieldConfig{}}
+	if len(name) > 0 {
+		a.Aggregate.Name = name[0]
+	}
+	return aggregateAnnotation{Annotation: a}
+}
+
+// Directives allow you apply directives to the field.
+func (a aggregateAnnotation) Directives(directives ...Directive) aggregateAnnotation {
+	a.Aggregate.Directives = directives
+	return a
+}
+
+// GroupBy returns a field annotation for exposing the field as a value of the
+// <T>GroupField enum, which the aggregations of the type can be grouped by (see
+// Aggregate). The field must be comparable. For example:
+//
+//	field.Enum("status").
+//		Values("IN_PROGRESS", "COMPLETED").
+//		Annotations(
+//			entgql.GroupBy(),
+//		)
+func GroupBy() Annotation {
+	return Annotation{GroupBy: true}
+}
+
+// OffsetPagination returns an annotation for exposing the offset-based pagination
+// of the type, alongside (or instead of) the Relay cursor pagination. When used on a
+// type, a <T>Page type and a field on the Query type are generated. By default, the
+// field is named <plural(T)>Page (e.g. todosPage):
+//
+//	type TodoPage {
+//		nodes: [Todo!]!
+//		hasNextPage: Boolean!
+//		hasPreviousPage: Boolean!
+//		totalCount: Int!
+//	}
+//
+//	type Query {
+//		todosPage(offset: Int, limit: Int, orderBy: TodoOrder, where: TodoWhereInput): TodoPage!
+//	}
+//
+// When used on an edge, the edge is exposed as an offset-paginated field:
+//
+//	edge.To("children", Todo.Type).
+//		Annotations(entgql.OffsetPagination())
+//
+// Note that, the annotation must also be set on the type of the edge.
+func OffsetPagination(name ...string) offsetPaginationAnnotation {
+	a := Annotation{OffsetPagination: &FieldConfig{}}
+	if len(name) > 0 {
+		a.OffsetPagination.Name = name[0]
+	}
+	return offsetPaginationAnnotation{Annotation: a}
+}
+
+// Directives allow you apply directives to the field.
+func (a offsetPaginationAnnotation) Directives(directives ...Directive) offsetPaginationAnnotation {
+	a.OffsetPagination.Directives = directives
+	return a
+}
+
+type interfaceAnnotation struct {
+	Annotation
+}
+
+// Interface returns an annotation that generates a GraphQL interface with the given
+// name and fields (the "id" field is always included), and makes the annotated types
+// implement it. Unlike the Implements annotation, it is expected to be used by ent mixins
+// that share the fields between the types. For example:
+//
+//	func (TimeMixin) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entgql.Interface("Timestamped", "created_at", "updated_at").
+//				QueryField("timestamped"),
+//		}
+//	}
+//
+// The code generation also adds a Go interface with the same name to the ent package,
+// which is implemented by the ent types and can be bound to the GraphQL interface by
+// gqlgen autobind.
+func Interface(name string, fields ...string) interfaceAnnotation {
+	return interfaceAnnotation{
+		Annotation: Annotation{
+			Interfaces: []InterfaceConfig{{Name: name, Fields: fields}},
+		},
+	}
+}
+
+// QueryField exposes the nodes of all types that implement the interface as a Relay
+// connection under the Query object. The nodes are ordered by their type and id, and
+// they are loaded by the generated Paginate<Interface> method of the ent client.
+func (a interfaceAnnotation) QueryField(name ...string) interfaceAnnotation {
+	i := a.Interfaces[0]
+	i.QueryField = &FieldConfig{}
+	if len(name) > 0 {
+		i.QueryField.Name = name[0]
+	}
+	a.Interfaces = []InterfaceConfig{i}
+	return a
+}
+
+// Authorize returns an annotation that protects the type, field or edge with
+// the @authorize directive. The given rules are passed to the AuthorizePolicy
+// attached to the context (see entgql.Authorizer) before the resolution of:
+//
+//   - Fields that return the annotated type, and Node/Nodes lookups of it.
+//   - The Aggregate field of the annotated type, and the QueryField of its
+//     interfaces, whose types must then be authorized by the same rules.
+//   - The annotated fields and edges.
+//   - The WhereInput predicates of the annotated fields and edges.
+//
+// For example:
+//
+//	func (Todo) Fields() []ent.Field {
+//		return []ent.Field{
+//			field.String("secret").
+//				Annotations(
+//					entgql.Authorize("admin"),
+//				),
+//		}
+//	}
+//
+// The generated ent.AuthorizeDirective needs to be configured in the
+// directive root of the gqlgen executable schema.
+func Authorize(rules ...string) Annotation {
+	return Annotation{Authorize: rules}
+}
+
+// Profiles returns an annotation that limits the visibility of the type, field or
+// edge to the given schema profiles (see WithSchemaProfile). The full schema, that
+// is written to the schema path, always contains all types and fields. For example:
+//
+//	func (User) Fields() []ent.Field {
+//		return []ent.Field{
+//			field.String("password_hash").
+//				Annotations(
+//					entgql.Profiles("admin"),
+//				),
+//		}
+//	}
+//
+// A type that is limited to a set of profiles also hides the fields and arguments of
+// other types that reference it in the rest of the profiles. Edges, and their mutation
+// input fields and ordering values, default to the profiles of their neighbor type. The
+// GraphQL types derived from JSON fields are visible in the profiles of their fields.
+func Profiles(names ...string) Annotation {
+	return Annotation{Profiles: names}
+}
+
+// WhereOps returns an annotation for adding additional operators to the field in the
+// generated <T>WhereInput. For example, checking if a key exists in a JSON field:
+//
+//	field.JSON("config", &Config{}).
+//		Annotations(
+//			entgql.WhereOps(entgql.OpHasKey, entgql.OpValueEQ),
+//		)
+//
+// Generates the following fields in the WhereInput:
+//
+//	input TodoWhereInput {
+//		...
+//		configHasKey: String
+//		configValueEQ: JSONPathValue
+//	}
+//
+// Operators that ent already generates for the field are ignored. Additional
+// operators can be added to multiple fields using the WithWhereOps option.
+func WhereOps(ops ...WhereOp) Annotation {
+	return Annotation{WhereOps: ops}
+}
+
+// NestedCreate returns an edge annotation for creating the edge neighbors together
+// with the node in the Create<T>Input and Update<T>Input types. The neighbor type
+// must have a Create<T>Input. For example:
+//
+//	edge.From("category", Category.Type).
+//		Ref("todos").
+//		Unique().
+//		Annotations(
+//			entgql.NestedCreate(),
+//		)
+//
+// Adds the following field to the CreateTodoInput:
+//
+//	input CreateTodoInput {
+//		...
+//		categoryID: ID
+//		createCategory: CreateCategoryInput
+//	}
+//
+// The nested neighbors are created by a hook that SetInput adds to the builder,
+// using the client of the builder. Hence, they are created in the transaction of
+// the mutation (e.g. the one opened by entgql.Transactioner) before the node is
+// saved. Note that, the neighbors are created before the node and therefore their
+// inverse edge cannot be required.
+func NestedCreate() Annotation {
+	return Annotation{NestedCreate: true}
+}
+
+// AlwaysLoad marks the field to be always selected by the generated CollectFields,
+// even if it was not requested in the GraphQL query. By default, CollectFields
+// selects only the columns of the requested fields (and the ID, foreign-keys and
+// ordering fields), and therefore, fields used by custom resolvers (e.g. resolvers
+// of fields that are not defined in the ent schema) should be annotated with it.
+//
+//	field.String("first_name").
+//		Annotations(
+//			entgql.AlwaysLoad(),
+//		)
+func AlwaysLoad() Annotation {
+	return Annotation{AlwaysLoad: true}
+}
+
+// SoftDelete returns a type annotation for excluding the soft-deleted nodes of the
+// type from the generated Node/Nodes lookups, pagination, edge loaders, field
+// collection, aggregations and subscriptions. The given field must be an optional field of the type, and nodes
+// are considered deleted if it is not null. For example:
+//
+//	func (Todo) Fields() []ent.Field {
+//		return []ent.Field{
+//			field.Time("deleted_at").
+//				Optional().
+//				Nillable(),
+//		}
+//	}
+//
+//	func (Todo) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entgql.RelayConnection(),
+//			entgql.SoftDelete("deleted_at"),
+//		}
+//	}
+//
+// Adds the "includeDeleted" argument to the connections of the type for
+// including the deleted nodes in the result:
+//
+//	type Query {
+//		todos(..., includeDeleted: Boolean): TodoConnection!
+//	}
+//
+// Deleted nodes can be included in all queries executed with a context that was
+// created by NewIncludeDeletedContext (e.g. for administrators). Note that, the
+// edge predicates of the WhereInput types (e.g. hasChildren) always ignore the
+// deleted nodes.
+func SoftDelete(field string) Annotation {
+	return Annotation{SoftDelete: field}
+}
+
+// OptimisticLock returns a type annotation for guarding the update mutations of
+// the type with optimistic concurrency control. The given field must be a required
+// and mutable integer or time field of the type, and it is used as the version of
+// the node. For example:
+//
+//	func (Todo) Fields() []ent.Field {
+//		return []ent.Field{
+//			field.Int("version").
+//				Default(0).
+//				Annotations(
+//					entgql.Skip(entgql.SkipMutationCreateInput),
+//				),
+//		}
+//	}
+//
+//	func (Todo) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entgql.Mutations(),
+//			entgql.OptimisticLock("version"),
+//		}
+//	}
+//
+// Adds the version field to the update input, and holds the version the client
+// expects the node to be in:
+//
+//	input UpdateTodoInput {
+//		version: Int
+//	}
+//
+// The generated Mutate method increments the version (or sets it to the current
+// time). UpdateOne builders that were configured with SetInput require the version,
+// and add a predicate on it to the update. If the node was changed in the meantime,
+// they fail with a GraphQL error that has the "VERSION_CONFLICT" code. The version is
+// nullable in the schema, as the input is shared with the bulk updates that ignore it,
+// and a missing version fails the UpdateOne builders with an ErrInvalidInput error.
+func OptimisticLock(field string) Annotation {
+	return Annotation{OptimisticLock: field}
+}
+
+// Constraint returns a field annotation for declaring the constraints of the field
+// in the generated Create<T>Input and Update<T>Input types. ent does not expose the
+// arguments of the field validators to the code generation, except for the MaxLen
+// validator that sets the size of the field. Hence, the maximum length of string
+// fields is declared automatically, and the other constraints should be declared
+// using this annotation. For example:
+//
+//	field.String("name").
+//		MinLen(1).
+//		MaxLen(64).
+//		Match(regexp.MustCompile("^[a-z]+$")).
+//		Annotations(
+//			entgql.Constraint().MinLength(1).Pattern("^[a-z]+$"),
+//		)
+//
+// Generates the following field in the mutation inputs:
+//
+//	input CreateUserInput {
+//		name: String! @constraint(minLength: 1, maxLength: 64, pattern: "^[a-z]+$")
+//	}
+//
+// The entgql.ConstraintDirective needs to be configured in the directive root of the
+// gqlgen executable schema. Note that the field validators are checked regardless of
+// the directive, by the Validate method of the generated inputs.
+func Constraint() constraintAnnotation {
+	return constraintAnnotation{Annotation: Annotation{Constraint: &ConstraintConfig{}}}
+}
+
+// MinLength sets the minimum length of a string field.
+func (a constraintAnnotation) MinLength(n int) constraintAnnotation {
+	a.Constraint.MinLength = &n
+	return a
+}
+
+// MaxLength sets the maximum length of a string field.
+func (a constraintAnnotation) MaxLength(n int) constraintAnnotation {
+	a.Constraint.MaxLength = &n
+	return a
+}
+
+// Min sets the minimum value of a numeric field.
+func (a constraintAnnotation) Min(v float64) constraintAnnotation {
+	a.Constraint.Min = &v
+	return a
+}
+
+// Max sets the maximum value of a numeric field.
+func (a constraintAnnotation) Max(v float64) constraintAnnotation {
+	a.Constraint.Max = &v
+	return a
+}
+
+// Pattern sets the regular expression that a string field must match.
+func (a constraintAnnotation) Pattern(expr string) constraintAnnotation {
+	a.Constraint.Pattern = &expr
+	return a
+}
+
 type MutationOption interface {
 	IsCreate() bool
 }
@@ -358,6 +859,10 @@ type builtinMutation bool
 
 func (v builtinMutation) IsCreate() bool { return bool(v) }
 
+type upsertMutation []string
+
+func (upsertMutation) IsCreate() bool { return true }
+
 func MutationCreate() MutationOption {
 	return builtinMutation(true)
 }
@@ -366,6 +871,40 @@ func MutationUpdate() MutationOption {
 	return builtinMutation(false)
 }
 
+// MutationUpsert returns a mutation option for generating an Upsert<T>Input that
+// creates a new node, or updates the existing node that conflicts with it. The given
+// fields are the conflict target of the upsert, and they must be a unique field of the
+// type, or the fields of a unique index. For example:
+//
+//	func (Category) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entgql.Mutations(
+//				entgql.MutationCreate(),
+//				entgql.MutationUpsert("slug"),
+//			),
+//			entgql.MutationFields(),
+//		}
+//	}
+//
+// Generates the following input and field:
+//
+//	input UpsertCategoryInput {
+//		slug: String!
+//		...
+//	}
+//
+//	type Mutation {
+//		upsertCategory(input: UpsertCategoryInput!): Category!
+//	}
+//
+// The input holds the fields of the create input, where the conflict fields are required,
+// and it is applied using the generated <T>Client.UpsertInput method. The upsert is executed
+// with the OnConflict().UpdateNewValues() builders of ent, and therefore it requires the
+// "sql/upsert" feature flag. Edges are not part of the upsert input.
+func MutationUpsert(fields ...string) MutationOption {
+	return upsertMutation(fields)
+}
+
 // Mutations returns an annotation for generate input types for mutation.
 func Mutations(inputs ...MutationOption) Annotation {
 	if len(inputs) == 0 {
@@ -374,11 +913,161 @@ func Mutations(inputs ...MutationOption) Annotation {
 
 	a := []MutationConfig{}
 	for _, f := range inputs {
-		a = append(a, MutationConfig{IsCreate: f.IsCreate()})
+		c := MutationConfig{IsCreate: f.IsCreate()}
+		if fields, ok := f.(upsertMutation); ok {
+			c.IsUpsert, c.ConflictFields = true, fields
+		}
+		a = append(a, c)
 	}
 	return Annotation{MutationInputs: a}
 }
 
+// Subscriptions returns an annotation for exposing the created, updated and
+// deleted events of the type under the Subscription object.
+//
+//	func (Todo) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entgql.Subscriptions(),
+//		}
+//	}
+//
+// The fields above are generated as follows:
+//
+//	type Subscription {
+//		todoCreated: Todo!
+//		todoUpdated(id: ID): Todo!
+//		todoDeleted: ID!
+//	}
+//
+// The events are published by the generated ent.SubscriptionHook to an
+// entgql.PubSub, and the resolvers of these fields are generated on the
+// ent.SubscriptionResolver type.
+func Subscriptions() Annotation {
+	return Annotation{Subscriptions: true}
+}
+
+// MultiOrder returns an annotation for ordering the connections of the type
+// by a list of fields instead of a single one. For example:
+//
+//	func (Todo) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entgql.RelayConnection(),
+//			entgql.MultiOrder(),
+//		}
+//	}
+//
+// Changes the orderBy argument of the Todo connections from `orderBy: TodoOrder`
+// to `orderBy: [TodoOrder!]`, and the cursors of these connections hold the values
+// of all fields in the order, e.g. `orderBy: [{field: PRIORITY, direction: DESC},
+// {field: CREATED_AT, direction: ASC}]`.
+func MultiOrder() Annotation {
+	return Annotation{MultiOrder: true}
+}
+
+type mutationFieldsAnnotation struct {
+	Annotation
+}
+
+// MutationFields returns an annotation for exposing the create, update, delete and upsert
+// mutations of the type under the Mutation object. The create<T>, update<T> and upsert<T>
+// fields are generated only if their input types are enabled with the Mutations annotation.
+//
+//	func (Todo) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entgql.Mutations(),
+//			entgql.MutationFields(),
+//		}
+//	}
+//
+// The fields above are generated as follows:
+//
+//	type Mutation {
+//		createTodo(input: CreateTodoInput!): Todo!
+//		updateTodo(id: ID!, input: UpdateTodoInput!): Todo!
+//		deleteTodo(id: ID!): ID!
+//	}
+//
+// The resolvers of these fields are generated on the ent.MutationResolver type.
+func MutationFields() mutationFieldsAnnotation {
+	return mutationFieldsAnnotation{
+		Annotation: Annotation{
+			MutationFields: &MutationFieldsConfig{
+				Create: &FieldConfig{},
+				Update: &FieldConfig{},
+				Delete: &FieldConfig{},
+				Upsert: &FieldConfig{},
+			},
+		},
+	}
+}
+
+// Create overrides the name of the create<T> field and allows applying directives to it.
+func (a mutationFieldsAnnotation) Create(name string, directives ...Directive) mutationFieldsAnnotation {
+	a.MutationFields.Create = &FieldConfig{Name: name, Directives: directives}
+	return a
+}
+
+// Update overrides the name of the update<T> field and allows applying directives to it.
+func (a mutationFieldsAnnotation) Update(name string, directives ...Directive) mutationFieldsAnnotation {
+	a.MutationFields.Update = &FieldConfig{Name: name, Directives: directives}
+	return a
+}
+
+// Delete overrides the name of the delete<T> field and allows applying directives to it.
+func (a mutationFieldsAnnotation) Delete(name string, directives ...Directive) mutationFieldsAnnotation {
+	a.MutationFields.Delete = &FieldConfig{Name: name, Directives: directives}
+	return a
+}
+
+// Upsert overrides the name of the upsert<T> field and allows applying directives to it.
+func (a mutationFieldsAnnotation) Upsert(name string, directives ...Directive) mutationFieldsAnnotation {
+	a.MutationFields.Upsert = &FieldConfig{Name: name, Directives: directives}
+	return a
+}
+
+// Bulk exposes the bulk mutations of the type under the Mutation object, in addition to
+// the single-node mutations. The update<Ts> and delete<Ts> fields are applied to all nodes
+// that match the given filter, and therefore, they are generated only if the WhereInput of
+// the type is generated.
+//
+//	entgql.MutationFields().Bulk()
+//
+// The fields above are generated as follows:
+//
+//	type Mutation {
+//		...
+//		createTodos(inputs: [CreateTodoInput!]!): [Todo!]!
+//		updateTodos(where: TodoWhereInput!, input: UpdateTodoInput!): Int!
+//		deleteTodos(where: TodoWhereInput!): Int!
+//	}
+//
+// The update<Ts> and delete<Ts> fields return the number of affected nodes. An empty
+// filter is rejected with the ErrEmpty<T>WhereInput error of the type.
+func (a mutationFieldsAnnotation) Bulk() mutationFieldsAnnotation {
+	a.MutationFields.CreateMany = &FieldConfig{}
+	a.MutationFields.UpdateMany = &FieldConfig{}
+	a.MutationFields.DeleteMany = &FieldConfig{}
+	return a
+}
+
+// CreateMany overrides the name of the create<Ts> field and allows applying directives to it.
+func (a mutationFieldsAnnotation) CreateMany(name string, directives ...Directive) mutationFieldsAnnotation {
+	a.MutationFields.CreateMany = &FieldConfig{Name: name, Directives: directives}
+	return a
+}
+
+// UpdateMany overrides the name of the update<Ts> field and allows applying directives to it.
+func (a mutationFieldsAnnotation) UpdateMany(name string, directives ...Directive) mutationFieldsAnnotation {
+	a.MutationFields.UpdateMany = &FieldConfig{Name: name, Directives: directives}
+	return a
+}
+
+// DeleteMany overrides the name of the delete<Ts> field and allows applying directives to it.
+func (a mutationFieldsAnnotation) DeleteMany(name string, directives ...Directive) mutationFieldsAnnotation {
+	a.MutationFields.DeleteMany = &FieldConfig{Name: name, Directives: directives}
+	return a
+}
+
 // Merge implements the schema.Merger interface.
 func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
 	var ant Annotation
@@ -395,6 +1084,36 @@ func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
 		if other != nil {
 			ant = other.Annotation
 		}
+	case aggregateAnnotation:
+		ant = other.Annotation
+	case *aggregateAnnotation:
+		if other != nil {
+			ant = other.Annotation
+		}
+	case offsetPaginationAnnotation:
+		ant = other.Annotation
+	case *offsetPaginationAnnotation:
+		if other != nil {
+			ant = other.Annotation
+		}
+	case constraintAnnotation:
+		ant = other.Annotation
+	case *constraintAnnotation:
+		if other != nil {
+			ant = other.Annotation
+		}
+	case interfaceAnnotation:
+		ant = other.Annotation
+	case *interfaceAnnotation:
+		if other != nil {
+			ant = other.Annotation
+		}
+	case mutationFieldsAnnotation:
+		ant = other.Annotation
+	case *mutationFieldsAnnotation:
+		if other != nil {
+			ant = other.Annotation
+		}
 	default:
 		return a
 	}
@@ -419,18 +1138,84 @@ func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
 	if ant.RelayConnection {
 		a.RelayConnection = true
 	}
+	if ant.Subscriptions {
+		a.Subscriptions = true
+	}
+	if ant.NestedCreate {
+		a.NestedCreate = true
+	}
+	if ant.AlwaysLoad {
+		a.AlwaysLoad = true
+	}
+	if ant.SoftDelete != "" {
+		a.SoftDelete = ant.SoftDelete
+	}
+	if ant.OptimisticLock != "" {
+		a.OptimisticLock = ant.OptimisticLock
+	}
+	if ant.MultiOrder {
+		a.MultiOrder = true
+	}
+	if ant.OrderEdgeCount {
+		a.OrderEdgeCount = true
+	}
+	if ant.GroupBy {
+		a.GroupBy = true
+	}
+	if len(ant.OrderEdgeFields) > 0 {
+		a.OrderEdgeFields = append(a.OrderEdgeFields, ant.OrderEdgeFields...)
+	}
 	if len(ant.Implements) > 0 {
 		a.Implements = append(a.Implements, ant.Implements...)
 	}
+	if len(ant.Interfaces) > 0 {
+		a.Interfaces = append([]InterfaceConfig(nil), a.Interfaces...)
+		for _, i := range ant.Interfaces {
+			a.mergeInterface(i)
+		}
+	}
 	if len(ant.Directives) > 0 {
 		a.Directives = append(a.Directives, ant.Directives...)
 	}
+	if len(ant.Authorize) > 0 {
+		a.Authorize = append(a.Authorize, ant.Authorize...)
+	}
+	if len(ant.WhereOps) > 0 {
+		a.WhereOps = append(a.WhereOps, ant.WhereOps...)
+	}
+	if len(ant.Profiles) > 0 {
+		a.Profiles = append(a.Profiles, ant.Profiles...)
+	}
 	if ant.QueryField != nil {
 		if a.QueryField == nil {
 			a.QueryField = &FieldConfig{}
 		}
 		a.QueryField.merge(ant.QueryField)
 	}
+	if ant.Aggregate != nil {
+		if a.Aggregate == nil {
+			a.Aggregate = &FieldConfig{}
+		}
+		a.Aggregate.merge(ant.Aggregate)
+	}
+	if ant.OffsetPagination != nil {
+		if a.OffsetPagination == nil {
+			a.OffsetPagination = &FieldConfig{}
+		}
+		a.OffsetPagination.merge(ant.OffsetPagination)
+	}
+	if ant.Constraint != nil {
+		if a.Constraint == nil {
+			a.Constraint = &ConstraintConfig{}
+		}
+		a.Constraint.merge(ant.Constraint)
+	}
+	if ant.MutationFields != nil {
+		if a.MutationFields == nil {
+			a.MutationFields = &MutationFieldsConfig{}
+		}
+		a.MutationFields.merge(ant.MutationFields)
+	}
 	return a
 }
 
@@ -460,6 +1245,26 @@ func (c FieldConfig) fieldName(gqlType string) string {
 	return camel(plural(gqlType))
 }
 
+// mergeInterface adds the interface to the annotation, or merges
+// it with the interface that was added with the same name.
+func (a *Annotation) mergeInterface(i InterfaceConfig) {
+	for j := range a.Interfaces {
+		if c := &a.Interfaces[j]; c.Name == i.Name {
+			if len(i.Fields) > 0 {
+				c.Fields = i.Fields
+			}
+			if i.QueryField != nil {
+				if c.QueryField == nil {
+					c.QueryField = &FieldConfig{}
+				}
+				c.QueryField.merge(i.QueryField)
+			}
+			return
+		}
+	}
+	a.Interfaces = append(a.Interfaces, i)
+}
+
 func (c *FieldConfig) merge(ant *FieldConfig) {
 	if ant == nil {
 		return
@@ -470,6 +1275,45 @@ func (c *FieldConfig) merge(ant *FieldConfig) {
 	c.Directives = append(c.Directives, ant.Directives...)
 }
 
+func (c *ConstraintConfig) merge(ant *ConstraintConfig) {
+	if ant.MinLength != nil {
+		c.MinLength = ant.MinLength
+	}
+	if ant.MaxLength != nil {
+		c.MaxLength = ant.MaxLength
+	}
+	if ant.Min != nil {
+		c.Min = ant.Min
+	}
+	if ant.Max != nil {
+		c.Max = ant.Max
+	}
+	if ant.Pattern != nil {
+		c.Pattern = ant.Pattern
+	}
+}
+
+func (c *MutationFieldsConfig) merge(ant *MutationFieldsConfig) {
+	c.Create = mergeFieldConfig(c.Create, ant.Create)
+	c.Update = mergeFieldConfig(c.Update, ant.Update)
+	c.Delete = mergeFieldConfig(c.Delete, ant.Delete)
+	c.CreateMany = mergeFieldConfig(c.CreateMany, ant.CreateMany)
+	c.UpdateMany = mergeFieldConfig(c.UpdateMany, ant.UpdateMany)
+	c.DeleteMany = mergeFieldConfig(c.DeleteMany, ant.DeleteMany)
+	c.Upsert = mergeFieldConfig(c.Upsert, ant.Upsert)
+}
+
+func mergeFieldConfig(c, ant *FieldConfig) *FieldConfig {
+	if ant == nil {
+		return c
+	}
+	if c == nil {
+		c = &FieldConfig{}
+	}
+	c.merge(ant)
+	return c
+}
+
 // annotation extracts the entgql.Annotation or returns its empty value.
 func annotation(ants gen.Annotations) (*Annotation, error) {
 	ant := &Annotation{}
@@ -507,3 +1351,26 @@ func Deprecated(reason string) Directive {
 	}
 	return NewDirective("deprecated", args...)
 }
+
+// Key creates a `@key` directive to apply on the type for marking it as an Apollo Federation
+// entity with the given key fields. Types are keyed by their "id" field by default, when the
+// extension is configured with the WithFederation option.
+func Key(fields string) Directive {
+	return NewDirective("key", DirectiveArgument{
+		Name:  "fields",
+		Kind:  ast.StringValue,
+		Value: fields,
+	})
+}
+
+// Shareable creates a `@shareable` directive to apply on the field/type for
+// marking it as resolvable by multiple subgraphs of an Apollo Federation.
+func Shareable() Directive {
+	return NewDirective("shareable")
+}
+
+// External creates an `@external` directive to apply on the field for marking
+// it as owned by another subgraph of an Apollo Federation.
+func External() Directive {
+	return NewDirective("external")
+}
@@ -38,6 +38,186 @@ func TestAnnotation(t *testing.T) {
 	require.ElementsMatch(t, names, annotation.Mapping)
 }
 
+func TestMutationFieldsAnnotation(t *testing.T) {
+	t.Parallel()
+	annotation := entgql.MutationFields()
+	require.Equal(t, &entgql.MutationFieldsConfig{
+		Create: &entgql.FieldConfig{},
+		Update: &entgql.FieldConfig{},
+		Delete: &entgql.FieldConfig{},
+		Upsert: &entgql.FieldConfig{},
+	}, annotation.MutationFields)
+
+	annotation = entgql.MutationFields().
+		Create("addTodo").
+		Delete("removeTodo", entgql.Deprecated("Use archiveTodo instead."))
+	require.Equal(t, "addTodo", annotation.MutationFields.Create.Name)
+	require.Empty(t, annotation.MutationFields.Update.Name)
+	require.Equal(t, "removeTodo", annotation.MutationFields.Delete.Name)
+	require.Len(t, annotation.MutationFields.Delete.Directives, 1)
+
+	merged := entgql.Annotation{}.Merge(annotation).(entgql.Annotation)
+	merged = merged.Merge(entgql.MutationFields().Update("editTodo")).(entgql.Annotation)
+	require.Equal(t, "addTodo", merged.MutationFields.Create.Name)
+	require.Equal(t, "editTodo", merged.MutationFields.Update.Name)
+	require.Equal(t, "removeTodo", merged.MutationFields.Delete.Name)
+	require.Nil(t, merged.MutationFields.CreateMany)
+
+	merged = merged.Merge(entgql.MutationFields().Bulk().DeleteMany("purgeTodos")).(entgql.Annotation)
+	require.Equal(t, &entgql.FieldConfig{}, merged.MutationFields.CreateMany)
+	require.Equal(t, &entgql.FieldConfig{}, merged.MutationFields.UpdateMany)
+	require.Equal(t, "purgeTodos", merged.MutationFields.DeleteMany.Name)
+
+	merged = merged.Merge(entgql.MutationFields().Upsert("syncTodo")).(entgql.Annotation)
+	require.Equal(t, "syncTodo", merged.MutationFields.Upsert.Name)
+}
+
+func TestSubscriptionsAnnotation(t *testing.T) {
+	t.Parallel()
+	annotation := entgql.Subscriptions()
+	require.True(t, annotation.Subscriptions)
+
+	merged := entgql.Annotation{}.Merge(annotation).(entgql.Annotation)
+	merged = merged.Merge(entgql.OrderField("NAME")).(entgql.Annotation)
+	require.True(t, merged.Subscriptions)
+	require.Equal(t, "NAME", merged.OrderField)
+}
+
+func TestOrderEdgeAnnotations(t *testing.T) {
+	t.Parallel()
+	annotation := entgql.OrderEdgeCount()
+	require.True(t, annotation.OrderEdgeCount)
+	annotation = entgql.OrderEdgeFields("name", "age")
+	require.Equal(t, []string{"name", "age"}, annotation.OrderEdgeFields)
+
+	merged := entgql.Annotation{}.Merge(entgql.OrderEdgeCount()).(entgql.Annotation)
+	merged = merged.Merge(annotation).(entgql.Annotation)
+	merged = merged.Merge(entgql.OrderEdgeFields("email")).(entgql.Annotation)
+	require.True(t, merged.OrderEdgeCount)
+	require.Equal(t, []string{"name", "age", "email"}, merged.OrderEdgeFields)
+}
+
+func TestAggregateAnnotation(t *testing.T) {
+	t.Parallel()
+	annotation := entgql.Aggregate()
+	require.Equal(t, &entgql.FieldConfig{}, annotation.Aggregate)
+
+	directive := entgql.Deprecated("Use todosStats instead.")
+	merged := entgql.Annotation{}.Merge(entgql.Aggregate("todosSummary")).(entgql.Annotation)
+	merged = merged.Merge(entgql.Aggregate().Directives(directive)).(entgql.Annotation)
+	require.Equal(t, "todosSummary", merged.Aggregate.Name)
+	require.Equal(t, []entgql.Directive{directive}, merged.Aggregate.Directives)
+
+	merged = entgql.Annotation{}.Merge(entgql.OrderField("STATUS")).(entgql.Annotation)
+	require.False(t, merged.GroupBy)
+	merged = merged.Merge(entgql.GroupBy()).(entgql.Annotation)
+	require.True(t, merged.GroupBy)
+	require.Equal(t, "STATUS", merged.OrderField)
+}
+
+func TestOffsetPaginationAnnotation(t *testing.T) {
+	t.Parallel()
+	annotation := entgql.OffsetPagination()
+	require.Equal(t, &entgql.FieldConfig{}, annotation.OffsetPagination)
+
+	directive := entgql.Deprecated("Use todos instead.")
+	merged := entgql.Annotation{}.Merge(entgql.RelayConnection()).(entgql.Annotation)
+	merged = merged.Merge(entgql.OffsetPagination("todoList").Directives(directive)).(entgql.Annotation)
+	require.True(t, merged.RelayConnection)
+	require.Equal(t, "todoList", merged.OffsetPagination.Name)
+	require.Equal(t, []entgql.Directive{directive}, merged.OffsetPagination.Directives)
+}
+
+func TestAuthorizeAnnotation(t *testing.T) {
+	t.Parallel()
+	annotation := entgql.Authorize("admin")
+	require.Equal(t, []string{"admin"}, annotation.Authorize)
+
+	merged := entgql.Annotation{}.Merge(entgql.Authorize("admin")).(entgql.Annotation)
+	merged = merged.Merge(entgql.Annotation{}).(entgql.Annotation)
+	merged = merged.Merge(entgql.Authorize("owner", "editor")).(entgql.Annotation)
+	require.Equal(t, []string{"admin", "owner", "editor"}, merged.Authorize)
+}
+
+func TestWhereOpsAnnotation(t *testing.T) {
+	t.Parallel()
+	annotation := entgql.WhereOps(entgql.OpContainsFold)
+	require.Equal(t, []entgql.WhereOp{entgql.OpContainsFold}, annotation.WhereOps)
+
+	merged := entgql.Annotation{}.Merge(entgql.WhereOps(entgql.OpHasKey)).(entgql.Annotation)
+	merged = merged.Merge(entgql.WhereOps(entgql.OpValueEQ)).(entgql.Annotation)
+	require.Equal(t, []entgql.WhereOp{entgql.OpHasKey, entgql.OpValueEQ}, merged.WhereOps)
+}
+
+func TestNestedCreateAnnotation(t *testing.T) {
+	t.Parallel()
+	annotation := entgql.NestedCreate()
+	require.True(t, annotation.NestedCreate)
+
+	merged := entgql.Annotation{}.Merge(entgql.NestedCreate()).(entgql.Annotation)
+	merged = merged.Merge(entgql.RelayConnection()).(entgql.Annotation)
+	require.True(t, merged.NestedCreate)
+	require.True(t, merged.RelayConnection)
+}
+
+func TestAlwaysLoadAnnotation(t *testing.T) {
+	t.Parallel()
+	annotation := entgql.AlwaysLoad()
+	require.True(t, annotation.AlwaysLoad)
+
+	merged := entgql.Annotation{}.Merge(entgql.AlwaysLoad()).(entgql.Annotation)
+	merged = merged.Merge(entgql.OrderField("TEXT")).(entgql.Annotation)
+	require.True(t, merged.AlwaysLoad)
+	require.Equal(t, "TEXT", merged.OrderField)
+}
+
+func TestSoftDeleteAnnotation(t *testing.T) {
+	t.Parallel()
+	annotation := entgql.SoftDelete("deleted_at")
+	require.Equal(t, "deleted_at", annotation.SoftDelete)
+
+	merged := entgql.Annotation{}.Merge(entgql.SoftDelete("deleted_at")).(entgql.Annotation)
+	merged = merged.Merge(entgql.RelayConnection()).(entgql.Annotation)
+	require.Equal(t, "deleted_at", merged.SoftDelete)
+	require.True(t, merged.RelayConnection)
+}
+
+func TestOptimisticLockAnnotation(t *testing.T) {
+	t.Parallel()
+	merged := entgql.Annotation{}.Merge(entgql.OptimisticLock("version")).(entgql.Annotation)
+	merged = merged.Merge(entgql.Mutations()).(entgql.Annotation)
+	require.Equal(t, "version", merged.OptimisticLock)
+	require.Len(t, merged.MutationInputs, 2)
+}
+
+func TestConstraintAnnotation(t *testing.T) {
+	t.Parallel()
+	merged := entgql.Annotation{}.Merge(entgql.Constraint().MinLength(1).Max(10)).(entgql.Annotation)
+	merged = merged.Merge(entgql.Constraint().Pattern("^[a-z]+$").Max(20)).(entgql.Annotation)
+	require.Equal(t, 1, *merged.Constraint.MinLength)
+	require.Equal(t, float64(20), *merged.Constraint.Max)
+	require.Equal(t, "^[a-z]+$", *merged.Constraint.Pattern)
+	require.Nil(t, merged.Constraint.MaxLength)
+	require.Nil(t, merged.Constraint.Min)
+}
+
+func TestInterfaceAnnotation(t *testing.T) {
+	t.Parallel()
+	ant := entgql.Interface("Timestamped", "created_at")
+	require.Equal(t, []entgql.InterfaceConfig{{Name: "Timestamped", Fields: []string{"created_at"}}}, ant.Interfaces)
+	require.Equal(t, &entgql.FieldConfig{Name: "timestamped"}, ant.QueryField("timestamped").Interfaces[0].QueryField)
+	require.Nil(t, ant.Interfaces[0].QueryField)
+
+	merged := entgql.Annotation{}.Merge(ant).(entgql.Annotation)
+	merged = merged.Merge(entgql.Interface("Owned", "owner_id")).(entgql.Annotation)
+	merged = merged.Merge(entgql.Interface("Timestamped").QueryField()).(entgql.Annotation)
+	require.Equal(t, []entgql.InterfaceConfig{
+		{Name: "Timestamped", Fields: []string{"created_at"}, QueryField: &entgql.FieldConfig{}},
+		{Name: "Owned", Fields: []string{"owner_id"}},
+	}, merged.Interfaces)
+	require.Nil(t, ant.Interfaces[0].QueryField)
+}
+
 func TestAnnotationDecode(t *testing.T) {
 	ann := &entgql.Annotation{}
 	err := ann.Decode(map[string]interface{}{})
@@ -0,0 +1,98 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// ErrUnauthorized is returned when the access to a type, field or edge annotated
+// with the Authorize annotation is checked without a policy in the context.
+var ErrUnauthorized = errors.New("entgql: unauthorized")
+
+// AuthorizePolicy authorizes the access to the types, fields and edges annotated
+// with the Authorize annotation.
+type AuthorizePolicy interface {
+	// Authorize returns an error if the operation in the context is not allowed to
+	// access the object protected by the given rules. The obj argument holds the
+	// parent object of the resolved field, the node on Node/Nodes lookups, or the
+	// input object on WhereInput filters.
+	Authorize(ctx context.Context, obj interface{}, rules []string) error
+}
+
+// The AuthorizePolicyFunc type is an adapter to allow the use of
+// ordinary functions as authorize policies.
+type AuthorizePolicyFunc func(ctx context.Context, obj interface{}, rules []string) error
+
+// Authorize returns f(ctx, obj, rules).
+func (f AuthorizePolicyFunc) Authorize(ctx context.Context, obj interface{}, rules []string) error {
+	return f(ctx, obj, rules)
+}
+
+type authorizeCtxKey struct{}
+
+// NewAuthorizeContext returns a new context with the given AuthorizePolicy attached.
+func NewAuthorizeContext(parent context.Context, p AuthorizePolicy) context.Context {
+	return context.WithValue(parent, authorizeCtxKey{}, p)
+}
+
+// AuthorizePolicyFromContext returns the AuthorizePolicy stored in a context, or nil if there isn't one.
+func AuthorizePolicyFromContext(ctx context.Context) AuthorizePolicy {
+	p, _ := ctx.Value(authorizeCtxKey{}).(AuthorizePolicy)
+	return p
+}
+
+// CheckAuthorize checks the given rules using the AuthorizePolicy stored in
+// the context. Access is denied with ErrUnauthorized if there is no policy.
+func CheckAuthorize(ctx context.Context, obj interface{}, rules []string) error {
+	p := AuthorizePolicyFromContext(ctx)
+	if p == nil {
+		return ErrUnauthorized
+	}
+	return p.Authorize(ctx, obj, rules)
+}
+
+// Authorizer is a graphql.HandlerExtension that attaches its policy to the context
+// of the operations. The policy is used by the generated @authorize directive and
+// the Node/Nodes lookups of types annotated with the Authorize annotation.
+//
+//	srv.Use(entgql.Authorizer{AuthorizePolicy: policy})
+type Authorizer struct{ AuthorizePolicy }
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+} = Authorizer{}
+
+// ExtensionName returns the extension name.
+func (Authorizer) ExtensionName() string {
+	return "EntGQLAuthorizer"
+}
+
+// Validate is called when adding an extension to the server, it allows validation against the servers schema.
+func (a Authorizer) Validate(graphql.ExecutableSchema) error {
+	if a.AuthorizePolicy == nil {
+		return errors.New("entgql: authorize policy is nil")
+	}
+	return nil
+}
+
+// InterceptResponse attaches the policy to the context of the operation.
+func (a Authorizer) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	return next(NewAuthorizeContext(ctx, a.AuthorizePolicy))
+}
@@ -0,0 +1,68 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"entgo.io/contrib/entgql"
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckAuthorize(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	err := entgql.CheckAuthorize(ctx, nil, []string{"admin"})
+	require.ErrorIs(t, err, entgql.ErrUnauthorized)
+
+	errDenied := errors.New("denied")
+	ctx = entgql.NewAuthorizeContext(ctx, entgql.AuthorizePolicyFunc(func(_ context.Context, obj interface{}, rules []string) error {
+		if obj == "public" {
+			return nil
+		}
+		require.Equal(t, []string{"admin"}, rules)
+		return errDenied
+	}))
+	require.NoError(t, entgql.CheckAuthorize(ctx, "public", []string{"admin"}))
+	require.ErrorIs(t, entgql.CheckAuthorize(ctx, "secret", []string{"admin"}), errDenied)
+}
+
+func TestAuthorizer(t *testing.T) {
+	t.Parallel()
+	require.Error(t, entgql.Authorizer{}.Validate(nil))
+
+	var called bool
+	policy := entgql.AuthorizePolicyFunc(func(context.Context, interface{}, []string) error {
+		called = true
+		return nil
+	})
+	srv := testserver.New()
+	srv.AddTransport(transport.POST{})
+	srv.Use(entgql.Authorizer{AuthorizePolicy: policy})
+	srv.AroundResponses(func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+		require.NotNil(t, entgql.AuthorizePolicyFromContext(ctx))
+		require.NoError(t, entgql.CheckAuthorize(ctx, nil, []string{"admin"}))
+		return next(ctx)
+	})
+	err := client.New(srv).Post(`query { name }`, &struct{ Name string }{})
+	require.NoError(t, err)
+	require.True(t, called)
+}
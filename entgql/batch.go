@@ -0,0 +1,181 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// DefaultBatchWait is the default duration a BatchLoader waits for
+// sibling lookups before it loads a batch.
+const DefaultBatchWait = time.Millisecond
+
+// BatchFunc loads the values of the given keys in a single query, and
+// returns them mapped by their keys. Keys without values are omitted.
+type BatchFunc func(ctx context.Context, keys []interface{}) (map[interface{}]interface{}, error)
+
+// BatchLoader groups the lookups of the same edge of sibling nodes into one query.
+// The generated edge methods (e.g. Todo.Children) use the BatchLoader stored in
+// the context to load edges that were not eager-loaded by CollectFields.
+//
+// A batch is loaded using the context of its first lookup, and therefore, a
+// BatchLoader should be used for a single request. See the Batcher extension.
+type BatchLoader struct {
+	wait     time.Duration
+	maxBatch int
+	mu       sync.Mutex
+	batches  map[string]*batch
+}
+
+// batch holds the keys of a pending batch and its results.
+type batch struct {
+	keys   []interface{}
+	seen   map[interface{}]struct{}
+	done   chan struct{}
+	closed bool
+	values map[interface{}]interface{}
+	err    error
+}
+
+// NewBatchLoader returns a new BatchLoader that waits the given duration for
+// sibling lookups before it loads a batch. A batch is loaded immediately when
+// it reaches maxBatch keys. Zero maxBatch means the batches are not limited.
+func NewBatchLoader(wait time.Duration, maxBatch int) *BatchLoader {
+	if wait <= 0 {
+		wait = DefaultBatchWait
+	}
+	return &BatchLoader{
+		wait:     wait,
+		maxBatch: maxBatch,
+		batches:  make(map[string]*batch),
+	}
+}
+
+// Load adds the key to the pending batch with the given name, and returns its value
+// after the batch was loaded by the given function. The function of the first key
+// in the batch is used for loading it. A nil value is returned for missing keys.
+func (l *BatchLoader) Load(ctx context.Context, name string, key interface{}, fn BatchFunc) (interface{}, error) {
+	l.mu.Lock()
+	b, ok := l.batches[name]
+	if !ok {
+		b = &batch{seen: make(map[interface{}]struct{}), done: make(chan struct{})}
+		l.batches[name] = b
+		time.AfterFunc(l.wait, func() { l.exec(ctx, name, b, fn) })
+	}
+	if _, ok := b.seen[key]; !ok {
+		b.seen[key] = struct{}{}
+		b.keys = append(b.keys, key)
+	}
+	full := l.maxBatch > 0 && len(b.keys) >= l.maxBatch
+	l.mu.Unlock()
+	if full {
+		l.exec(ctx, name, b, fn)
+	}
+	select {
+	case <-b.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if b.err != nil {
+		return nil, b.err
+	}
+	return b.values[key], nil
+}
+
+// exec loads the given batch, unless it was already loaded.
+func (l *BatchLoader) exec(ctx context.Context, name string, b *batch, fn BatchFunc) {
+	l.mu.Lock()
+	if b.closed {
+		l.mu.Unlock()
+		return
+	}
+	b.closed = true
+	// New lookups are added to a new batch.
+	if l.batches[name] == b {
+		delete(l.batches, name)
+	}
+	l.mu.Unlock()
+	b.values, b.err = fn(ctx, b.keys)
+	close(b.done)
+}
+
+type batchCtxKey struct{}
+
+// NewBatchLoaderContext returns a new context with the given BatchLoader attached.
+func NewBatchLoaderContext(parent context.Context, l *BatchLoader) context.Context {
+	return context.WithValue(parent, batchCtxKey{}, l)
+}
+
+// BatchLoaderFromContext returns the BatchLoader stored in a context, or nil if there isn't one.
+func BatchLoaderFromContext(ctx context.Context) *BatchLoader {
+	l, _ := ctx.Value(batchCtxKey{}).(*BatchLoader)
+	return l
+}
+
+// Batcher is a graphql.HandlerExtension that attaches a new BatchLoader to the
+// context of each operation. Hence, the edges that were not eager-loaded (e.g.
+// edges of federation entities or nodes loaded by custom resolvers) are loaded
+// in one query for all sibling nodes, instead of one query for each of them.
+//
+//	srv.Use(entgql.Batcher{})
+type Batcher struct {
+	// Wait is the duration the loader waits for sibling lookups
+	// before it loads a batch. Defaults to DefaultBatchWait.
+	Wait time.Duration
+	// MaxBatch is the maximum number of keys in a batch.
+	// Zero means the batches are not limited.
+	MaxBatch int
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+} = Batcher{}
+
+// ExtensionName returns the extension name.
+func (Batcher) ExtensionName() string {
+	return "EntGQLBatcher"
+}
+
+// Validate is called when adding an extension to the server, it allows validation against the servers schema.
+func (b Batcher) Validate(graphql.ExecutableSchema) error {
+	if b.Wait < 0 || b.MaxBatch < 0 {
+		return errors.New("entgql: batch wait and size must not be negative")
+	}
+	return nil
+}
+
+// InterceptResponse attaches a new BatchLoader to the context of the operation.
+func (b Batcher) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	return next(NewBatchLoaderContext(ctx, NewBatchLoader(b.Wait, b.MaxBatch)))
+}
+
+// Middleware returns an HTTP middleware that attaches a new BatchLoader to the
+// context of each request. It can be used instead of the extension above, for
+// sharing the loader between the operations of a batched request.
+//
+//	http.Handle("/query", entgql.Batcher{}.Middleware(srv))
+func (b Batcher) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := NewBatchLoaderContext(r.Context(), NewBatchLoader(b.Wait, b.MaxBatch))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,120 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchLoader(t *testing.T) {
+	t.Parallel()
+	var (
+		mu      sync.Mutex
+		batches [][]interface{}
+		ctx     = context.Background()
+		l       = entgql.NewBatchLoader(10*time.Millisecond, 0)
+	)
+	double := func(_ context.Context, keys []interface{}) (map[interface{}]interface{}, error) {
+		mu.Lock()
+		batches = append(batches, keys)
+		mu.Unlock()
+		m := make(map[interface{}]interface{}, len(keys))
+		for _, k := range keys {
+			if k.(int) > 0 {
+				m[k] = k.(int) * 2
+			}
+		}
+		return m, nil
+	}
+	var wg sync.WaitGroup
+	for _, k := range []int{1, 2, 3, 3, -1} {
+		wg.Add(1)
+		go func(k int) {
+			defer wg.Done()
+			v, err := l.Load(ctx, "double", k, double)
+			require.NoError(t, err)
+			if k > 0 {
+				require.Equal(t, k*2, v)
+			} else {
+				require.Nil(t, v)
+			}
+		}(k)
+	}
+	wg.Wait()
+	require.Len(t, batches, 1)
+	keys := batches[0]
+	sort.Slice(keys, func(i, j int) bool { return keys[i].(int) < keys[j].(int) })
+	require.Equal(t, []interface{}{-1, 1, 2, 3}, keys)
+
+	errLoad := errors.New("load failed")
+	_, err := l.Load(ctx, "fail", 1, func(context.Context, []interface{}) (map[interface{}]interface{}, error) {
+		return nil, errLoad
+	})
+	require.ErrorIs(t, err, errLoad)
+}
+
+func TestBatchLoader_MaxBatch(t *testing.T) {
+	t.Parallel()
+	var (
+		calls int
+		l     = entgql.NewBatchLoader(time.Hour, 1)
+	)
+	for i := 0; i < 3; i++ {
+		v, err := l.Load(context.Background(), "identity", i, func(_ context.Context, keys []interface{}) (map[interface{}]interface{}, error) {
+			calls++
+			return map[interface{}]interface{}{keys[0]: keys[0]}, nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, i, v)
+	}
+	require.Equal(t, 3, calls)
+}
+
+func TestBatcher(t *testing.T) {
+	t.Parallel()
+	require.Error(t, entgql.Batcher{Wait: -1}.Validate(nil))
+	require.NoError(t, entgql.Batcher{}.Validate(nil))
+
+	srv := testserver.New()
+	srv.AddTransport(transport.POST{})
+	srv.Use(entgql.Batcher{})
+	srv.AroundResponses(func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+		require.NotNil(t, entgql.BatchLoaderFromContext(ctx))
+		return next(ctx)
+	})
+	err := client.New(srv).Post(`query { name }`, &struct{ Name string }{})
+	require.NoError(t, err)
+
+	var l *entgql.BatchLoader
+	h := entgql.Batcher{}.Middleware(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		l = entgql.BatchLoaderFromContext(r.Context())
+	}))
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", nil))
+	require.NotNil(t, l)
+}
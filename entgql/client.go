@@ -0,0 +1,327 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Client is a GraphQL client used by the typed clients generated by the ClientTemplate.
+// Variables are encoded, and results are decoded, using the GraphQL marshalers of the
+// generated types (e.g. Cursor, enums and order fields) if they are implemented.
+type Client struct {
+	url    string
+	client *http.Client
+	header http.Header
+}
+
+// ClientOption allows configuring the Client using functional options.
+type ClientOption func(*Client)
+
+// WithHTTPClient sets the HTTP client used for executing the
+// operations. Defaults to http.DefaultClient.
+func WithHTTPClient(hc *http.Client) ClientOption {
+	return func(c *Client) {
+		c.client = hc
+	}
+}
+
+// WithHeader adds the given header to the requests sent by the client.
+func WithHeader(key, value string) ClientOption {
+	return func(c *Client) {
+		c.header.Add(key, value)
+	}
+}
+
+// NewClient returns a new Client for the GraphQL server at the given URL.
+func NewClient(url string, opts ...ClientOption) *Client {
+	c := &Client{
+		url:    url,
+		client: http.DefaultClient,
+		header: make(http.Header),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Do executes the given operation with its variables, and decodes its result into v. The fields
+// of the result are matched with the struct fields of v by their Go names, or their JSON names.
+// If the server returns errors, Do returns them as a gqlerror.List.
+func (c *Client) Do(ctx context.Context, query string, vars map[string]interface{}, v interface{}) error {
+	encoded := make(map[string]interface{}, len(vars))
+	for name, value := range vars {
+		ev, err := encodeValue(reflect.ValueOf(value))
+		if err != nil {
+			return fmt.Errorf("entgql: encode variable %q: %w", name, err)
+		}
+		encoded[name] = ev
+	}
+	body, err := json.Marshal(struct {
+		Query     string                 `json:"query"`
+		Variables map[string]interface{} `json:"variables,omitempty"`
+	}{
+		Query:     query,
+		Variables: encoded,
+	})
+	if err != nil {
+		return fmt.Errorf("entgql: encode request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for k, vs := range c.header {
+		req.Header[k] = vs
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	var out struct {
+		Data   interface{}   `json:"data"`
+		Errors gqlerror.List `json:"errors"`
+	}
+	dec := json.NewDecoder(resp.Body)
+	dec.UseNumber()
+	if err := dec.Decode(&out); err != nil {
+		return fmt.Errorf("entgql: decode response (status %d): %w", resp.StatusCode, err)
+	}
+	if len(out.Errors) > 0 {
+		return out.Errors
+	}
+	if v == nil {
+		return nil
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("entgql: decode response into non-pointer %T", v)
+	}
+	if err := decodeValue(out.Data, rv.Elem()); err != nil {
+		return fmt.Errorf("entgql: decode response: %w", err)
+	}
+	return nil
+}
+
+var (
+	jsonMarshalerType   = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+)
+
+// encodeValue returns the JSON representation of the given variable value.
+func encodeValue(rv reflect.Value) (interface{}, error) {
+	if !rv.IsValid() || isNilValue(rv) {
+		return nil, nil
+	}
+	switch t := rv.Type(); {
+	case t.Implements(marshalerType):
+		var b bytes.Buffer
+		rv.Interface().(graphql.Marshaler).MarshalGQL(&b)
+		return json.RawMessage(b.Bytes()), nil
+	case t.Implements(jsonMarshalerType):
+		return rv.Interface(), nil
+	}
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return encodeValue(rv.Elem())
+	case reflect.Struct:
+		m := make(map[string]interface{})
+		for i := 0; i < rv.NumField(); i++ {
+			name, ok := fieldName(rv.Type().Field(i))
+			if !ok || isNilValue(rv.Field(i)) {
+				continue
+			}
+			v, err := encodeValue(rv.Field(i))
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %w", name, err)
+			}
+			m[name] = v
+		}
+		return m, nil
+	case reflect.Slice, reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return rv.Interface(), nil
+		}
+		vs := make([]interface{}, rv.Len())
+		for i := range vs {
+			v, err := encodeValue(rv.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			vs[i] = v
+		}
+		return vs, nil
+	case reflect.Map:
+		m := make(map[string]interface{}, rv.Len())
+		for it := rv.MapRange(); it.Next(); {
+			v, err := encodeValue(it.Value())
+			if err != nil {
+				return nil, err
+			}
+			m[fmt.Sprint(it.Key().Interface())] = v
+		}
+		return m, nil
+	default:
+		return rv.Interface(), nil
+	}
+}
+
+// decodeValue decodes the given JSON value into rv. The value is expected
+// to be decoded by a json.Decoder with the UseNumber option.
+func decodeValue(src interface{}, rv reflect.Value) error {
+	if src == nil {
+		rv.Set(reflect.Zero(rv.Type()))
+		return nil
+	}
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			rv.Set(reflect.New(rv.Type().Elem()))
+		}
+		return decodeValue(src, rv.Elem())
+	}
+	switch pt := reflect.PtrTo(rv.Type()); {
+	case pt.Implements(unmarshalerType):
+		return rv.Addr().Interface().(graphql.Unmarshaler).UnmarshalGQL(src)
+	case pt.Implements(jsonUnmarshalerType):
+		return decodeJSON(src, rv)
+	}
+	switch rv.Kind() {
+	case reflect.Struct:
+		obj, ok := src.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("cannot decode %T into %s", src, rv.Type())
+		}
+		fields := structFields(rv.Type())
+		for k, v := range obj {
+			i, ok := fields[k]
+			if !ok {
+				continue
+			}
+			if err := decodeValue(v, rv.Field(i)); err != nil {
+				return fmt.Errorf("field %s: %w", k, err)
+			}
+		}
+		return nil
+	case reflect.Slice:
+		list, ok := src.([]interface{})
+		if !ok {
+			return decodeJSON(src, rv)
+		}
+		s := reflect.MakeSlice(rv.Type(), len(list), len(list))
+		for i := range list {
+			if err := decodeValue(list[i], s.Index(i)); err != nil {
+				return err
+			}
+		}
+		rv.Set(s)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		// Integer IDs are serialized as strings.
+		if s, ok := src.(string); ok {
+			n, err := strconv.ParseInt(s, 10, rv.Type().Bits())
+			if err != nil {
+				return err
+			}
+			rv.SetInt(n)
+			return nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if s, ok := src.(string); ok {
+			n, err := strconv.ParseUint(s, 10, rv.Type().Bits())
+			if err != nil {
+				return err
+			}
+			rv.SetUint(n)
+			return nil
+		}
+	}
+	return decodeJSON(src, rv)
+}
+
+// decodeJSON decodes the given JSON value into rv using the encoding/json package.
+func decodeJSON(src interface{}, rv reflect.Value) error {
+	b, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, rv.Addr().Interface())
+}
+
+// structFields returns the indexes of the struct fields by their Go and JSON names.
+func structFields(t reflect.Type) map[string]int {
+	fields := make(map[string]int, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		if name, ok := fieldName(f); ok {
+			fields[name] = i
+		}
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if f := t.Field(i); f.PkgPath == "" {
+			fields[f.Name] = i
+		}
+	}
+	return fields
+}
+
+// fieldName returns the GraphQL name of an exported struct field. The name is
+// taken from the JSON tag of the field, or derived from its Go name otherwise.
+func fieldName(f reflect.StructField) (string, bool) {
+	if f.PkgPath != "" {
+		return "", false
+	}
+	tag := f.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+	if name := strings.Split(tag, ",")[0]; name != "" {
+		return name, true
+	}
+	r := []rune(f.Name)
+	for i := 0; i < len(r) && unicode.IsUpper(r[i]); i++ {
+		// Keep the last letter of an initialism that is followed by
+		// a lowercase letter in uppercase. e.g. "URLPath" => "urlPath".
+		if i > 0 && i+1 < len(r) && unicode.IsLower(r[i+1]) {
+			break
+		}
+		r[i] = unicode.ToLower(r[i])
+	}
+	return string(r), true
+}
+
+func isNilValue(rv reflect.Value) bool {
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map, reflect.Func, reflect.Chan:
+		return rv.IsNil()
+	}
+	return false
+}
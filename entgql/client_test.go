@@ -0,0 +1,104 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// level implements the graphql.Marshaler and graphql.Unmarshaler interfaces.
+type level int
+
+func (l level) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(fmt.Sprintf("L%d", l)))
+}
+
+func (l *level) UnmarshalGQL(v interface{}) error {
+	_, err := fmt.Sscanf(v.(string), "L%d", (*int)(l))
+	return err
+}
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+	var req struct {
+		Query     string
+		Variables map[string]interface{}
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "token", r.Header.Get("Authorization"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if req.Query == "fail" {
+			io.WriteString(w, `{"errors": [{"message": "failed"}]}`)
+			return
+		}
+		io.WriteString(w, `{"data": {"node": {"ID": "1", "Level": "L2", "createdAt": "2022-05-01T00:00:00Z", "tags": ["a"], "unknown": 1}}}`)
+	}))
+	defer srv.Close()
+	c := entgql.NewClient(srv.URL, entgql.WithHeader("Authorization", "token"))
+
+	type input struct {
+		UserID   int
+		URLPath  string
+		Level    *level
+		Skipped  *int
+		Filtered []int  `json:"-"`
+		Not      *input `json:"not,omitempty"`
+	}
+	var rsp struct {
+		Node *struct {
+			ID        int
+			Level     level
+			CreatedAt time.Time `json:"createdAt"`
+			Tags      []string  `json:"tags"`
+		} `json:"node"`
+	}
+	l := level(3)
+	err := c.Do(context.Background(), "query", map[string]interface{}{
+		"input": &input{UserID: 1, URLPath: "/", Level: &l, Filtered: []int{1}, Not: &input{}},
+		"first": (*int)(nil),
+	}, &rsp)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{
+		"input": map[string]interface{}{
+			"userID":  float64(1),
+			"urlPath": "/",
+			"level":   "L3",
+			"not":     map[string]interface{}{"userID": float64(0), "urlPath": ""},
+		},
+		"first": nil,
+	}, req.Variables)
+	require.Equal(t, 1, rsp.Node.ID)
+	require.Equal(t, level(2), rsp.Node.Level)
+	require.Equal(t, time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC), rsp.Node.CreatedAt)
+	require.Equal(t, []string{"a"}, rsp.Node.Tags)
+
+	err = c.Do(context.Background(), "fail", nil, &rsp)
+	var errs gqlerror.List
+	require.True(t, errors.As(err, &errs))
+	require.Equal(t, "failed", errs[0].Message)
+}
@@ -0,0 +1,223 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/99designs/gqlgen/complexity"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Error codes set on the errors returned by the ComplexityLimit extension.
+const (
+	ErrCodeComplexityLimit = "COMPLEXITY_LIMIT_EXCEEDED"
+	ErrCodeDepthLimit      = "DEPTH_LIMIT_EXCEEDED"
+)
+
+// ComplexityFunc computes the complexity of a field from the
+// complexity of its selection set and its arguments.
+type ComplexityFunc func(childComplexity int, args map[string]interface{}) int
+
+// ComplexityFuncs maps GraphQL object types to the complexity
+// functions of their fields. For example:
+//
+//	entgql.ComplexityFuncs{
+//		"Todo": {
+//			"children": entgql.ConnectionComplexity(100),
+//		},
+//	}
+//
+// The ComplexityFuncs of the connection and page fields generated by
+// entgql are returned by the generated ent.ComplexityFuncs function.
+type ComplexityFuncs map[string]map[string]ComplexityFunc
+
+// ConnectionComplexity returns a ComplexityFunc for Relay connections that multiplies
+// the complexity of the connection selection by the `first` or `last` arguments. The
+// given size is used as the multiplier of connections that are queried without them.
+func ConnectionComplexity(size int) ComplexityFunc {
+	return argComplexity(size, "first", "last")
+}
+
+// PageComplexity returns a ComplexityFunc for offset-paginated fields that multiplies
+// the complexity of the page selection by the `limit` argument. The given size is
+// used as the multiplier of pages that are queried without it.
+func PageComplexity(size int) ComplexityFunc {
+	return argComplexity(size, "limit")
+}
+
+// argComplexity returns a ComplexityFunc that multiplies the complexity of the
+// selection by the first argument that is provided, or by the given size.
+func argComplexity(size int, names ...string) ComplexityFunc {
+	return func(childComplexity int, args map[string]interface{}) int {
+		n := size
+		for _, name := range names {
+			if v, ok := intArg(args[name]); ok {
+				n = v
+				break
+			}
+		}
+		return safeMul(childComplexity, n)
+	}
+}
+
+// ComplexityLimit is a graphql.HandlerExtension that rejects operations
+// exceeding the configured complexity or depth before they are executed.
+//
+//	srv.Use(&entgql.ComplexityLimit{
+//		Funcs:         ent.ComplexityFuncs(100),
+//		MaxComplexity: 1000,
+//		MaxDepth:      10,
+//	})
+//
+// The complexity of fields that do not have a ComplexityFunc is computed by
+// the complexity functions configured on the gqlgen executable schema, or
+// defaults to 1 + the complexity of their selection.
+type ComplexityLimit struct {
+	// Funcs holds the complexity functions of the fields.
+	Funcs ComplexityFuncs
+	// MaxComplexity is the maximum allowed complexity of an operation.
+	// Zero means the complexity of the operations is not limited.
+	MaxComplexity int
+	// MaxDepth is the maximum allowed depth of an operation. Introspection
+	// fields are not counted. Zero means the depth is not limited.
+	MaxDepth int
+
+	es graphql.ExecutableSchema
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = (*ComplexityLimit)(nil)
+
+// ExtensionName returns the extension name.
+func (*ComplexityLimit) ExtensionName() string {
+	return "EntGQLComplexityLimit"
+}
+
+// Validate is called when adding an extension to the server, it allows validation against the servers schema.
+func (c *ComplexityLimit) Validate(es graphql.ExecutableSchema) error {
+	if c.MaxComplexity < 0 || c.MaxDepth < 0 {
+		return errors.New("entgql: complexity and depth limits must not be negative")
+	}
+	if c.MaxComplexity == 0 && c.MaxDepth == 0 {
+		return errors.New("entgql: complexity or depth limit must be set")
+	}
+	c.es = es
+	return nil
+}
+
+// MutateOperationContext rejects operations that exceed the configured limits.
+func (c *ComplexityLimit) MutateOperationContext(_ context.Context, oc *graphql.OperationContext) *gqlerror.Error {
+	op := oc.Operation
+	if op == nil {
+		return nil
+	}
+	if c.MaxDepth > 0 {
+		if depth := selectionDepth(op.SelectionSet); depth > c.MaxDepth {
+			err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", depth, c.MaxDepth)
+			errcode.Set(err, ErrCodeDepthLimit)
+			return err
+		}
+	}
+	if c.MaxComplexity > 0 {
+		es := &complexitySchema{ExecutableSchema: c.es, funcs: c.Funcs}
+		if n := complexity.Calculate(es, op, oc.Variables); n > c.MaxComplexity {
+			err := gqlerror.Errorf("operation has complexity %d, which exceeds the limit of %d", n, c.MaxComplexity)
+			errcode.Set(err, ErrCodeComplexityLimit)
+			return err
+		}
+	}
+	return nil
+}
+
+// complexitySchema wraps an executable schema and overrides
+// its complexity functions with the configured ones.
+type complexitySchema struct {
+	graphql.ExecutableSchema
+	funcs ComplexityFuncs
+}
+
+// Complexity implements the graphql.ExecutableSchema interface.
+func (s *complexitySchema) Complexity(typeName, field string, childComplexity int, args map[string]interface{}) (int, bool) {
+	if f, ok := s.funcs[typeName][field]; ok {
+		return f(childComplexity, args), true
+	}
+	return s.ExecutableSchema.Complexity(typeName, field, childComplexity, args)
+}
+
+// selectionDepth returns the maximum depth of the given selection set.
+func selectionDepth(set ast.SelectionSet) int {
+	var depth int
+	for _, sel := range set {
+		var d int
+		switch sel := sel.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(sel.Name, "__") {
+				continue
+			}
+			d = 1 + selectionDepth(sel.SelectionSet)
+		case *ast.FragmentSpread:
+			if sel.Definition != nil {
+				d = selectionDepth(sel.Definition.SelectionSet)
+			}
+		case *ast.InlineFragment:
+			d = selectionDepth(sel.SelectionSet)
+		}
+		if d > depth {
+			depth = d
+		}
+	}
+	return depth
+}
+
+// intArg returns the integer value of a field argument.
+func intArg(v interface{}) (int, bool) {
+	switch v := v.(type) {
+	case int:
+		return v, true
+	case *int:
+		if v != nil {
+			return *v, true
+		}
+	case int64:
+		return int(v), true
+	case float64:
+		return int(v), true
+	case json.Number:
+		n, err := v.Int64()
+		return int(n), err == nil
+	}
+	return 0, false
+}
+
+// safeMul is a saturating multiplication of non-negative integers.
+func safeMul(a, b int) int {
+	const maxInt = int(^uint(0) >> 1)
+	if a <= 0 || b <= 0 {
+		return 0
+	}
+	if a > maxInt/b {
+		return maxInt
+	}
+	return a * b
+}
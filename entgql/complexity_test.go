@@ -0,0 +1,53 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"entgo.io/contrib/entgql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectionComplexity(t *testing.T) {
+	f := entgql.ConnectionComplexity(100)
+	require.Equal(t, 300, f(3, nil))
+	require.Equal(t, 30, f(3, map[string]interface{}{"first": 10}))
+	require.Equal(t, 15, f(3, map[string]interface{}{"last": int64(5)}))
+	require.Equal(t, 6, f(3, map[string]interface{}{"first": json.Number("2")}))
+	first := 4
+	require.Equal(t, 12, f(3, map[string]interface{}{"first": &first, "last": nil}))
+	require.Equal(t, 0, f(3, map[string]interface{}{"first": 0}))
+	require.Equal(t, math.MaxInt, f(math.MaxInt/2, map[string]interface{}{"first": 3}))
+}
+
+func TestPageComplexity(t *testing.T) {
+	f := entgql.PageComplexity(100)
+	require.Equal(t, 300, f(3, nil))
+	require.Equal(t, 30, f(3, map[string]interface{}{"limit": 10}))
+	require.Equal(t, 300, f(3, map[string]interface{}{"offset": 10}))
+	require.Equal(t, 0, f(3, map[string]interface{}{"limit": 0}))
+}
+
+func TestComplexityLimit_Validate(t *testing.T) {
+	err := (&entgql.ComplexityLimit{}).Validate(nil)
+	require.EqualError(t, err, "entgql: complexity or depth limit must be set")
+	err = (&entgql.ComplexityLimit{MaxDepth: -1}).Validate(nil)
+	require.EqualError(t, err, "entgql: complexity and depth limits must not be negative")
+	err = (&entgql.ComplexityLimit{MaxDepth: 10}).Validate(nil)
+	require.NoError(t, err)
+}
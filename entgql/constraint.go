@@ -0,0 +1,128 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sync"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// ConstraintDirective implements the @constraint directive that is generated for the
+// fields of the mutation inputs that have constraints declared by the Constraint
+// annotation, and for string fields with a maximum length set by the MaxLen validator.
+// Invalid values fail the operation with an ErrInvalidInput error before the mutation
+// is resolved.
+//
+//	srv := handler.NewDefaultServer(NewExecutableSchema(Config{
+//		Resolvers: &Resolver{client},
+//		Directives: DirectiveRoot{
+//			Constraint: entgql.ConstraintDirective,
+//		},
+//	}))
+func ConstraintDirective(ctx context.Context, _ interface{}, next graphql.Resolver, minLength, maxLength *int, min, max *float64, pattern *string) (interface{}, error) {
+	v, err := next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c := &ConstraintConfig{MinLength: minLength, MaxLength: maxLength, Min: min, Max: max, Pattern: pattern}
+	if err := c.check(v); err != nil {
+		return nil, ErrInvalidInput(argumentPath(ctx), err)
+	}
+	return v, nil
+}
+
+// argumentPath returns the path of the input field in the
+// context, relative to the arguments of the resolved field.
+func argumentPath(ctx context.Context) ast.Path {
+	path := graphql.GetPath(ctx)
+	if fc := graphql.GetFieldContext(ctx); fc != nil {
+		if p := fc.Path(); len(p) <= len(path) {
+			path = path[len(p):]
+		}
+	}
+	return path
+}
+
+// check checks the given string or numeric value (or a pointer to it) against the constraints.
+func (c *ConstraintConfig) check(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	switch rv.Kind() {
+	case reflect.String:
+		s := rv.String()
+		if c.MinLength != nil && len(s) < *c.MinLength {
+			return errors.New("value is less than the required length")
+		}
+		if c.MaxLength != nil && len(s) > *c.MaxLength {
+			return errors.New("value is greater than the required length")
+		}
+		if c.Pattern != nil {
+			re, err := compilePattern(*c.Pattern)
+			if err != nil {
+				return fmt.Errorf("invalid constraint pattern: %w", err)
+			}
+			if !re.MatchString(s) {
+				return fmt.Errorf("value does not match the pattern %q", *c.Pattern)
+			}
+		}
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return c.checkRange(float64(rv.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return c.checkRange(float64(rv.Uint()))
+	case reflect.Float32, reflect.Float64:
+		return c.checkRange(rv.Float())
+	default:
+		return nil
+	}
+}
+
+// patterns caches the compiled regular expressions of the constraint patterns.
+var patterns sync.Map
+
+// compilePattern returns the compiled regular expression of the given pattern.
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	if re, ok := patterns.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	patterns.Store(pattern, re)
+	return re, nil
+}
+
+func (c *ConstraintConfig) checkRange(v float64) error {
+	if c.Min != nil && v < *c.Min {
+		return errors.New("value is less than the minimum")
+	}
+	if c.Max != nil && v > *c.Max {
+		return errors.New("value is greater than the maximum")
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func TestConstraint(t *testing.T) {
+	f := &gen.Field{Name: "name", Type: &field.TypeInfo{Type: field.TypeString}, Validators: 1}
+	require.Nil(t, constraint(f, &Annotation{}))
+
+	ant := Constraint().MinLength(1).MaxLength(64).Min(-1.5).Max(10).Pattern(`^\w+$`).Annotation
+	d := constraint(f, &ant)
+	require.NotNil(t, d)
+	var b strings.Builder
+	formatter.NewFormatter(&b).FormatSchemaDocument(&ast.SchemaDocument{
+		Definitions: ast.DefinitionList{{
+			Kind: ast.InputObject,
+			Name: "CreateUserInput",
+			Fields: ast.FieldList{{
+				Name:       "name",
+				Type:       ast.NonNullNamedType("String", nil),
+				Directives: ast.DirectiveList{d},
+			}},
+		}},
+	})
+	require.Equal(t, "input CreateUserInput {\n\tname: String! @constraint(minLength: 1, maxLength: 64, min: -1.5, max: 10, pattern: \"^\\\\w+$\")\n}\n", b.String())
+}
+
+func TestConstraintDirective(t *testing.T) {
+	ctx := graphql.WithPathContext(context.Background(), graphql.NewPathWithField("input"))
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+	value := func(v interface{}) graphql.Resolver {
+		return func(context.Context) (interface{}, error) { return v, nil }
+	}
+	minLength, maxLength, min, max, pattern := 2, 4, 1.0, 10.0, "^[a-z]+$"
+
+	v, err := ConstraintDirective(ctx, nil, value("ab"), &minLength, &maxLength, nil, nil, &pattern)
+	require.NoError(t, err)
+	require.Equal(t, "ab", v)
+	_, err = ConstraintDirective(ctx, nil, value((*string)(nil)), &minLength, nil, nil, nil, nil)
+	require.NoError(t, err)
+	s := "abcde"
+	_, err = ConstraintDirective(ctx, nil, value(&s), nil, &maxLength, nil, nil, nil)
+	gqlErr, ok := err.(*gqlerror.Error)
+	require.True(t, ok)
+	require.Equal(t, "input.name: value is greater than the required length", gqlErr.Message)
+	require.Equal(t, CodeInvalidInput, gqlErr.Extensions["code"])
+	require.Equal(t, ast.Path{ast.PathName("input"), ast.PathName("name")}, gqlErr.Extensions["inputPath"])
+	_, err = ConstraintDirective(ctx, nil, value("AB"), nil, nil, nil, nil, &pattern)
+	require.EqualError(t, err, `input: input.name: value does not match the pattern "^[a-z]+$"`)
+
+	_, err = ConstraintDirective(ctx, nil, value(5), nil, nil, &min, &max, nil)
+	require.NoError(t, err)
+	_, err = ConstraintDirective(ctx, nil, value(int64(0)), nil, nil, &min, &max, nil)
+	require.EqualError(t, err, "input: input.name: value is less than the minimum")
+	_, err = ConstraintDirective(ctx, nil, value(10.5), nil, nil, &min, &max, nil)
+	require.EqualError(t, err, "input: input.name: value is greater than the maximum")
+}
+
+func TestCompilePattern(t *testing.T) {
+	re1, err := compilePattern("^[a-z]+$")
+	require.NoError(t, err)
+	re2, err := compilePattern("^[a-z]+$")
+	require.NoError(t, err)
+	require.Same(t, re1, re2)
+	_, err = compilePattern("[a-z")
+	require.Error(t, err)
+}
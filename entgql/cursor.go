@@ -0,0 +1,152 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrInvalidCursor is returned by the cursor codecs when
+// a cursor is malformed or was tampered with.
+var ErrInvalidCursor = errors.New("entgql: invalid cursor")
+
+// CursorCodec encodes the pagination cursors into the opaque strings sent to
+// the clients, and decodes them back. The generated Cursor type uses the codec
+// installed by the SetCursorCodec function when the WithCursorCodec option is set.
+type CursorCodec interface {
+	// Encode returns the string representation of the encoded cursor.
+	Encode(data []byte) string
+	// Decode returns the encoded cursor of the given string, or
+	// ErrInvalidCursor if it is malformed or was tampered with.
+	Decode(s string) ([]byte, error)
+}
+
+// Base64CursorCodec is the default cursor codec. It encodes the cursors using
+// base64, and therefore, clients can decode and forge them. Use it only if the
+// ordering values are not sensitive.
+type Base64CursorCodec struct{}
+
+// Encode implements the CursorCodec interface.
+func (Base64CursorCodec) Encode(data []byte) string {
+	return base64.RawStdEncoding.EncodeToString(data)
+}
+
+// Decode implements the CursorCodec interface.
+func (Base64CursorCodec) Decode(s string) ([]byte, error) {
+	data, err := base64.RawStdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+	return data, nil
+}
+
+// hmacCodec signs the cursors using HMAC-SHA256.
+type hmacCodec struct {
+	key []byte
+}
+
+// NewHMACCursorCodec returns a cursor codec that signs the cursors using HMAC-SHA256
+// with the given key. Signed cursors cannot be forged by clients, but their values
+// are still readable. Use NewAESCursorCodec for hiding the values of the cursors.
+// An error is returned if the key is empty.
+func NewHMACCursorCodec(key []byte) (CursorCodec, error) {
+	if len(key) == 0 {
+		return nil, errors.New("entgql: cursor signing key cannot be empty")
+	}
+	return &hmacCodec{key: key}, nil
+}
+
+// Encode implements the CursorCodec interface.
+func (c *hmacCodec) Encode(data []byte) string {
+	b := make([]byte, 0, len(data)+sha256.Size)
+	b = append(append(b, data...), c.sum(data)...)
+	return base64.RawStdEncoding.EncodeToString(b)
+}
+
+// Decode implements the CursorCodec interface.
+func (c *hmacCodec) Decode(s string) ([]byte, error) {
+	b, err := base64.RawStdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+	if len(b) < sha256.Size {
+		return nil, ErrInvalidCursor
+	}
+	data, sig := b[:len(b)-sha256.Size], b[len(b)-sha256.Size:]
+	if !hmac.Equal(sig, c.sum(data)) {
+		return nil, ErrInvalidCursor
+	}
+	return data, nil
+}
+
+func (c *hmacCodec) sum(data []byte) []byte {
+	h := hmac.New(sha256.New, c.key)
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+// aesCodec encrypts the cursors using AES-GCM.
+type aesCodec struct {
+	aead cipher.AEAD
+}
+
+// NewAESCursorCodec returns a cursor codec that encrypts the cursors using AES-GCM
+// with the given key. The key must be 16, 24 or 32 bytes long for selecting AES-128,
+// AES-192 or AES-256. Encrypted cursors cannot be read or forged by clients.
+func NewAESCursorCodec(key []byte) (CursorCodec, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("entgql: create cursor cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("entgql: create cursor cipher: %w", err)
+	}
+	return &aesCodec{aead: aead}, nil
+}
+
+// Encode implements the CursorCodec interface.
+func (c *aesCodec) Encode(data []byte) string {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		panic(fmt.Sprintf("entgql: generate cursor nonce: %v", err))
+	}
+	return base64.RawStdEncoding.EncodeToString(c.aead.Seal(nonce, nonce, data, nil))
+}
+
+// Decode implements the CursorCodec interface.
+func (c *aesCodec) Decode(s string) ([]byte, error) {
+	b, err := base64.RawStdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+	n := c.aead.NonceSize()
+	if len(b) < n {
+		return nil, ErrInvalidCursor
+	}
+	data, err := c.aead.Open(nil, b[:n], b[n:], nil)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return data, nil
+}
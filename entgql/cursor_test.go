@@ -0,0 +1,81 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"testing"
+
+	"entgo.io/contrib/entgql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursorCodecs(t *testing.T) {
+	t.Parallel()
+	aes, err := entgql.NewAESCursorCodec([]byte("0123456789abcdef"))
+	require.NoError(t, err)
+	_, err = entgql.NewAESCursorCodec([]byte("short"))
+	require.Error(t, err)
+	hmac, err := entgql.NewHMACCursorCodec([]byte("secret"))
+	require.NoError(t, err)
+	_, err = entgql.NewHMACCursorCodec(nil)
+	require.Error(t, err)
+	_, err = entgql.NewHMACCursorCodec([]byte{})
+	require.Error(t, err)
+
+	data := []byte("cursor")
+	for name, codec := range map[string]entgql.CursorCodec{
+		"Base64": entgql.Base64CursorCodec{},
+		"HMAC":   hmac,
+		"AES":    aes,
+	} {
+		codec := codec
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			s := codec.Encode(data)
+			got, err := codec.Decode(s)
+			require.NoError(t, err)
+			require.Equal(t, data, got)
+
+			_, err = codec.Decode("!")
+			require.ErrorIs(t, err, entgql.ErrInvalidCursor)
+		})
+	}
+
+	t.Run("Tamper", func(t *testing.T) {
+		t.Parallel()
+		for _, codec := range []entgql.CursorCodec{hmac, aes} {
+			b, err := base64.RawStdEncoding.DecodeString(codec.Encode(data))
+			require.NoError(t, err)
+			b[0] ^= 1
+			_, err = codec.Decode(base64.RawStdEncoding.EncodeToString(b))
+			require.ErrorIs(t, err, entgql.ErrInvalidCursor)
+			_, err = codec.Decode("")
+			require.ErrorIs(t, err, entgql.ErrInvalidCursor)
+		}
+		// Signed cursors are readable, but encrypted cursors are not.
+		b, err := base64.RawStdEncoding.DecodeString(hmac.Encode(data))
+		require.NoError(t, err)
+		require.True(t, bytes.HasPrefix(b, data))
+		b, err = base64.RawStdEncoding.DecodeString(aes.Encode(data))
+		require.NoError(t, err)
+		require.False(t, bytes.Contains(b, data))
+		other, err := entgql.NewHMACCursorCodec([]byte("other"))
+		require.NoError(t, err)
+		_, err = other.Decode(hmac.Encode(data))
+		require.ErrorIs(t, err, entgql.ErrInvalidCursor)
+	})
+}
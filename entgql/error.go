@@ -16,6 +16,7 @@ package entgql
 
 import (
 	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
@@ -25,3 +26,17 @@ func ErrNodeNotFound(id interface{}) *gqlerror.Error {
 	errcode.Set(err, "NOT_FOUND")
 	return err
 }
+
+// CodeInvalidInput is the extension code of the errors
+// that are returned for invalid mutation input fields.
+const CodeInvalidInput = "INVALID_INPUT"
+
+// ErrInvalidInput creates an invalid input graphql error for the input field at
+// the given path (e.g. input.text). The path is also added to the "inputPath"
+// extension of the error.
+func ErrInvalidInput(path ast.Path, err error) *gqlerror.Error {
+	gqlErr := gqlerror.Errorf("%s: %v", path, err)
+	errcode.Set(gqlErr, CodeInvalidInput)
+	gqlErr.Extensions["inputPath"] = path
+	return gqlErr
+}
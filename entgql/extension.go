@@ -15,6 +15,7 @@
 package entgql
 
 import (
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -36,6 +37,14 @@ type (
 		path      string
 		hooks     []gen.Hook
 		templates []*gen.Template
+		// checkChanges indicates if the generated schema is compared to
+		// the schema file before it is written, and allowedChanges holds
+		// the paths of the breaking changes that are allowed.
+		checkChanges   bool
+		allowedChanges map[string]bool
+		// profiles holds the schema variants that are
+		// written alongside the schema. See WithSchemaProfile.
+		profiles []*schemaProfile
 	}
 
 	// ExtensionOption allows for managing the Extension configuration
@@ -62,6 +71,70 @@ func WithSchemaPath(path string) ExtensionOption {
 	}
 }
 
+// WithBreakingChangeCheck configures the extension to compare the generated GraphQL schema
+// to the existing file in the schema path (see WithSchemaPath) before it is overwritten. The
+// code generation fails if the new schema contains breaking changes, such as removing a field,
+// an argument or an enum value, making an argument or an input field required, or making
+// an output field nullable. A missing schema file is not checked.
+//
+// Breaking changes can be allowed by their paths, such as "Todo" for a type, "Todo.text" for a
+// field, "TodoStatus.COMPLETED" for an enum value, or "Query.todos.first" for an argument:
+//
+//	ex, err := entgql.NewExtension(
+//		entgql.WithSchemaGenerator(),
+//		entgql.WithSchemaPath("../ent.graphql"),
+//		entgql.WithBreakingChangeCheck("Todo.blob"),
+//	)
+//
+// Removing fields, arguments or enum values that were marked as deprecated in the previous
+// schema (e.g. using the entgql.Deprecated directive) is not considered a breaking change.
+func WithBreakingChangeCheck(allowed ...string) ExtensionOption {
+	return func(ex *Extension) error {
+		ex.checkChanges = true
+		if ex.allowedChanges == nil {
+			ex.allowedChanges = make(map[string]bool)
+		}
+		for _, path := range allowed {
+			ex.allowedChanges[path] = true
+		}
+		return nil
+	}
+}
+
+// WithSchemaProfile defines a variant of the generated GraphQL schema that is written
+// to the given path, in addition to the schema file (see WithSchemaPath). The profile
+// schema is derived from the same BuildSchema pass and holds only the types, fields
+// and edges that are visible in the profile. Elements are limited to a set of profiles
+// using the entgql.Profiles annotation, and elements without it are visible in all
+// profiles. For example:
+//
+//	ex, err := entgql.NewExtension(
+//		entgql.WithSchemaGenerator(),
+//		entgql.WithSchemaPath("../internal.graphql"),
+//		entgql.WithSchemaProfile("public", "../public.graphql"),
+//	)
+//
+// The schema file always holds the full schema, and the Go code is generated from it.
+// Therefore, every gqlgen executable schema of a profile should be configured with
+// its own gqlgen.yml that resolves to the same ent package.
+func WithSchemaProfile(name, path string) ExtensionOption {
+	return func(ex *Extension) error {
+		if name == "" || path == "" {
+			return errors.New("entgql: schema profile must have a name and a path")
+		}
+		for _, p := range ex.profiles {
+			switch {
+			case p.name == name:
+				return fmt.Errorf("entgql: schema profile %q is already defined", name)
+			case filepath.Clean(p.path) == filepath.Clean(path):
+				return fmt.Errorf("entgql: schema profiles %q and %q have the same path %q", p.name, name, path)
+			}
+		}
+		ex.profiles = append(ex.profiles, &schemaProfile{name: name, path: path})
+		return nil
+	}
+}
+
 // WithSchemaHook allows users to provide a list of hooks
 // to run after the GQL schema generation.
 func WithSchemaHook(hooks ...SchemaHook) ExtensionOption {
@@ -142,7 +215,12 @@ func WithRelaySpec(enabled bool) ExtensionOption {
 	}
 }
 
-// WithSchemaGenerator add a hook for generate GQL schema
+// WithSchemaGenerator add a hook for generate GQL schema.
+//
+// The GraphQL object and input types of JSON fields with struct types (e.g. field.JSON("config",
+// &Config{})) are derived from their Go types, unless the fields are mapped to other types using
+// the entgql.Type annotation or the gqlgen.yml models. The names of the GraphQL fields are taken
+// from the "json" tags, and pointer, slice, map and "omitempty" fields are nullable.
 func WithSchemaGenerator() ExtensionOption {
 	return func(e *Extension) error {
 		e.genSchema = true
@@ -150,6 +228,78 @@ func WithSchemaGenerator() ExtensionOption {
 	}
 }
 
+// WithFederation enables the Apollo Federation (v2) support. When enabled, the types in the
+// generated schema are marked as federated entities, keyed by their "id" field or by the key
+// defined using the entgql.Key directive, and the FederationTemplate is added to the code
+// generation templates for generating the Go methods required by the entity resolvers.
+//
+// Note that the gqlgen federation plugin should be enabled, and its @entityResolver
+// directive should be skipped at runtime:
+//
+//	federation:
+//	  filename: federation.go
+//	  package: graph
+//	  version: 2
+//	directives:
+//	  entityResolver:
+//	    skip_runtime: true
+//
+// The generated Entities method of each client can be used to resolve
+// all representations of an entity type in one query:
+//
+//	func (r *entityResolver) FindManyTodoByIDs(ctx context.Context, reps []*TodoByIDsInput) ([]*ent.Todo, error) {
+//		ids := make([]int, len(reps))
+//		for i := range reps {
+//			ids[i] = reps[i].ID
+//		}
+//		return r.client.Todo.Entities(ctx, ids...)
+//	}
+func WithFederation() ExtensionOption {
+	return func(ex *Extension) error {
+		ex.federation = true
+		ex.templates = append(ex.templates, FederationTemplate)
+		return nil
+	}
+}
+
+// WithCursorCodec enables the installation of a codec for the pagination cursors. By default,
+// cursors are base64-encoded, and therefore, clients can decode and forge them, and read the
+// values of the ordering fields. When enabled, the CursorTemplate is added to the code generation,
+// and the codec of the cursors can be set using the generated SetCursorCodec function:
+//
+//	codec, err := entgql.NewAESCursorCodec(key)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	ent.SetCursorCodec(codec)
+//
+// Cursors that cannot be decoded (e.g. tampered cursors) are rejected with
+// the INVALID_PAGINATION error code.
+func WithCursorCodec() ExtensionOption {
+	return func(ex *Extension) error {
+		ex.templates = append(ex.templates, CursorTemplate)
+		return nil
+	}
+}
+
+// WithGoClient adds the ClientTemplate to the code generation. The template generates a typed
+// Go client of the GraphQL schema in the gqlclient package, with a fragment for each type,
+// Query<T>s methods for the connection fields defined by the QueryField annotation, and
+// methods for the mutation fields defined by the MutationFields annotation.
+//
+//	c := gqlclient.NewClient("http://localhost:8081/query")
+//	conn, err := c.QueryTodos(ctx, &first, nil, &ent.TodoWhereInput{Status: &status}, nil)
+//	if err != nil {
+//		return err
+//	}
+//	todo, err := c.CreateTodo(ctx, ent.CreateTodoInput{Text: "text"})
+func WithGoClient() ExtensionOption {
+	return func(ex *Extension) error {
+		ex.templates = append(ex.templates, ClientTemplate)
+		return nil
+	}
+}
+
 // WithMapScalarFunc allows users to provide a custom function that
 // maps an ent.Field (*gen.Field) into its GraphQL scalar type. If the
 // function returns an empty string, the extension fallbacks to its
@@ -172,6 +322,26 @@ func WithMapScalarFunc(scalarFunc func(*gen.Field, gen.Op) string) ExtensionOpti
 	}
 }
 
+// WithWhereOps allows users to provide a function that returns the additional operators
+// of the fields in the generated <T>WhereInput types. The returned operators are merged
+// with the operators defined by the WhereOps annotation of the field.
+//
+//	ex, err := entgql.NewExtension(
+//		entgql.WithWhereInputs(true),
+//		entgql.WithWhereOps(func(f *gen.Field) []entgql.WhereOp {
+//			if f.IsString() && !f.ConvertedToBasic() {
+//				return []entgql.WhereOp{entgql.OpContainsFold}
+//			}
+//			return nil
+//		}),
+//	)
+func WithWhereOps(opsFunc func(*gen.Field) []WhereOp) ExtensionOption {
+	return func(ex *Extension) error {
+		ex.hooks = append(ex.hooks, whereOpsHook(opsFunc))
+		return nil
+	}
+}
+
 // NewExtension creates a new extension with the given configuration.
 //
 //	ex, err := entgql.NewExtension(
@@ -209,19 +379,83 @@ func (e *Extension) Hooks() []gen.Hook {
 func (e *Extension) genSchemaHook() gen.Hook {
 	return func(next gen.Generator) gen.Generator {
 		return gen.GenerateFunc(func(g *gen.Graph) (err error) {
-			if err = next.Generate(g); err != nil {
-				return err
-			}
-
 			if e.path == "" || !(e.genSchema || e.genWhereInput || e.genMutations) {
-				return nil
+				return next.Generate(g)
 			}
-
-			schema, err := e.BuildSchema(g)
+			// The schemas are checked for breaking changes before running the
+			// code generation, in order to keep the generated code and schemas
+			// in sync. Otherwise, they are built after the code generation.
+			if !e.checkChanges {
+				if err = next.Generate(g); err != nil {
+					return err
+				}
+			}
+			schemas, err := e.buildSchemas(g)
 			if err != nil {
 				return err
 			}
-			return ioutil.WriteFile(e.path, []byte(printSchema(schema)), 0644)
+			if e.checkChanges {
+				for path, s := range schemas {
+					if err = checkBreakingChanges(path, s, e.allowedChanges); err != nil {
+						return err
+					}
+				}
+				if err = next.Generate(g); err != nil {
+					return err
+				}
+			}
+			for path, s := range schemas {
+				if err = ioutil.WriteFile(path, []byte(printSchema(s)), 0644); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+}
+
+// buildSchemas builds the schema of the graph and its profile
+// schemas, keyed by the paths they are written to.
+func (e *Extension) buildSchemas(g *gen.Graph) (map[string]*ast.Schema, error) {
+	schema, err := e.BuildSchema(g)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkProfiles(e.profileNames(), e.profiles); err != nil {
+		return nil, err
+	}
+	schemas := map[string]*ast.Schema{e.path: schema}
+	for _, p := range e.profiles {
+		if filepath.Clean(p.path) == filepath.Clean(e.path) {
+			return nil, fmt.Errorf("entgql: schema profile %q has the same path as the schema %q", p.name, e.path)
+		}
+		schemas[p.path] = e.profileSchema(schema, p.name)
+	}
+	return schemas, nil
+}
+
+// whereOpsHook returns a new hook for adding the operators
+// returned by the given function to the field annotations.
+func whereOpsHook(opsFunc func(*gen.Field) []WhereOp) gen.Hook {
+	return func(next gen.Generator) gen.Generator {
+		return gen.GenerateFunc(func(g *gen.Graph) error {
+			for _, n := range g.Nodes {
+				for _, f := range allFields(n) {
+					ops := opsFunc(f)
+					if len(ops) == 0 {
+						continue
+					}
+					ant, err := annotation(f.Annotations)
+					if err != nil {
+						return err
+					}
+					if f.Annotations == nil {
+						f.Annotations = make(gen.Annotations)
+					}
+					f.Annotations[ant.Name()] = ant.Merge(WhereOps(ops...))
+				}
+			}
+			return next.Generate(g)
 		})
 	}
 }
@@ -0,0 +1,139 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithWhereOps(t *testing.T) {
+	ex, err := NewExtension(WithWhereOps(func(f *gen.Field) []WhereOp {
+		if f.IsString() {
+			return []WhereOp{OpContainsFold}
+		}
+		return nil
+	}))
+	require.NoError(t, err)
+	text := &gen.Field{
+		Name: "text",
+		Type: &field.TypeInfo{Type: field.TypeString},
+		Annotations: map[string]interface{}{
+			annotationName: WhereOps(OpEqualFold),
+		},
+	}
+	name := &gen.Field{Name: "name", Type: &field.TypeInfo{Type: field.TypeString}}
+	priority := &gen.Field{Name: "priority", Type: &field.TypeInfo{Type: field.TypeInt}}
+	g := &gen.Graph{
+		Nodes: []*gen.Type{
+			{Name: "Todo", Fields: []*gen.Field{text, name, priority}},
+		},
+	}
+	var called bool
+	next := gen.GenerateFunc(func(*gen.Graph) error {
+		called = true
+		return nil
+	})
+	require.NoError(t, ex.Hooks()[0](next).Generate(g))
+	require.True(t, called)
+	for _, tt := range []struct {
+		f   *gen.Field
+		ops []WhereOp
+	}{
+		{text, []WhereOp{OpEqualFold, OpContainsFold}},
+		{name, []WhereOp{OpContainsFold}},
+		{priority, nil},
+	} {
+		ant, err := annotation(tt.f.Annotations)
+		require.NoError(t, err)
+		require.Equal(t, tt.ops, ant.WhereOps, tt.f.Name)
+	}
+}
+
+func TestWithFederation(t *testing.T) {
+	ex, err := NewExtension(WithFederation())
+	require.NoError(t, err)
+	require.True(t, ex.federation)
+	require.Equal(t, FederationTemplate, ex.Templates()[len(ex.Templates())-1])
+}
+
+func TestWithBreakingChangeCheck(t *testing.T) {
+	ex, err := NewExtension(WithBreakingChangeCheck("Todo.text"), WithBreakingChangeCheck("Todo.blob"))
+	require.NoError(t, err)
+	require.True(t, ex.checkChanges)
+	require.Equal(t, map[string]bool{"Todo.text": true, "Todo.blob": true}, ex.allowedChanges)
+}
+
+func TestWithSchemaProfile(t *testing.T) {
+	ex, err := NewExtension(WithSchemaProfile("public", "public.graphql"), WithSchemaProfile("support", "support.graphql"))
+	require.NoError(t, err)
+	require.Equal(t, []*schemaProfile{{name: "public", path: "public.graphql"}, {name: "support", path: "support.graphql"}}, ex.profiles)
+	_, err = NewExtension(WithSchemaProfile("public", "public.graphql"), WithSchemaProfile("public", "other.graphql"))
+	require.EqualError(t, err, `entgql: schema profile "public" is already defined`)
+	_, err = NewExtension(WithSchemaProfile("public", "public.graphql"), WithSchemaProfile("support", "./public.graphql"))
+	require.EqualError(t, err, `entgql: schema profiles "public" and "support" have the same path "./public.graphql"`)
+	_, err = NewExtension(WithSchemaProfile("public", ""))
+	require.Error(t, err)
+
+	ex, err = NewExtension(WithSchemaGenerator(), WithSchemaPath("ent.graphql"), WithSchemaProfile("public", "ent.graphql"))
+	require.NoError(t, err)
+	_, err = ex.buildSchemas(&gen.Graph{Config: &gen.Config{Package: "example.com/ent"}})
+	require.EqualError(t, err, `entgql: schema profile "public" has the same path as the schema "ent.graphql"`)
+}
+
+func TestGenSchemaHook(t *testing.T) {
+	graph := func(name string) *gen.Graph {
+		return &gen.Graph{
+			Config: &gen.Config{Package: "example.com/ent"},
+			Nodes: []*gen.Type{
+				{
+					Name:        "Todo",
+					ID:          &gen.Field{Name: "id", Type: &field.TypeInfo{Type: field.TypeInt}},
+					Fields:      []*gen.Field{{Name: name, Type: &field.TypeInfo{Type: field.TypeString}}},
+					Annotations: map[string]interface{}{annotationName: QueryField()},
+				},
+			},
+		}
+	}
+	path := filepath.Join(t.TempDir(), "ent.graphql")
+	var exists bool
+	next := gen.GenerateFunc(func(*gen.Graph) error {
+		_, err := os.Stat(path)
+		exists = err == nil
+		return nil
+	})
+
+	// The schema is written after the code generation.
+	ex, err := NewExtension(WithSchemaGenerator(), WithSchemaPath(path))
+	require.NoError(t, err)
+	require.NoError(t, ex.genSchemaHook()(next).Generate(graph("text")))
+	require.False(t, exists)
+	require.FileExists(t, path)
+
+	// Breaking changes fail the generation before the code is generated.
+	ex, err = NewExtension(WithSchemaGenerator(), WithSchemaPath(path), WithBreakingChangeCheck())
+	require.NoError(t, err)
+	exists = false
+	err = ex.genSchemaHook()(next).Generate(graph("title"))
+	require.Error(t, err)
+	require.False(t, exists, "code generation should not run")
+	require.NoError(t, ex.genSchemaHook()(next).Generate(graph("text")))
+	require.True(t, exists)
+}
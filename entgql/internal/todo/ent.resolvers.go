@@ -23,6 +23,42 @@ import (
 	"entgo.io/contrib/entgql/internal/todo/ent"
 )
 
+func (r *mutationResolver) CreateCategory(ctx context.Context, input ent.CreateCategoryInput) (*ent.Category, error) {
+	return r.client.MutationResolver().CreateCategory(ctx, input)
+}
+
+func (r *mutationResolver) DeleteCategory(ctx context.Context, id int) (int, error) {
+	return r.client.MutationResolver().DeleteCategory(ctx, id)
+}
+
+func (r *mutationResolver) UpsertCategory(ctx context.Context, input ent.UpsertCategoryInput) (*ent.Category, error) {
+	return r.client.MutationResolver().UpsertCategory(ctx, input)
+}
+
+func (r *mutationResolver) CreateTodo(ctx context.Context, input ent.CreateTodoInput) (*ent.Todo, error) {
+	return r.client.MutationResolver().CreateTodo(ctx, input)
+}
+
+func (r *mutationResolver) UpdateTodo(ctx context.Context, id int, input ent.UpdateTodoInput) (*ent.Todo, error) {
+	return r.client.MutationResolver().UpdateTodo(ctx, id, input)
+}
+
+func (r *mutationResolver) DeleteTodo(ctx context.Context, id int) (int, error) {
+	return r.client.MutationResolver().DeleteTodo(ctx, id)
+}
+
+func (r *mutationResolver) CreateTodos(ctx context.Context, inputs []*ent.CreateTodoInput) ([]*ent.Todo, error) {
+	return r.client.MutationResolver().CreateTodos(ctx, inputs)
+}
+
+func (r *mutationResolver) UpdateTodos(ctx context.Context, where ent.TodoWhereInput, input ent.UpdateTodoInput) (int, error) {
+	return r.client.MutationResolver().UpdateTodos(ctx, where, input)
+}
+
+func (r *mutationResolver) DeleteTodos(ctx context.Context, where ent.TodoWhereInput) (int, error) {
+	return r.client.MutationResolver().DeleteTodos(ctx, where)
+}
+
 func (r *queryResolver) Node(ctx context.Context, id int) (ent.Noder, error) {
 	return r.client.Noder(ctx, id)
 }
@@ -38,14 +74,23 @@ func (r *queryResolver) Groups(ctx context.Context, after *ent.Cursor, first *in
 		)
 }
 
-func (r *queryResolver) Todos(ctx context.Context, after *ent.Cursor, first *int, before *ent.Cursor, last *int, orderBy *ent.TodoOrder, where *ent.TodoWhereInput) (*ent.TodoConnection, error) {
+func (r *queryResolver) Todos(ctx context.Context, after *ent.Cursor, first *int, before *ent.Cursor, last *int, orderBy []*ent.TodoOrder, where *ent.TodoWhereInput, includeDeleted *bool) (*ent.TodoConnection, error) {
 	return r.client.Todo.Query().
 		Paginate(ctx, after, first, before, last,
 			ent.WithTodoOrder(orderBy),
 			ent.WithTodoFilter(where.Filter),
+			ent.WithTodoIncludeDeleted(includeDeleted),
 		)
 }
 
+func (r *queryResolver) TodosAggregate(ctx context.Context, where *ent.TodoWhereInput, groupBy []ent.TodoGroupField) ([]*ent.TodoAggregate, error) {
+	query, err := where.Filter(r.client.Todo.Query())
+	if err != nil {
+		return nil, err
+	}
+	return query.Aggregates(ctx, groupBy)
+}
+
 func (r *queryResolver) Users(ctx context.Context, after *ent.Cursor, first *int, before *ent.Cursor, last *int, where *ent.UserWhereInput) (*ent.UserConnection, error) {
 	return r.client.User.Query().
 		Paginate(ctx, after, first, before, last,
@@ -53,11 +98,42 @@ func (r *queryResolver) Users(ctx context.Context, after *ent.Cursor, first *int
 		)
 }
 
+func (r *queryResolver) UsersPage(ctx context.Context, offset *int, limit *int, where *ent.UserWhereInput) (*ent.UserPage, error) {
+	return r.client.User.Query().
+		PaginateOffset(ctx, offset, limit,
+			ent.WithUserFilter(where.Filter),
+		)
+}
+
+func (r *queryResolver) Timestamped(ctx context.Context, after *ent.Cursor, first *int, before *ent.Cursor, last *int) (*ent.TimestampedConnection, error) {
+	return r.client.PaginateTimestamped(ctx, after, first, before, last)
+}
+
+func (r *subscriptionResolver) TodoCreated(ctx context.Context) (<-chan *ent.Todo, error) {
+	return r.client.SubscriptionResolver(r.pubsub).TodoCreated(ctx)
+}
+
+func (r *subscriptionResolver) TodoUpdated(ctx context.Context, id *int) (<-chan *ent.Todo, error) {
+	return r.client.SubscriptionResolver(r.pubsub).TodoUpdated(ctx, id)
+}
+
+func (r *subscriptionResolver) TodoDeleted(ctx context.Context) (<-chan int, error) {
+	return r.client.SubscriptionResolver(r.pubsub).TodoDeleted(ctx)
+}
+
+// Mutation returns MutationResolver implementation.
+func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
+
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// Subscription returns SubscriptionResolver implementation.
+func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
+
 // TodoWhereInput returns TodoWhereInputResolver implementation.
 func (r *Resolver) TodoWhereInput() TodoWhereInputResolver { return &todoWhereInputResolver{r} }
 
+type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
 type todoWhereInputResolver struct{ *Resolver }
@@ -39,6 +39,10 @@ const (
 	FieldCount = "count"
 	// FieldStrings holds the string denoting the strings field in the database.
 	FieldStrings = "strings"
+	// FieldTypes holds the string denoting the types field in the database.
+	FieldTypes = "types"
+	// FieldSlug holds the string denoting the slug field in the database.
+	FieldSlug = "slug"
 	// EdgeTodos holds the string denoting the todos edge name in mutations.
 	EdgeTodos = "todos"
 	// Table holds the table name of the category in the database.
@@ -61,6 +65,8 @@ var Columns = []string{
 	FieldDuration,
 	FieldCount,
 	FieldStrings,
+	FieldTypes,
+	FieldSlug,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -134,6 +134,38 @@ func (cu *CategoryUpdate) ClearStrings() *CategoryUpdate {
 	return cu
 }
 
+// SetTypes sets the "types" field.
+func (cu *CategoryUpdate) SetTypes(st *schematype.CategoryTypes) *CategoryUpdate {
+	cu.mutation.SetTypes(st)
+	return cu
+}
+
+// ClearTypes clears the value of the "types" field.
+func (cu *CategoryUpdate) ClearTypes() *CategoryUpdate {
+	cu.mutation.ClearTypes()
+	return cu
+}
+
+// SetSlug sets the "slug" field.
+func (cu *CategoryUpdate) SetSlug(s string) *CategoryUpdate {
+	cu.mutation.SetSlug(s)
+	return cu
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (cu *CategoryUpdate) SetNillableSlug(s *string) *CategoryUpdate {
+	if s != nil {
+		cu.SetSlug(*s)
+	}
+	return cu
+}
+
+// ClearSlug clears the value of the "slug" field.
+func (cu *CategoryUpdate) ClearSlug() *CategoryUpdate {
+	cu.mutation.ClearSlug()
+	return cu
+}
+
 // AddTodoIDs adds the "todos" edge to the Todo entity by IDs.
 func (cu *CategoryUpdate) AddTodoIDs(ids ...int) *CategoryUpdate {
 	cu.mutation.AddTodoIDs(ids...)
@@ -348,6 +380,32 @@ func (cu *CategoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: category.FieldStrings,
 		})
 	}
+	if value, ok := cu.mutation.Types(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: category.FieldTypes,
+		})
+	}
+	if cu.mutation.TypesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: category.FieldTypes,
+		})
+	}
+	if value, ok := cu.mutation.Slug(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: category.FieldSlug,
+		})
+	}
+	if cu.mutation.SlugCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: category.FieldSlug,
+		})
+	}
 	if cu.mutation.TodosCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -511,6 +569,38 @@ func (cuo *CategoryUpdateOne) ClearStrings() *CategoryUpdateOne {
 	return cuo
 }
 
+// SetTypes sets the "types" field.
+func (cuo *CategoryUpdateOne) SetTypes(st *schematype.CategoryTypes) *CategoryUpdateOne {
+	cuo.mutation.SetTypes(st)
+	return cuo
+}
+
+// ClearTypes clears the value of the "types" field.
+func (cuo *CategoryUpdateOne) ClearTypes() *CategoryUpdateOne {
+	cuo.mutation.ClearTypes()
+	return cuo
+}
+
+// SetSlug sets the "slug" field.
+func (cuo *CategoryUpdateOne) SetSlug(s string) *CategoryUpdateOne {
+	cuo.mutation.SetSlug(s)
+	return cuo
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (cuo *CategoryUpdateOne) SetNillableSlug(s *string) *CategoryUpdateOne {
+	if s != nil {
+		cuo.SetSlug(*s)
+	}
+	return cuo
+}
+
+// ClearSlug clears the value of the "slug" field.
+func (cuo *CategoryUpdateOne) ClearSlug() *CategoryUpdateOne {
+	cuo.mutation.ClearSlug()
+	return cuo
+}
+
 // AddTodoIDs adds the "todos" edge to the Todo entity by IDs.
 func (cuo *CategoryUpdateOne) AddTodoIDs(ids ...int) *CategoryUpdateOne {
 	cuo.mutation.AddTodoIDs(ids...)
@@ -755,6 +845,32 @@ func (cuo *CategoryUpdateOne) sqlSave(ctx context.Context) (_node *Category, err
 			Column: category.FieldStrings,
 		})
 	}
+	if value, ok := cuo.mutation.Types(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: category.FieldTypes,
+		})
+	}
+	if cuo.mutation.TypesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: category.FieldTypes,
+		})
+	}
+	if value, ok := cuo.mutation.Slug(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: category.FieldSlug,
+		})
+	}
+	if cuo.mutation.SlugCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: category.FieldSlug,
+		})
+	}
 	if cuo.mutation.TodosCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -24,6 +24,8 @@ func main() {
 		entgql.WithSchemaGenerator(),
 		entgql.WithSchemaPath("./ent.graphql"),
 		entgql.WithWhereInputs(true),
+		entgql.WithCursorCodec(),
+		entgql.WithGoClient(),
 	)
 	if err != nil {
 		log.Fatalf("creating entgql extension: %v", err)
@@ -46,7 +48,7 @@ func main() {
 			//
 			// Code generated by entc, DO NOT EDIT.
 		`,
-	}, entc.Extensions(ex))
+	}, entc.Extensions(ex), entc.FeatureNames("sql/upsert"))
 	if err != nil {
 		log.Fatalf("running ent codegen: %v", err)
 	}
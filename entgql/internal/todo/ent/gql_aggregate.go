@@ -0,0 +1,199 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	"entgo.io/contrib/entgql/internal/todo/ent/todo"
+	"entgo.io/ent/dialect/sql"
+)
+
+// TodoGroupField defines the fields that Todo aggregations can be grouped by.
+type TodoGroupField string
+
+// Fields that Todo aggregations can be grouped by.
+const (
+	TodoGroupFieldStatus   TodoGroupField = "STATUS"
+	TodoGroupFieldPriority TodoGroupField = "PRIORITY"
+	TodoGroupFieldLabel    TodoGroupField = "LABEL"
+)
+
+// String implements fmt.Stringer interface.
+func (f TodoGroupField) String() string {
+	return string(f)
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f TodoGroupField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *TodoGroupField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("TodoGroupField %T must be a string", v)
+	}
+	*f = TodoGroupField(str)
+	return f.Validate()
+}
+
+// Validate checks that f is a valid TodoGroupField.
+func (f TodoGroupField) Validate() error {
+	if f.column() == "" {
+		return fmt.Errorf("%s is not a valid TodoGroupField", f)
+	}
+	return nil
+}
+
+// column returns the database column of the field.
+func (f TodoGroupField) column() string {
+	switch f {
+	case TodoGroupFieldStatus:
+		return todo.FieldStatus
+	case TodoGroupFieldPriority:
+		return todo.FieldPriority
+	case TodoGroupFieldLabel:
+		return todo.FieldLabel
+	default:
+		return ""
+	}
+}
+
+// TodoAggregate holds the aggregated values of a group of Todo nodes.
+type TodoAggregate struct {
+	// Count is the number of nodes in the group.
+	Count int `json:"count"`
+	// Status holds the value of the "status" field of the group,
+	// or nil if the aggregations are not grouped by this field.
+	Status *todo.Status `json:"status,omitempty"`
+	// Priority holds the value of the "priority" field of the group,
+	// or nil if the aggregations are not grouped by this field.
+	Priority *int `json:"priority,omitempty"`
+	// Label holds the value of the "label" field of the group,
+	// or nil if the aggregations are not grouped by this field.
+	Label *string `json:"todo_label,omitempty"`
+	// Sum, Avg, Min and Max hold the results of the aggregation
+	// functions applied on the numeric fields of the group.
+	Sum *TodoAggregateValues `json:"sum"`
+	Avg *TodoAggregateValues `json:"avg"`
+	Min *TodoAggregateValues `json:"min"`
+	Max *TodoAggregateValues `json:"max"`
+}
+
+// TodoAggregateValues holds the results of an aggregation
+// function applied on the numeric fields of Todo.
+type TodoAggregateValues struct {
+	Priority *float64 `json:"priority,omitempty"`
+	Version  *float64 `json:"version,omitempty"`
+}
+
+// Aggregates returns the aggregations of the Todo nodes matching the query,
+// grouped by the given fields. If no fields are given, the aggregations are
+// computed over all nodes and returned as a single group.
+//
+//	aggs, err := client.Todo.Query().
+//		Aggregates(ctx, []ent.TodoGroupField{ent.TodoGroupFieldStatus})
+//
+func (tq *TodoQuery) Aggregates(ctx context.Context, groupBy []TodoGroupField) ([]*TodoAggregate, error) {
+	tq = tq.excludeDeleted(ctx)
+	var fields []string
+	for _, f := range groupBy {
+		if err := f.Validate(); err != nil {
+			return nil, err
+		}
+		fields = append(fields, f.column())
+	}
+	if len(fields) > 0 {
+		tq.Order(Asc(fields...))
+	}
+	fns := []AggregateFunc{
+		As(Count(), "count"),
+		As(Sum(todo.FieldPriority), "sum_priority"),
+		As(Mean(todo.FieldPriority), "avg_priority"),
+		As(Min(todo.FieldPriority), "min_priority"),
+		As(Max(todo.FieldPriority), "max_priority"),
+		As(Sum(todo.FieldVersion), "sum_version"),
+		As(Mean(todo.FieldVersion), "avg_version"),
+		As(Min(todo.FieldVersion), "min_version"),
+		As(Max(todo.FieldVersion), "max_version"),
+	}
+	var v []struct {
+		TodoAggregate
+		SumPriority *float64 `json:"sum_priority"`
+		AvgPriority *float64 `json:"avg_priority"`
+		MinPriority *float64 `json:"min_priority"`
+		MaxPriority *float64 `json:"max_priority"`
+		SumVersion  *float64 `json:"sum_version"`
+		AvgVersion  *float64 `json:"avg_version"`
+		MinVersion  *float64 `json:"min_version"`
+		MaxVersion  *float64 `json:"max_version"`
+	}
+	if len(fields) > 0 {
+		if err := tq.GroupBy(fields[0], fields[1:]...).Aggregate(fns...).Scan(ctx, &v); err != nil {
+			return nil, err
+		}
+	} else if err := tq.aggregateAll(ctx, fns, &v); err != nil {
+		return nil, err
+	}
+	aggs := make([]*TodoAggregate, len(v))
+	for i := range v {
+		agg := v[i].TodoAggregate
+		agg.Sum = &TodoAggregateValues{
+			Priority: v[i].SumPriority,
+			Version:  v[i].SumVersion,
+		}
+		agg.Avg = &TodoAggregateValues{
+			Priority: v[i].AvgPriority,
+			Version:  v[i].AvgVersion,
+		}
+		agg.Min = &TodoAggregateValues{
+			Priority: v[i].MinPriority,
+			Version:  v[i].MinVersion,
+		}
+		agg.Max = &TodoAggregateValues{
+			Priority: v[i].MaxPriority,
+			Version:  v[i].MaxVersion,
+		}
+		aggs[i] = &agg
+	}
+	return aggs, nil
+}
+
+// aggregateAll computes the aggregations over all nodes matching the
+// query, as the group-by builder requires at least one field.
+func (tq *TodoQuery) aggregateAll(ctx context.Context, fns []AggregateFunc, v interface{}) error {
+	if err := tq.prepareQuery(ctx); err != nil {
+		return err
+	}
+	selector := tq.sqlQuery(ctx)
+	columns := make([]string, 0, len(fns))
+	for _, fn := range fns {
+		columns = append(columns, fn(selector))
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Select(columns...).Query()
+	if err := tq.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -25,6 +25,7 @@ import (
 
 	"entgo.io/contrib/entgql"
 	"entgo.io/contrib/entgql/internal/todo/ent/category"
+	"entgo.io/contrib/entgql/internal/todo/ent/friendship"
 	"entgo.io/contrib/entgql/internal/todo/ent/group"
 	"entgo.io/contrib/entgql/internal/todo/ent/todo"
 	"entgo.io/contrib/entgql/internal/todo/ent/user"
@@ -67,7 +68,7 @@ func (c *Category) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     c.ID,
 		Type:   "Category",
-		Fields: make([]*Field, 6),
+		Fields: make([]*Field, 8),
 		Edges:  make([]*Edge, 1),
 	}
 	var buf []byte
@@ -119,11 +120,27 @@ func (c *Category) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "strings",
 		Value: string(buf),
 	}
+	if buf, err = json.Marshal(c.Types); err != nil {
+		return nil, err
+	}
+	node.Fields[6] = &Field{
+		Type:  "*schematype.CategoryTypes",
+		Name:  "types",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(c.Slug); err != nil {
+		return nil, err
+	}
+	node.Fields[7] = &Field{
+		Type:  "string",
+		Name:  "slug",
+		Value: string(buf),
+	}
 	node.Edges[0] = &Edge{
 		Type: "Todo",
 		Name: "todos",
 	}
-	err = c.QueryTodos().
+	err = c.QueryTodos().excludeDeleted(ctx).
 		Select(todo.FieldID).
 		Scan(ctx, &node.Edges[0].IDs)
 	if err != nil {
@@ -132,6 +149,61 @@ func (c *Category) Node(ctx context.Context) (node *Node, err error) {
 	return node, nil
 }
 
+func (f *Friendship) Node(ctx context.Context) (node *Node, err error) {
+	node = &Node{
+		ID:     f.ID,
+		Type:   "Friendship",
+		Fields: make([]*Field, 3),
+		Edges:  make([]*Edge, 2),
+	}
+	var buf []byte
+	if buf, err = json.Marshal(f.CreatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[0] = &Field{
+		Type:  "time.Time",
+		Name:  "created_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(f.UserID); err != nil {
+		return nil, err
+	}
+	node.Fields[1] = &Field{
+		Type:  "int",
+		Name:  "user_id",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(f.FriendID); err != nil {
+		return nil, err
+	}
+	node.Fields[2] = &Field{
+		Type:  "int",
+		Name:  "friend_id",
+		Value: string(buf),
+	}
+	node.Edges[0] = &Edge{
+		Type: "User",
+		Name: "user",
+	}
+	err = f.QueryUser().
+		Select(user.FieldID).
+		Scan(ctx, &node.Edges[0].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[1] = &Edge{
+		Type: "User",
+		Name: "friend",
+	}
+	err = f.QueryFriend().
+		Select(user.FieldID).
+		Scan(ctx, &node.Edges[1].IDs)
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
 func (gr *Group) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     gr.ID,
@@ -165,7 +237,7 @@ func (t *Todo) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     t.ID,
 		Type:   "Todo",
-		Fields: make([]*Field, 5),
+		Fields: make([]*Field, 8),
 		Edges:  make([]*Edge, 3),
 	}
 	var buf []byte
@@ -209,11 +281,35 @@ func (t *Todo) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "category_id",
 		Value: string(buf),
 	}
+	if buf, err = json.Marshal(t.DeletedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[5] = &Field{
+		Type:  "time.Time",
+		Name:  "deleted_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(t.Version); err != nil {
+		return nil, err
+	}
+	node.Fields[6] = &Field{
+		Type:  "int",
+		Name:  "version",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(t.Label); err != nil {
+		return nil, err
+	}
+	node.Fields[7] = &Field{
+		Type:  "string",
+		Name:  "label",
+		Value: string(buf),
+	}
 	node.Edges[0] = &Edge{
 		Type: "Todo",
 		Name: "parent",
 	}
-	err = t.QueryParent().
+	err = t.QueryParent().excludeDeleted(ctx).
 		Select(todo.FieldID).
 		Scan(ctx, &node.Edges[0].IDs)
 	if err != nil {
@@ -223,7 +319,7 @@ func (t *Todo) Node(ctx context.Context) (node *Node, err error) {
 		Type: "Todo",
 		Name: "children",
 	}
-	err = t.QueryChildren().
+	err = t.QueryChildren().excludeDeleted(ctx).
 		Select(todo.FieldID).
 		Scan(ctx, &node.Edges[1].IDs)
 	if err != nil {
@@ -247,7 +343,7 @@ func (u *User) Node(ctx context.Context) (node *Node, err error) {
 		ID:     u.ID,
 		Type:   "User",
 		Fields: make([]*Field, 1),
-		Edges:  make([]*Edge, 2),
+		Edges:  make([]*Edge, 3),
 	}
 	var buf []byte
 	if buf, err = json.Marshal(u.Name); err != nil {
@@ -278,6 +374,16 @@ func (u *User) Node(ctx context.Context) (node *Node, err error) {
 	if err != nil {
 		return nil, err
 	}
+	node.Edges[2] = &Edge{
+		Type: "Friendship",
+		Name: "friendships",
+	}
+	err = u.QueryFriendships().
+		Select(friendship.FieldID).
+		Scan(ctx, &node.Edges[2].IDs)
+	if err != nil {
+		return nil, err
+	}
 	return node, nil
 }
 
@@ -360,6 +466,18 @@ func (c *Client) noder(ctx context.Context, table string, id int) (Noder, error)
 			return nil, err
 		}
 		return n, nil
+	case friendship.Table:
+		query := c.Friendship.Query().
+			Where(friendship.ID(id))
+		query, err := query.CollectFields(ctx, "Friendship")
+		if err != nil {
+			return nil, err
+		}
+		n, err := query.Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
 	case group.Table:
 		query := c.Group.Query().
 			Where(group.ID(id))
@@ -375,6 +493,7 @@ func (c *Client) noder(ctx context.Context, table string, id int) (Noder, error)
 	case todo.Table:
 		query := c.Todo.Query().
 			Where(todo.ID(id))
+		query = query.excludeDeleted(ctx)
 		query, err := query.CollectFields(ctx, "Todo")
 		if err != nil {
 			return nil, err
@@ -485,6 +604,22 @@ func (c *Client) noders(ctx context.Context, table string, ids []int) ([]Noder,
 				*noder = node
 			}
 		}
+	case friendship.Table:
+		query := c.Friendship.Query().
+			Where(friendship.IDIn(ids...))
+		query, err := query.CollectFields(ctx, "Friendship")
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case group.Table:
 		query := c.Group.Query().
 			Where(group.IDIn(ids...))
@@ -504,6 +639,7 @@ func (c *Client) noders(ctx context.Context, table string, ids []int) ([]Noder,
 	case todo.Table:
 		query := c.Todo.Query().
 			Where(todo.IDIn(ids...))
+		query = query.excludeDeleted(ctx)
 		query, err := query.CollectFields(ctx, "Todo")
 		if err != nil {
 			return nil, err
@@ -66,6 +66,8 @@ type CategoryMutation struct {
 	count         *uint64
 	addcount      *int64
 	strings       *[]string
+	types         **schematype.CategoryTypes
+	slug          *string
 	clearedFields map[string]struct{}
 	todos         map[int]struct{}
 	removedtodos  map[int]struct{}
@@ -483,6 +485,104 @@ func (m *CategoryMutation) ResetStrings() {
 	delete(m.clearedFields, category.FieldStrings)
 }
 
+// SetTypes sets the "types" field.
+func (m *CategoryMutation) SetTypes(st *schematype.CategoryTypes) {
+	m.types = &st
+}
+
+// Types returns the value of the "types" field in the mutation.
+func (m *CategoryMutation) Types() (r *schematype.CategoryTypes, exists bool) {
+	v := m.types
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTypes returns the old "types" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryMutation) OldTypes(ctx context.Context) (v *schematype.CategoryTypes, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTypes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTypes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTypes: %w", err)
+	}
+	return oldValue.Types, nil
+}
+
+// ClearTypes clears the value of the "types" field.
+func (m *CategoryMutation) ClearTypes() {
+	m.types = nil
+	m.clearedFields[category.FieldTypes] = struct{}{}
+}
+
+// TypesCleared returns if the "types" field was cleared in this mutation.
+func (m *CategoryMutation) TypesCleared() bool {
+	_, ok := m.clearedFields[category.FieldTypes]
+	return ok
+}
+
+// ResetTypes resets all changes to the "types" field.
+func (m *CategoryMutation) ResetTypes() {
+	m.types = nil
+	delete(m.clearedFields, category.FieldTypes)
+}
+
+// SetSlug sets the "slug" field.
+func (m *CategoryMutation) SetSlug(s string) {
+	m.slug = &s
+}
+
+// Slug returns the value of the "slug" field in the mutation.
+func (m *CategoryMutation) Slug() (r string, exists bool) {
+	v := m.slug
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlug returns the old "slug" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryMutation) OldSlug(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlug requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+	}
+	return oldValue.Slug, nil
+}
+
+// ClearSlug clears the value of the "slug" field.
+func (m *CategoryMutation) ClearSlug() {
+	m.slug = nil
+	m.clearedFields[category.FieldSlug] = struct{}{}
+}
+
+// SlugCleared returns if the "slug" field was cleared in this mutation.
+func (m *CategoryMutation) SlugCleared() bool {
+	_, ok := m.clearedFields[category.FieldSlug]
+	return ok
+}
+
+// ResetSlug resets all changes to the "slug" field.
+func (m *CategoryMutation) ResetSlug() {
+	m.slug = nil
+	delete(m.clearedFields, category.FieldSlug)
+}
+
 // AddTodoIDs adds the "todos" edge to the Todo entity by ids.
 func (m *CategoryMutation) AddTodoIDs(ids ...int) {
 	if m.todos == nil {
@@ -556,7 +656,7 @@ func (m *CategoryMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *CategoryMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+	fields := make([]string, 0, 8)
 	if m.text != nil {
 		fields = append(fields, category.FieldText)
 	}
@@ -575,6 +675,12 @@ func (m *CategoryMutation) Fields() []string {
 	if m.strings != nil {
 		fields = append(fields, category.FieldStrings)
 	}
+	if m.types != nil {
+		fields = append(fields, category.FieldTypes)
+	}
+	if m.slug != nil {
+		fields = append(fields, category.FieldSlug)
+	}
 	return fields
 }
 
@@ -595,6 +701,10 @@ func (m *CategoryMutation) Field(name string) (ent.Value, bool) {
 		return m.Count()
 	case category.FieldStrings:
 		return m.Strings()
+	case category.FieldTypes:
+		return m.Types()
+	case category.FieldSlug:
+		return m.Slug()
 	}
 	return nil, false
 }
@@ -616,6 +726,10 @@ func (m *CategoryMutation) OldField(ctx context.Context, name string) (ent.Value
 		return m.OldCount(ctx)
 	case category.FieldStrings:
 		return m.OldStrings(ctx)
+	case category.FieldTypes:
+		return m.OldTypes(ctx)
+	case category.FieldSlug:
+		return m.OldSlug(ctx)
 	}
 	return nil, fmt.Errorf("unknown Category field %s", name)
 }
@@ -667,6 +781,20 @@ func (m *CategoryMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetStrings(v)
 		return nil
+	case category.FieldTypes:
+		v, ok := value.(*schematype.CategoryTypes)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTypes(v)
+		return nil
+	case category.FieldSlug:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlug(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Category field %s", name)
 }
@@ -736,6 +864,12 @@ func (m *CategoryMutation) ClearedFields() []string {
 	if m.FieldCleared(category.FieldStrings) {
 		fields = append(fields, category.FieldStrings)
 	}
+	if m.FieldCleared(category.FieldTypes) {
+		fields = append(fields, category.FieldTypes)
+	}
+	if m.FieldCleared(category.FieldSlug) {
+		fields = append(fields, category.FieldSlug)
+	}
 	return fields
 }
 
@@ -762,6 +896,12 @@ func (m *CategoryMutation) ClearField(name string) error {
 	case category.FieldStrings:
 		m.ClearStrings()
 		return nil
+	case category.FieldTypes:
+		m.ClearTypes()
+		return nil
+	case category.FieldSlug:
+		m.ClearSlug()
+		return nil
 	}
 	return fmt.Errorf("unknown Category nullable field %s", name)
 }
@@ -788,6 +928,12 @@ func (m *CategoryMutation) ResetField(name string) error {
 	case category.FieldStrings:
 		m.ResetStrings()
 		return nil
+	case category.FieldTypes:
+		m.ResetTypes()
+		return nil
+	case category.FieldSlug:
+		m.ResetSlug()
+		return nil
 	}
 	return fmt.Errorf("unknown Category field %s", name)
 }
@@ -1814,6 +1960,10 @@ type TodoMutation struct {
 	addpriority     *int
 	text            *string
 	blob            *[]byte
+	deleted_at      *time.Time
+	version         *int
+	addversion      *int
+	label           *string
 	clearedFields   map[string]struct{}
 	parent          *int
 	clearedparent   bool
@@ -2189,6 +2339,160 @@ func (m *TodoMutation) ResetCategoryID() {
 	delete(m.clearedFields, todo.FieldCategoryID)
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (m *TodoMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *TodoMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Todo entity.
+// If the Todo object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TodoMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *TodoMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[todo.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *TodoMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[todo.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *TodoMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, todo.FieldDeletedAt)
+}
+
+// SetVersion sets the "version" field.
+func (m *TodoMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *TodoMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Todo entity.
+// If the Todo object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TodoMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *TodoMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *TodoMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *TodoMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetLabel sets the "label" field.
+func (m *TodoMutation) SetLabel(s string) {
+	m.label = &s
+}
+
+// Label returns the value of the "label" field in the mutation.
+func (m *TodoMutation) Label() (r string, exists bool) {
+	v := m.label
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabel returns the old "label" field's value of the Todo entity.
+// If the Todo object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TodoMutation) OldLabel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabel: %w", err)
+	}
+	return oldValue.Label, nil
+}
+
+// ClearLabel clears the value of the "label" field.
+func (m *TodoMutation) ClearLabel() {
+	m.label = nil
+	m.clearedFields[todo.FieldLabel] = struct{}{}
+}
+
+// LabelCleared returns if the "label" field was cleared in this mutation.
+func (m *TodoMutation) LabelCleared() bool {
+	_, ok := m.clearedFields[todo.FieldLabel]
+	return ok
+}
+
+// ResetLabel resets all changes to the "label" field.
+func (m *TodoMutation) ResetLabel() {
+	m.label = nil
+	delete(m.clearedFields, todo.FieldLabel)
+}
+
 // SetParentID sets the "parent" edge to the Todo entity by id.
 func (m *TodoMutation) SetParentID(id int) {
 	m.parent = &id
@@ -2366,7 +2670,7 @@ func (m *TodoMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *TodoMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+	fields := make([]string, 0, 9)
 	if m.created_at != nil {
 		fields = append(fields, todo.FieldCreatedAt)
 	}
@@ -2385,6 +2689,15 @@ func (m *TodoMutation) Fields() []string {
 	if m.category != nil {
 		fields = append(fields, todo.FieldCategoryID)
 	}
+	if m.deleted_at != nil {
+		fields = append(fields, todo.FieldDeletedAt)
+	}
+	if m.version != nil {
+		fields = append(fields, todo.FieldVersion)
+	}
+	if m.label != nil {
+		fields = append(fields, todo.FieldLabel)
+	}
 	return fields
 }
 
@@ -2405,6 +2718,12 @@ func (m *TodoMutation) Field(name string) (ent.Value, bool) {
 		return m.Blob()
 	case todo.FieldCategoryID:
 		return m.CategoryID()
+	case todo.FieldDeletedAt:
+		return m.DeletedAt()
+	case todo.FieldVersion:
+		return m.Version()
+	case todo.FieldLabel:
+		return m.Label()
 	}
 	return nil, false
 }
@@ -2426,6 +2745,12 @@ func (m *TodoMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldBlob(ctx)
 	case todo.FieldCategoryID:
 		return m.OldCategoryID(ctx)
+	case todo.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case todo.FieldVersion:
+		return m.OldVersion(ctx)
+	case todo.FieldLabel:
+		return m.OldLabel(ctx)
 	}
 	return nil, fmt.Errorf("unknown Todo field %s", name)
 }
@@ -2477,6 +2802,27 @@ func (m *TodoMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetCategoryID(v)
 		return nil
+	case todo.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case todo.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case todo.FieldLabel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabel(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Todo field %s", name)
 }
@@ -2488,6 +2834,9 @@ func (m *TodoMutation) AddedFields() []string {
 	if m.addpriority != nil {
 		fields = append(fields, todo.FieldPriority)
 	}
+	if m.addversion != nil {
+		fields = append(fields, todo.FieldVersion)
+	}
 	return fields
 }
 
@@ -2498,6 +2847,8 @@ func (m *TodoMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	case todo.FieldPriority:
 		return m.AddedPriority()
+	case todo.FieldVersion:
+		return m.AddedVersion()
 	}
 	return nil, false
 }
@@ -2514,6 +2865,13 @@ func (m *TodoMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddPriority(v)
 		return nil
+	case todo.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Todo numeric field %s", name)
 }
@@ -2528,6 +2886,12 @@ func (m *TodoMutation) ClearedFields() []string {
 	if m.FieldCleared(todo.FieldCategoryID) {
 		fields = append(fields, todo.FieldCategoryID)
 	}
+	if m.FieldCleared(todo.FieldDeletedAt) {
+		fields = append(fields, todo.FieldDeletedAt)
+	}
+	if m.FieldCleared(todo.FieldLabel) {
+		fields = append(fields, todo.FieldLabel)
+	}
 	return fields
 }
 
@@ -2548,6 +2912,12 @@ func (m *TodoMutation) ClearField(name string) error {
 	case todo.FieldCategoryID:
 		m.ClearCategoryID()
 		return nil
+	case todo.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case todo.FieldLabel:
+		m.ClearLabel()
+		return nil
 	}
 	return fmt.Errorf("unknown Todo nullable field %s", name)
 }
@@ -2574,6 +2944,15 @@ func (m *TodoMutation) ResetField(name string) error {
 	case todo.FieldCategoryID:
 		m.ResetCategoryID()
 		return nil
+	case todo.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case todo.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case todo.FieldLabel:
+		m.ResetLabel()
+		return nil
 	}
 	return fmt.Errorf("unknown Todo field %s", name)
 }
@@ -63,6 +63,10 @@ func init() {
 	todoDescText := todoFields[3].Descriptor()
 	// todo.TextValidator is a validator for the "text" field. It is called by the builders before save.
 	todo.TextValidator = todoDescText.Validators[0].(func(string) error)
+	// todoDescVersion is the schema descriptor for version field.
+	todoDescVersion := todoFields[7].Descriptor()
+	// todo.DefaultVersion holds the default value on creation for the version field.
+	todo.DefaultVersion = todoDescVersion.Default.(int)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescName is the schema descriptor for name field.
@@ -20,6 +20,7 @@ import (
 	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 
@@ -51,7 +52,10 @@ func (Category) Fields() []ent.Field {
 			SchemaType(map[string]string{
 				dialect.SQLite: "json",
 			}).
-			Optional(),
+			Optional().
+			Annotations(
+				entgql.WhereOps(entgql.OpHasKey, entgql.OpValueEQ),
+			),
 		field.Int64("duration").
 			GoType(time.Duration(0)).
 			Optional().
@@ -63,9 +67,18 @@ func (Category) Fields() []ent.Field {
 			Optional().
 			Annotations(
 				entgql.Type("Uint64"),
+				entgql.Authorize("admin"),
 			),
 		field.Strings("strings").
+			Optional().
+			Annotations(
+				entgql.WhereOps(entgql.OpValueEQ),
+			),
+		field.JSON("types", &schematype.CategoryTypes{}).
 			Optional(),
+		field.String("slug").
+			Optional().
+			Unique(),
 	}
 }
 
@@ -76,3 +89,14 @@ func (Category) Edges() []ent.Edge {
 			Annotations(entgql.RelayConnection()),
 	}
 }
+
+// Annotations returns Category annotations.
+func (Category) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Mutations(
+			entgql.MutationCreate(),
+			entgql.MutationUpsert("slug"),
+		),
+		entgql.MutationFields(),
+	}
+}
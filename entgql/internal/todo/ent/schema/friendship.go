@@ -17,7 +17,9 @@ package schema
 import (
 	"time"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -27,11 +29,21 @@ type Friendship struct {
 	ent.Schema
 }
 
+// Mixin returns friendship mixed-in schema.
+func (Friendship) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimestampedMixin{},
+	}
+}
+
 // Fields of the Friendship.
 func (Friendship) Fields() []ent.Field {
 	return []ent.Field{
 		field.Time("created_at").
-			Default(time.Now),
+			Default(time.Now).
+			Annotations(
+				entgql.OrderField("CREATED_AT"),
+			),
 		field.Int("user_id"),
 		field.Int("friend_id"),
 	}
@@ -50,3 +62,10 @@ func (Friendship) Edges() []ent.Edge {
 			Field("friend_id"),
 	}
 }
+
+// Annotations returns Friendship annotations.
+func (Friendship) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.RelayConnection(),
+	}
+}
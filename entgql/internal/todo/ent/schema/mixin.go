@@ -14,7 +14,26 @@
 
 package schema
 
-import "entgo.io/ent"
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/mixin"
+)
+
+// TimestampedMixin makes the types that mix it in implement the
+// Timestamped GraphQL interface, which is generated by entgql.
+type TimestampedMixin struct {
+	mixin.Schema
+}
+
+// Annotations of the TimestampedMixin.
+func (TimestampedMixin) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Interface("Timestamped", "created_at").
+			QueryField("timestamped"),
+	}
+}
 
 type filterFields struct {
 	ent.Interface
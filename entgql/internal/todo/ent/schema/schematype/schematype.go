@@ -38,3 +38,18 @@ func (t *CategoryConfig) Scan(v interface{}) (err error) {
 func (t *CategoryConfig) Value() (driver.Value, error) {
 	return json.Marshal(t)
 }
+
+// CategoryTypes is a JSON type whose GraphQL object and
+// input types are derived by entgql from its Go type.
+type CategoryTypes struct {
+	Public bool           `json:"public"`
+	Tags   []string       `json:"tags,omitempty"`
+	Owner  *CategoryOwner `json:"owner,omitempty"`
+	Secret string         `json:"-"`
+}
+
+// CategoryOwner is the owner of a category.
+type CategoryOwner struct {
+	Name  string `json:"name"`
+	Email string `json:"email_address,omitempty"`
+}
@@ -29,6 +29,13 @@ type Todo struct {
 	ent.Schema
 }
 
+// Mixin returns todo mixed-in schema.
+func (Todo) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimestampedMixin{},
+	}
+}
+
 // Fields returns todo fields.
 func (Todo) Fields() []ent.Field {
 	return []ent.Field{
@@ -46,16 +53,19 @@ func (Todo) Fields() []ent.Field {
 			).
 			Annotations(
 				entgql.OrderField("STATUS"),
+				entgql.GroupBy(),
 			),
 		field.Int("priority").
 			Default(0).
 			Annotations(
 				entgql.OrderField("PRIORITY"),
+				entgql.GroupBy(),
 			),
 		field.Text("text").
 			NotEmpty().
 			Annotations(
 				entgql.OrderField("TEXT"),
+				entgql.Constraint().MinLength(1),
 			),
 		field.Bytes("blob").
 			Annotations(
@@ -64,6 +74,23 @@ func (Todo) Fields() []ent.Field {
 			Optional(),
 		field.Int("category_id").
 			Optional(),
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.Int("version").
+			Default(0).
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput),
+			),
+		field.String("label").
+			Optional().
+			StorageKey("todo_label").
+			Annotations(
+				entgql.GroupBy(),
+			),
 	}
 }
 
@@ -72,7 +99,7 @@ func (Todo) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("children", Todo.Type).
 			//nolint SA1019 we keep this as the example.
-			Annotations(entgql.Bind(), entgql.RelayConnection()).
+			Annotations(entgql.Bind(), entgql.RelayConnection(), entgql.OrderEdgeCount(), entgql.NestedCreate()).
 			From("parent").
 			//nolint SA1019 we keep this as the example.
 			Annotations(entgql.Bind()).
@@ -80,7 +107,8 @@ func (Todo) Edges() []ent.Edge {
 		edge.From("category", Category.Type).
 			Ref("todos").
 			Field("category_id").
-			Unique(),
+			Unique().
+			Annotations(entgql.OrderEdgeFields("text"), entgql.NestedCreate()),
 		edge.To("secret", VerySecret.Type).
 			Unique(),
 	}
@@ -91,6 +119,12 @@ func (Todo) Annotations() []schema.Annotation {
 	return []schema.Annotation{
 		entgql.RelayConnection(),
 		entgql.QueryField(),
-		entgql.Mutations(entgql.MutationCreate()),
+		entgql.Mutations(),
+		entgql.MutationFields().Bulk(),
+		entgql.Subscriptions(),
+		entgql.MultiOrder(),
+		entgql.Aggregate(),
+		entgql.SoftDelete("deleted_at"),
+		entgql.OptimisticLock("version"),
 	}
 }
@@ -41,7 +41,8 @@ func (User) Edges() []ent.Edge {
 		edge.To("groups", Group.Type).
 			Annotations(entgql.RelayConnection()),
 		edge.To("friends", User.Type).
-			Through("friendships", Friendship.Type),
+			Through("friendships", Friendship.Type).
+			Annotations(entgql.OffsetPagination()),
 	}
 }
 
@@ -49,6 +50,7 @@ func (User) Edges() []ent.Edge {
 func (User) Annotations() []schema.Annotation {
 	return []schema.Annotation{
 		entgql.RelayConnection(),
+		entgql.OffsetPagination(),
 		entgql.QueryField(),
 	}
 }
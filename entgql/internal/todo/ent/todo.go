@@ -44,6 +44,12 @@ type Todo struct {
 	Blob []byte `json:"blob,omitempty"`
 	// CategoryID holds the value of the "category_id" field.
 	CategoryID int `json:"category_id,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// Label holds the value of the "label" field.
+	Label string `json:"label,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TodoQuery when eager-loading is set.
 	Edges         TodoEdges `json:"edges"`
@@ -66,6 +72,8 @@ type TodoEdges struct {
 	loadedTypes [4]bool
 	// totalCount holds the count of the edges above.
 	totalCount [3]*int
+	// orderValues holds the values of the ordering fields that are computed from the edges above.
+	orderValues map[string]Value
 }
 
 // ParentOrErr returns the Parent value or an error if the edge
@@ -126,11 +134,11 @@ func (*Todo) scanValues(columns []string) ([]interface{}, error) {
 		switch columns[i] {
 		case todo.FieldBlob:
 			values[i] = new([]byte)
-		case todo.FieldID, todo.FieldPriority, todo.FieldCategoryID:
+		case todo.FieldID, todo.FieldPriority, todo.FieldCategoryID, todo.FieldVersion:
 			values[i] = new(sql.NullInt64)
-		case todo.FieldStatus, todo.FieldText:
+		case todo.FieldStatus, todo.FieldText, todo.FieldLabel:
 			values[i] = new(sql.NullString)
-		case todo.FieldCreatedAt:
+		case todo.FieldCreatedAt, todo.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		case todo.ForeignKeys[0]: // todo_children
 			values[i] = new(sql.NullInt64)
@@ -193,6 +201,25 @@ func (t *Todo) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				t.CategoryID = int(value.Int64)
 			}
+		case todo.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				t.DeletedAt = new(time.Time)
+				*t.DeletedAt = value.Time
+			}
+		case todo.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				t.Version = int(value.Int64)
+			}
+		case todo.FieldLabel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field label", values[i])
+			} else if value.Valid {
+				t.Label = value.String
+			}
 		case todo.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field todo_children", value)
@@ -272,6 +299,17 @@ func (t *Todo) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("category_id=")
 	builder.WriteString(fmt.Sprintf("%v", t.CategoryID))
+	builder.WriteString(", ")
+	if v := t.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", t.Version))
+	builder.WriteString(", ")
+	builder.WriteString("label=")
+	builder.WriteString(t.Label)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -40,6 +40,12 @@ const (
 	FieldBlob = "blob"
 	// FieldCategoryID holds the string denoting the category_id field in the database.
 	FieldCategoryID = "category_id"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldLabel holds the string denoting the label field in the database.
+	FieldLabel = "todo_label"
 	// EdgeParent holds the string denoting the parent edge name in mutations.
 	EdgeParent = "parent"
 	// EdgeChildren holds the string denoting the children edge name in mutations.
@@ -83,6 +89,9 @@ var Columns = []string{
 	FieldText,
 	FieldBlob,
 	FieldCategoryID,
+	FieldDeletedAt,
+	FieldVersion,
+	FieldLabel,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "todos"
@@ -114,6 +123,8 @@ var (
 	DefaultPriority int
 	// TextValidator is a validator for the "text" field. It is called by the builders before save.
 	TextValidator func(string) error
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
 )
 
 // Status defines the type for the "status" enum field.
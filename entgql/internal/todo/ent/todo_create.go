@@ -25,6 +25,7 @@ import (
 	"entgo.io/contrib/entgql/internal/todo/ent/category"
 	"entgo.io/contrib/entgql/internal/todo/ent/todo"
 	"entgo.io/contrib/entgql/internal/todo/ent/verysecret"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -34,6 +35,7 @@ type TodoCreate struct {
 	config
 	mutation *TodoMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -96,6 +98,48 @@ func (tc *TodoCreate) SetNillableCategoryID(i *int) *TodoCreate {
 	return tc
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (tc *TodoCreate) SetDeletedAt(t time.Time) *TodoCreate {
+	tc.mutation.SetDeletedAt(t)
+	return tc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tc *TodoCreate) SetNillableDeletedAt(t *time.Time) *TodoCreate {
+	if t != nil {
+		tc.SetDeletedAt(*t)
+	}
+	return tc
+}
+
+// SetVersion sets the "version" field.
+func (tc *TodoCreate) SetVersion(i int) *TodoCreate {
+	tc.mutation.SetVersion(i)
+	return tc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (tc *TodoCreate) SetNillableVersion(i *int) *TodoCreate {
+	if i != nil {
+		tc.SetVersion(*i)
+	}
+	return tc
+}
+
+// SetLabel sets the "label" field.
+func (tc *TodoCreate) SetLabel(s string) *TodoCreate {
+	tc.mutation.SetLabel(s)
+	return tc
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (tc *TodoCreate) SetNillableLabel(s *string) *TodoCreate {
+	if s != nil {
+		tc.SetLabel(*s)
+	}
+	return tc
+}
+
 // SetParentID sets the "parent" edge to the Todo entity by ID.
 func (tc *TodoCreate) SetParentID(id int) *TodoCreate {
 	tc.mutation.SetParentID(id)
@@ -239,6 +283,10 @@ func (tc *TodoCreate) defaults() {
 		v := todo.DefaultPriority
 		tc.mutation.SetPriority(v)
 	}
+	if _, ok := tc.mutation.Version(); !ok {
+		v := todo.DefaultVersion
+		tc.mutation.SetVersion(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -265,6 +313,9 @@ func (tc *TodoCreate) check() error {
 			return &ValidationError{Name: "text", err: fmt.Errorf(`ent: validator failed for field "Todo.text": %w`, err)}
 		}
 	}
+	if _, ok := tc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Todo.version"`)}
+	}
 	return nil
 }
 
@@ -292,6 +343,7 @@ func (tc *TodoCreate) createSpec() (*Todo, *sqlgraph.CreateSpec) {
 			},
 		}
 	)
+	_spec.OnConflict = tc.conflict
 	if value, ok := tc.mutation.CreatedAt(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -332,6 +384,30 @@ func (tc *TodoCreate) createSpec() (*Todo, *sqlgraph.CreateSpec) {
 		})
 		_node.Blob = value
 	}
+	if value, ok := tc.mutation.DeletedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: todo.FieldDeletedAt,
+		})
+		_node.DeletedAt = &value
+	}
+	if value, ok := tc.mutation.Version(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: todo.FieldVersion,
+		})
+		_node.Version = value
+	}
+	if value, ok := tc.mutation.Label(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: todo.FieldLabel,
+		})
+		_node.Label = value
+	}
 	if nodes := tc.mutation.ParentIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -414,10 +490,454 @@ func (tc *TodoCreate) createSpec() (*Todo, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Todo.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TodoUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (tc *TodoCreate) OnConflict(opts ...sql.ConflictOption) *TodoUpsertOne {
+	tc.conflict = opts
+	return &TodoUpsertOne{
+		create: tc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Todo.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (tc *TodoCreate) OnConflictColumns(columns ...string) *TodoUpsertOne {
+	tc.conflict = append(tc.conflict, sql.ConflictColumns(columns...))
+	return &TodoUpsertOne{
+		create: tc,
+	}
+}
+
+type (
+	// TodoUpsertOne is the builder for "upsert"-ing
+	//  one Todo node.
+	TodoUpsertOne struct {
+		create *TodoCreate
+	}
+
+	// TodoUpsert is the "OnConflict" setter.
+	TodoUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCreatedAt sets the "created_at" field.
+func (u *TodoUpsert) SetCreatedAt(v time.Time) *TodoUpsert {
+	u.Set(todo.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *TodoUpsert) UpdateCreatedAt() *TodoUpsert {
+	u.SetExcluded(todo.FieldCreatedAt)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *TodoUpsert) SetStatus(v todo.Status) *TodoUpsert {
+	u.Set(todo.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TodoUpsert) UpdateStatus() *TodoUpsert {
+	u.SetExcluded(todo.FieldStatus)
+	return u
+}
+
+// SetPriority sets the "priority" field.
+func (u *TodoUpsert) SetPriority(v int) *TodoUpsert {
+	u.Set(todo.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *TodoUpsert) UpdatePriority() *TodoUpsert {
+	u.SetExcluded(todo.FieldPriority)
+	return u
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *TodoUpsert) AddPriority(v int) *TodoUpsert {
+	u.Add(todo.FieldPriority, v)
+	return u
+}
+
+// SetText sets the "text" field.
+func (u *TodoUpsert) SetText(v string) *TodoUpsert {
+	u.Set(todo.FieldText, v)
+	return u
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *TodoUpsert) UpdateText() *TodoUpsert {
+	u.SetExcluded(todo.FieldText)
+	return u
+}
+
+// SetBlob sets the "blob" field.
+func (u *TodoUpsert) SetBlob(v []byte) *TodoUpsert {
+	u.Set(todo.FieldBlob, v)
+	return u
+}
+
+// UpdateBlob sets the "blob" field to the value that was provided on create.
+func (u *TodoUpsert) UpdateBlob() *TodoUpsert {
+	u.SetExcluded(todo.FieldBlob)
+	return u
+}
+
+// ClearBlob clears the value of the "blob" field.
+func (u *TodoUpsert) ClearBlob() *TodoUpsert {
+	u.SetNull(todo.FieldBlob)
+	return u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *TodoUpsert) SetCategoryID(v int) *TodoUpsert {
+	u.Set(todo.FieldCategoryID, v)
+	return u
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *TodoUpsert) UpdateCategoryID() *TodoUpsert {
+	u.SetExcluded(todo.FieldCategoryID)
+	return u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (u *TodoUpsert) ClearCategoryID() *TodoUpsert {
+	u.SetNull(todo.FieldCategoryID)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *TodoUpsert) SetDeletedAt(v time.Time) *TodoUpsert {
+	u.Set(todo.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *TodoUpsert) UpdateDeletedAt() *TodoUpsert {
+	u.SetExcluded(todo.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *TodoUpsert) ClearDeletedAt() *TodoUpsert {
+	u.SetNull(todo.FieldDeletedAt)
+	return u
+}
+
+// SetVersion sets the "version" field.
+func (u *TodoUpsert) SetVersion(v int) *TodoUpsert {
+	u.Set(todo.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *TodoUpsert) UpdateVersion() *TodoUpsert {
+	u.SetExcluded(todo.FieldVersion)
+	return u
+}
+
+// AddVersion adds v to the "version" field.
+func (u *TodoUpsert) AddVersion(v int) *TodoUpsert {
+	u.Add(todo.FieldVersion, v)
+	return u
+}
+
+// SetLabel sets the "label" field.
+func (u *TodoUpsert) SetLabel(v string) *TodoUpsert {
+	u.Set(todo.FieldLabel, v)
+	return u
+}
+
+// UpdateLabel sets the "label" field to the value that was provided on create.
+func (u *TodoUpsert) UpdateLabel() *TodoUpsert {
+	u.SetExcluded(todo.FieldLabel)
+	return u
+}
+
+// ClearLabel clears the value of the "label" field.
+func (u *TodoUpsert) ClearLabel() *TodoUpsert {
+	u.SetNull(todo.FieldLabel)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Todo.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+//
+func (u *TodoUpsertOne) UpdateNewValues() *TodoUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(todo.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//  client.Todo.Create().
+//      OnConflict(sql.ResolveWithIgnore()).
+//      Exec(ctx)
+//
+func (u *TodoUpsertOne) Ignore() *TodoUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TodoUpsertOne) DoNothing() *TodoUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TodoCreate.OnConflict
+// documentation for more info.
+func (u *TodoUpsertOne) Update(set func(*TodoUpsert)) *TodoUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TodoUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *TodoUpsertOne) SetCreatedAt(v time.Time) *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *TodoUpsertOne) UpdateCreatedAt() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TodoUpsertOne) SetStatus(v todo.Status) *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TodoUpsertOne) UpdateStatus() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *TodoUpsertOne) SetPriority(v int) *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *TodoUpsertOne) AddPriority(v int) *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *TodoUpsertOne) UpdatePriority() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetText sets the "text" field.
+func (u *TodoUpsertOne) SetText(v string) *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetText(v)
+	})
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *TodoUpsertOne) UpdateText() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateText()
+	})
+}
+
+// SetBlob sets the "blob" field.
+func (u *TodoUpsertOne) SetBlob(v []byte) *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetBlob(v)
+	})
+}
+
+// UpdateBlob sets the "blob" field to the value that was provided on create.
+func (u *TodoUpsertOne) UpdateBlob() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateBlob()
+	})
+}
+
+// ClearBlob clears the value of the "blob" field.
+func (u *TodoUpsertOne) ClearBlob() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.ClearBlob()
+	})
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *TodoUpsertOne) SetCategoryID(v int) *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetCategoryID(v)
+	})
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *TodoUpsertOne) UpdateCategoryID() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateCategoryID()
+	})
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (u *TodoUpsertOne) ClearCategoryID() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.ClearCategoryID()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *TodoUpsertOne) SetDeletedAt(v time.Time) *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *TodoUpsertOne) UpdateDeletedAt() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *TodoUpsertOne) ClearDeletedAt() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *TodoUpsertOne) SetVersion(v int) *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *TodoUpsertOne) AddVersion(v int) *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *TodoUpsertOne) UpdateVersion() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetLabel sets the "label" field.
+func (u *TodoUpsertOne) SetLabel(v string) *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetLabel(v)
+	})
+}
+
+// UpdateLabel sets the "label" field to the value that was provided on create.
+func (u *TodoUpsertOne) UpdateLabel() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateLabel()
+	})
+}
+
+// ClearLabel clears the value of the "label" field.
+func (u *TodoUpsertOne) ClearLabel() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.ClearLabel()
+	})
+}
+
+// Exec executes the query.
+func (u *TodoUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TodoCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TodoUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TodoUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TodoUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // TodoCreateBulk is the builder for creating many Todo entities in bulk.
 type TodoCreateBulk struct {
 	config
 	builders []*TodoCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Todo entities in the database.
@@ -444,6 +964,7 @@ func (tcb *TodoCreateBulk) Save(ctx context.Context) ([]*Todo, error) {
 					_, err = mutators[i+1].Mutate(root, tcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, tcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -497,3 +1018,283 @@ func (tcb *TodoCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Todo.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TodoUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (tcb *TodoCreateBulk) OnConflict(opts ...sql.ConflictOption) *TodoUpsertBulk {
+	tcb.conflict = opts
+	return &TodoUpsertBulk{
+		create: tcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Todo.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (tcb *TodoCreateBulk) OnConflictColumns(columns ...string) *TodoUpsertBulk {
+	tcb.conflict = append(tcb.conflict, sql.ConflictColumns(columns...))
+	return &TodoUpsertBulk{
+		create: tcb,
+	}
+}
+
+// TodoUpsertBulk is the builder for "upsert"-ing
+// a bulk of Todo nodes.
+type TodoUpsertBulk struct {
+	create *TodoCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Todo.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+//
+func (u *TodoUpsertBulk) UpdateNewValues() *TodoUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(todo.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Todo.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+//
+func (u *TodoUpsertBulk) Ignore() *TodoUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TodoUpsertBulk) DoNothing() *TodoUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TodoCreateBulk.OnConflict
+// documentation for more info.
+func (u *TodoUpsertBulk) Update(set func(*TodoUpsert)) *TodoUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TodoUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *TodoUpsertBulk) SetCreatedAt(v time.Time) *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *TodoUpsertBulk) UpdateCreatedAt() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TodoUpsertBulk) SetStatus(v todo.Status) *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TodoUpsertBulk) UpdateStatus() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *TodoUpsertBulk) SetPriority(v int) *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *TodoUpsertBulk) AddPriority(v int) *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *TodoUpsertBulk) UpdatePriority() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetText sets the "text" field.
+func (u *TodoUpsertBulk) SetText(v string) *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetText(v)
+	})
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *TodoUpsertBulk) UpdateText() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateText()
+	})
+}
+
+// SetBlob sets the "blob" field.
+func (u *TodoUpsertBulk) SetBlob(v []byte) *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetBlob(v)
+	})
+}
+
+// UpdateBlob sets the "blob" field to the value that was provided on create.
+func (u *TodoUpsertBulk) UpdateBlob() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateBlob()
+	})
+}
+
+// ClearBlob clears the value of the "blob" field.
+func (u *TodoUpsertBulk) ClearBlob() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.ClearBlob()
+	})
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *TodoUpsertBulk) SetCategoryID(v int) *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetCategoryID(v)
+	})
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *TodoUpsertBulk) UpdateCategoryID() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateCategoryID()
+	})
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (u *TodoUpsertBulk) ClearCategoryID() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.ClearCategoryID()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *TodoUpsertBulk) SetDeletedAt(v time.Time) *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *TodoUpsertBulk) UpdateDeletedAt() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *TodoUpsertBulk) ClearDeletedAt() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *TodoUpsertBulk) SetVersion(v int) *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *TodoUpsertBulk) AddVersion(v int) *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *TodoUpsertBulk) UpdateVersion() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetLabel sets the "label" field.
+func (u *TodoUpsertBulk) SetLabel(v string) *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetLabel(v)
+	})
+}
+
+// UpdateLabel sets the "label" field to the value that was provided on create.
+func (u *TodoUpsertBulk) UpdateLabel() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateLabel()
+	})
+}
+
+// ClearLabel clears the value of the "label" field.
+func (u *TodoUpsertBulk) ClearLabel() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.ClearLabel()
+	})
+}
+
+// Exec executes the query.
+func (u *TodoUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TodoCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TodoCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TodoUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
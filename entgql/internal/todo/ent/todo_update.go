@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/contrib/entgql/internal/todo/ent/category"
 	"entgo.io/contrib/entgql/internal/todo/ent/predicate"
@@ -108,6 +109,67 @@ func (tu *TodoUpdate) ClearCategoryID() *TodoUpdate {
 	return tu
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (tu *TodoUpdate) SetDeletedAt(t time.Time) *TodoUpdate {
+	tu.mutation.SetDeletedAt(t)
+	return tu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tu *TodoUpdate) SetNillableDeletedAt(t *time.Time) *TodoUpdate {
+	if t != nil {
+		tu.SetDeletedAt(*t)
+	}
+	return tu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (tu *TodoUpdate) ClearDeletedAt() *TodoUpdate {
+	tu.mutation.ClearDeletedAt()
+	return tu
+}
+
+// SetVersion sets the "version" field.
+func (tu *TodoUpdate) SetVersion(i int) *TodoUpdate {
+	tu.mutation.ResetVersion()
+	tu.mutation.SetVersion(i)
+	return tu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (tu *TodoUpdate) SetNillableVersion(i *int) *TodoUpdate {
+	if i != nil {
+		tu.SetVersion(*i)
+	}
+	return tu
+}
+
+// AddVersion adds i to the "version" field.
+func (tu *TodoUpdate) AddVersion(i int) *TodoUpdate {
+	tu.mutation.AddVersion(i)
+	return tu
+}
+
+// SetLabel sets the "label" field.
+func (tu *TodoUpdate) SetLabel(s string) *TodoUpdate {
+	tu.mutation.SetLabel(s)
+	return tu
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (tu *TodoUpdate) SetNillableLabel(s *string) *TodoUpdate {
+	if s != nil {
+		tu.SetLabel(*s)
+	}
+	return tu
+}
+
+// ClearLabel clears the value of the "label" field.
+func (tu *TodoUpdate) ClearLabel() *TodoUpdate {
+	tu.mutation.ClearLabel()
+	return tu
+}
+
 // SetParentID sets the "parent" edge to the Todo entity by ID.
 func (tu *TodoUpdate) SetParentID(id int) *TodoUpdate {
 	tu.mutation.SetParentID(id)
@@ -344,6 +406,46 @@ func (tu *TodoUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: todo.FieldBlob,
 		})
 	}
+	if value, ok := tu.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: todo.FieldDeletedAt,
+		})
+	}
+	if tu.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: todo.FieldDeletedAt,
+		})
+	}
+	if value, ok := tu.mutation.Version(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: todo.FieldVersion,
+		})
+	}
+	if value, ok := tu.mutation.AddedVersion(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: todo.FieldVersion,
+		})
+	}
+	if value, ok := tu.mutation.Label(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: todo.FieldLabel,
+		})
+	}
+	if tu.mutation.LabelCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: todo.FieldLabel,
+		})
+	}
 	if tu.mutation.ParentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -587,6 +689,67 @@ func (tuo *TodoUpdateOne) ClearCategoryID() *TodoUpdateOne {
 	return tuo
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (tuo *TodoUpdateOne) SetDeletedAt(t time.Time) *TodoUpdateOne {
+	tuo.mutation.SetDeletedAt(t)
+	return tuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableDeletedAt(t *time.Time) *TodoUpdateOne {
+	if t != nil {
+		tuo.SetDeletedAt(*t)
+	}
+	return tuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (tuo *TodoUpdateOne) ClearDeletedAt() *TodoUpdateOne {
+	tuo.mutation.ClearDeletedAt()
+	return tuo
+}
+
+// SetVersion sets the "version" field.
+func (tuo *TodoUpdateOne) SetVersion(i int) *TodoUpdateOne {
+	tuo.mutation.ResetVersion()
+	tuo.mutation.SetVersion(i)
+	return tuo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableVersion(i *int) *TodoUpdateOne {
+	if i != nil {
+		tuo.SetVersion(*i)
+	}
+	return tuo
+}
+
+// AddVersion adds i to the "version" field.
+func (tuo *TodoUpdateOne) AddVersion(i int) *TodoUpdateOne {
+	tuo.mutation.AddVersion(i)
+	return tuo
+}
+
+// SetLabel sets the "label" field.
+func (tuo *TodoUpdateOne) SetLabel(s string) *TodoUpdateOne {
+	tuo.mutation.SetLabel(s)
+	return tuo
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableLabel(s *string) *TodoUpdateOne {
+	if s != nil {
+		tuo.SetLabel(*s)
+	}
+	return tuo
+}
+
+// ClearLabel clears the value of the "label" field.
+func (tuo *TodoUpdateOne) ClearLabel() *TodoUpdateOne {
+	tuo.mutation.ClearLabel()
+	return tuo
+}
+
 // SetParentID sets the "parent" edge to the Todo entity by ID.
 func (tuo *TodoUpdateOne) SetParentID(id int) *TodoUpdateOne {
 	tuo.mutation.SetParentID(id)
@@ -853,6 +1016,46 @@ func (tuo *TodoUpdateOne) sqlSave(ctx context.Context) (_node *Todo, err error)
 			Column: todo.FieldBlob,
 		})
 	}
+	if value, ok := tuo.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: todo.FieldDeletedAt,
+		})
+	}
+	if tuo.mutation.DeletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: todo.FieldDeletedAt,
+		})
+	}
+	if value, ok := tuo.mutation.Version(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: todo.FieldVersion,
+		})
+	}
+	if value, ok := tuo.mutation.AddedVersion(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: todo.FieldVersion,
+		})
+	}
+	if value, ok := tuo.mutation.Label(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: todo.FieldLabel,
+		})
+	}
+	if tuo.mutation.LabelCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: todo.FieldLabel,
+		})
+	}
 	if tuo.mutation.ParentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -22,6 +22,7 @@ import (
 	"fmt"
 
 	"entgo.io/contrib/entgql/internal/todo/ent/verysecret"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -31,6 +32,7 @@ type VerySecretCreate struct {
 	config
 	mutation *VerySecretMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetPassword sets the "password" field.
@@ -145,6 +147,7 @@ func (vsc *VerySecretCreate) createSpec() (*VerySecret, *sqlgraph.CreateSpec) {
 			},
 		}
 	)
+	_spec.OnConflict = vsc.conflict
 	if value, ok := vsc.mutation.Password(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -156,10 +159,163 @@ func (vsc *VerySecretCreate) createSpec() (*VerySecret, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.VerySecret.Create().
+//		SetPassword(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.VerySecretUpsert) {
+//			SetPassword(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (vsc *VerySecretCreate) OnConflict(opts ...sql.ConflictOption) *VerySecretUpsertOne {
+	vsc.conflict = opts
+	return &VerySecretUpsertOne{
+		create: vsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.VerySecret.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (vsc *VerySecretCreate) OnConflictColumns(columns ...string) *VerySecretUpsertOne {
+	vsc.conflict = append(vsc.conflict, sql.ConflictColumns(columns...))
+	return &VerySecretUpsertOne{
+		create: vsc,
+	}
+}
+
+type (
+	// VerySecretUpsertOne is the builder for "upsert"-ing
+	//  one VerySecret node.
+	VerySecretUpsertOne struct {
+		create *VerySecretCreate
+	}
+
+	// VerySecretUpsert is the "OnConflict" setter.
+	VerySecretUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPassword sets the "password" field.
+func (u *VerySecretUpsert) SetPassword(v string) *VerySecretUpsert {
+	u.Set(verysecret.FieldPassword, v)
+	return u
+}
+
+// UpdatePassword sets the "password" field to the value that was provided on create.
+func (u *VerySecretUpsert) UpdatePassword() *VerySecretUpsert {
+	u.SetExcluded(verysecret.FieldPassword)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.VerySecret.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+//
+func (u *VerySecretUpsertOne) UpdateNewValues() *VerySecretUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//  client.VerySecret.Create().
+//      OnConflict(sql.ResolveWithIgnore()).
+//      Exec(ctx)
+//
+func (u *VerySecretUpsertOne) Ignore() *VerySecretUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *VerySecretUpsertOne) DoNothing() *VerySecretUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the VerySecretCreate.OnConflict
+// documentation for more info.
+func (u *VerySecretUpsertOne) Update(set func(*VerySecretUpsert)) *VerySecretUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&VerySecretUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPassword sets the "password" field.
+func (u *VerySecretUpsertOne) SetPassword(v string) *VerySecretUpsertOne {
+	return u.Update(func(s *VerySecretUpsert) {
+		s.SetPassword(v)
+	})
+}
+
+// UpdatePassword sets the "password" field to the value that was provided on create.
+func (u *VerySecretUpsertOne) UpdatePassword() *VerySecretUpsertOne {
+	return u.Update(func(s *VerySecretUpsert) {
+		s.UpdatePassword()
+	})
+}
+
+// Exec executes the query.
+func (u *VerySecretUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for VerySecretCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *VerySecretUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *VerySecretUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *VerySecretUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // VerySecretCreateBulk is the builder for creating many VerySecret entities in bulk.
 type VerySecretCreateBulk struct {
 	config
 	builders []*VerySecretCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the VerySecret entities in the database.
@@ -185,6 +341,7 @@ func (vscb *VerySecretCreateBulk) Save(ctx context.Context) ([]*VerySecret, erro
 					_, err = mutators[i+1].Mutate(root, vscb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = vscb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, vscb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -238,3 +395,122 @@ func (vscb *VerySecretCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.VerySecret.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.VerySecretUpsert) {
+//			SetPassword(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (vscb *VerySecretCreateBulk) OnConflict(opts ...sql.ConflictOption) *VerySecretUpsertBulk {
+	vscb.conflict = opts
+	return &VerySecretUpsertBulk{
+		create: vscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.VerySecret.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (vscb *VerySecretCreateBulk) OnConflictColumns(columns ...string) *VerySecretUpsertBulk {
+	vscb.conflict = append(vscb.conflict, sql.ConflictColumns(columns...))
+	return &VerySecretUpsertBulk{
+		create: vscb,
+	}
+}
+
+// VerySecretUpsertBulk is the builder for "upsert"-ing
+// a bulk of VerySecret nodes.
+type VerySecretUpsertBulk struct {
+	create *VerySecretCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.VerySecret.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+//
+func (u *VerySecretUpsertBulk) UpdateNewValues() *VerySecretUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.VerySecret.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+//
+func (u *VerySecretUpsertBulk) Ignore() *VerySecretUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *VerySecretUpsertBulk) DoNothing() *VerySecretUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the VerySecretCreateBulk.OnConflict
+// documentation for more info.
+func (u *VerySecretUpsertBulk) Update(set func(*VerySecretUpsert)) *VerySecretUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&VerySecretUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPassword sets the "password" field.
+func (u *VerySecretUpsertBulk) SetPassword(v string) *VerySecretUpsertBulk {
+	return u.Update(func(s *VerySecretUpsert) {
+		s.SetPassword(v)
+	})
+}
+
+// UpdatePassword sets the "password" field to the value that was provided on create.
+func (u *VerySecretUpsertBulk) UpdatePassword() *VerySecretUpsertBulk {
+	return u.Update(func(s *VerySecretUpsert) {
+		s.UpdatePassword()
+	})
+}
+
+// Exec executes the query.
+func (u *VerySecretUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the VerySecretCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for VerySecretCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *VerySecretUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
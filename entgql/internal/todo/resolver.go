@@ -15,16 +15,27 @@
 package todo
 
 import (
+	"entgo.io/contrib/entgql"
 	"entgo.io/contrib/entgql/internal/todo/ent"
 	"github.com/99designs/gqlgen/graphql"
 )
 
 // Resolver is the resolver root.
-type Resolver struct{ client *ent.Client }
+type Resolver struct {
+	client *ent.Client
+	pubsub entgql.PubSub
+}
 
-// NewSchema creates a graphql executable schema.
+// NewSchema creates a graphql executable schema. The change events of
+// the ent client are published to the subscriptions of the schema.
 func NewSchema(client *ent.Client) graphql.ExecutableSchema {
+	pubsub := entgql.NewMemoryPubSub()
+	client.Use(ent.SubscriptionHook(pubsub))
 	return NewExecutableSchema(Config{
-		Resolvers: &Resolver{client},
+		Resolvers: &Resolver{client: client, pubsub: pubsub},
+		Directives: DirectiveRoot{
+			Authorize:  ent.AuthorizeDirective,
+			Constraint: entgql.ConstraintDirective,
+		},
 	})
 }
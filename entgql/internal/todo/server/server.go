@@ -15,6 +15,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	"entgo.io/contrib/entgql"
@@ -35,6 +36,7 @@ func main() {
 	var cli struct {
 		Addr  string `name:"address" default:":8081" help:"Address to listen on."`
 		Debug bool   `name:"debug" help:"Enable debugging mode."`
+		Admin bool   `name:"admin" help:"Allow access to the fields protected by the admin rule."`
 	}
 	kong.Parse(&cli)
 
@@ -55,6 +57,21 @@ func main() {
 
 	srv := handler.NewDefaultServer(todo.NewSchema(client))
 	srv.Use(entgql.Transactioner{TxOpener: client})
+	srv.Use(&entgql.ComplexityLimit{
+		Funcs:         ent.ComplexityFuncs(100),
+		MaxComplexity: 10000,
+		MaxDepth:      15,
+	})
+	srv.Use(entgql.Authorizer{
+		AuthorizePolicy: entgql.AuthorizePolicyFunc(func(_ context.Context, _ interface{}, rules []string) error {
+			for _, r := range rules {
+				if r == "admin" && !cli.Admin {
+					return errors.New("admin access required")
+				}
+			}
+			return nil
+		}),
+	})
 	if cli.Debug {
 		srv.Use(&debug.Tracer{})
 	}
@@ -25,13 +25,6 @@ import (
 	"entgo.io/contrib/entgql/internal/todo/ent/todo"
 )
 
-func (r *mutationResolver) CreateTodo(ctx context.Context, input ent.CreateTodoInput) (*ent.Todo, error) {
-	return ent.FromContext(ctx).Todo.
-		Create().
-		SetInput(input).
-		Save(ctx)
-}
-
 func (r *mutationResolver) ClearTodos(ctx context.Context) (int, error) {
 	client := ent.FromContext(ctx)
 	return client.Todo.
@@ -58,8 +51,3 @@ func (r *todoWhereInputResolver) CreatedToday(ctx context.Context, obj *ent.Todo
 
 	return nil
 }
-
-// Mutation returns MutationResolver implementation.
-func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
-
-type mutationResolver struct{ *Resolver }
@@ -20,9 +20,11 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"net/http/httptest"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -37,13 +39,16 @@ import (
 	"entgo.io/contrib/entgql/internal/todo/ent"
 	"entgo.io/contrib/entgql/internal/todo/ent/category"
 	"entgo.io/contrib/entgql/internal/todo/ent/enttest"
+	"entgo.io/contrib/entgql/internal/todo/ent/gqlclient"
 	"entgo.io/contrib/entgql/internal/todo/ent/migrate"
+	"entgo.io/contrib/entgql/internal/todo/ent/schema/schematype"
 	"entgo.io/contrib/entgql/internal/todo/ent/todo"
 	"entgo.io/ent/dialect"
 	"github.com/99designs/gqlgen/client"
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/stretchr/testify/suite"
+	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
 	_ "github.com/mattn/go-sqlite3"
@@ -520,6 +525,385 @@ func (s *todoTestSuite) TestPaginationOrder() {
 	})
 }
 
+func (s *todoTestSuite) TestPaginationMultiOrder() {
+	const (
+		query = `query($after: Cursor, $first: Int, $before: Cursor, $last: Int) {
+			todos(after: $after, first: $first, before: $before, last: $last, orderBy: [{field: STATUS}, {field: PRIORITY, direction: DESC}]) {
+				totalCount
+				edges {
+					node {
+						id
+					}
+					cursor
+				}
+				pageInfo {
+					hasNextPage
+					hasPreviousPage
+					startCursor
+					endCursor
+				}
+			}
+		}`
+		step  = 5
+		steps = maxTodos/step + 1
+	)
+	ctx := context.Background()
+	s.ent.Todo.Update().
+		Where(todo.PriorityLT(maxTodos / 2)).
+		SetStatus(todo.StatusInProgress).
+		ExecX(ctx)
+	ids := s.ent.Todo.Query().
+		Order(ent.Asc(todo.FieldStatus), ent.Desc(todo.FieldPriority), ent.Asc(todo.FieldID)).
+		IDsX(ctx)
+	expected := make([]string, len(ids))
+	for i, id := range ids {
+		expected[i] = strconv.Itoa(id)
+	}
+
+	s.Run("Forward", func() {
+		var (
+			rsp response
+			got []string
+		)
+		for i := 0; i < steps; i++ {
+			err := s.Post(query, &rsp,
+				client.Var("after", rsp.Todos.PageInfo.EndCursor),
+				client.Var("first", step),
+			)
+			s.Require().NoError(err)
+			s.Require().Equal(maxTodos, rsp.Todos.TotalCount)
+			s.Require().Equal(i < steps-1, rsp.Todos.PageInfo.HasNextPage)
+			for _, e := range rsp.Todos.Edges {
+				got = append(got, e.Node.ID)
+			}
+		}
+		s.Require().Equal(expected, got)
+	})
+	s.Run("Backward", func() {
+		var (
+			rsp response
+			got []string
+		)
+		for i := 0; i < steps; i++ {
+			err := s.Post(query, &rsp,
+				client.Var("before", rsp.Todos.PageInfo.StartCursor),
+				client.Var("last", step),
+			)
+			s.Require().NoError(err)
+			s.Require().Equal(maxTodos, rsp.Todos.TotalCount)
+			s.Require().Equal(i < steps-1, rsp.Todos.PageInfo.HasPreviousPage)
+			page := make([]string, 0, len(rsp.Todos.Edges))
+			for _, e := range rsp.Todos.Edges {
+				page = append(page, e.Node.ID)
+			}
+			got = append(page, got...)
+		}
+		s.Require().Equal(expected, got)
+	})
+	s.Run("Nested", func() {
+		const query = `query($id: ID!) {
+			node(id: $id) {
+				... on Todo {
+					children(first: 3, orderBy: [{field: STATUS}, {field: PRIORITY, direction: DESC}]) {
+						edges {
+							node {
+								id
+							}
+						}
+					}
+				}
+			}
+		}`
+		var rsp struct {
+			Node struct {
+				Children struct {
+					Edges []struct {
+						Node struct {
+							ID string
+						}
+					}
+				}
+			}
+		}
+		root := idOffset + 1
+		err := s.Post(query, &rsp, client.Var("id", root))
+		s.Require().NoError(err)
+		ids := s.ent.Todo.Query().
+			Where(todo.HasParentWith(todo.ID(root))).
+			Order(ent.Asc(todo.FieldStatus), ent.Desc(todo.FieldPriority), ent.Asc(todo.FieldID)).
+			Limit(3).
+			IDsX(ctx)
+		s.Require().Len(rsp.Node.Children.Edges, len(ids))
+		for i, id := range ids {
+			s.Require().Equal(strconv.Itoa(id), rsp.Node.Children.Edges[i].Node.ID)
+		}
+	})
+}
+
+func (s *todoTestSuite) TestPaginationEdgeOrder() {
+	const (
+		query = `query($after: Cursor, $first: Int, $before: Cursor, $last: Int, $orderBy: TodoOrder!) {
+			todos(after: $after, first: $first, before: $before, last: $last, orderBy: [$orderBy]) {
+				totalCount
+				edges {
+					node {
+						id
+					}
+					cursor
+				}
+				pageInfo {
+					hasNextPage
+					hasPreviousPage
+					startCursor
+					endCursor
+				}
+			}
+		}`
+		step  = 5
+		steps = maxTodos/step + 1
+	)
+	ctx := context.Background()
+	todos := s.ent.Todo.Query().WithChildren().AllX(ctx)
+	texts := []string{"c", "a", "b"}
+	categories := make(map[int]string, len(todos))
+	for i, t := range todos {
+		text := texts[i%len(texts)]
+		s.ent.Category.Create().SetText(text).SetStatus(category.StatusEnabled).AddTodoIDs(t.ID).ExecX(ctx)
+		categories[t.ID] = text
+	}
+	ids := func(less func(a, b *ent.Todo) bool) []string {
+		sort.Slice(todos, func(i, j int) bool { return less(todos[i], todos[j]) })
+		ids := make([]string, len(todos))
+		for i, t := range todos {
+			ids[i] = strconv.Itoa(t.ID)
+		}
+		return ids
+	}
+	for _, tt := range []struct {
+		name     string
+		orderBy  map[string]interface{}
+		expected []string
+	}{
+		{
+			name:    "ChildrenCount",
+			orderBy: map[string]interface{}{"field": "CHILDREN_COUNT", "direction": "DESC"},
+			expected: ids(func(a, b *ent.Todo) bool {
+				if n, m := len(a.Edges.Children), len(b.Edges.Children); n != m {
+					return n > m
+				}
+				return a.ID > b.ID
+			}),
+		},
+		{
+			name:    "CategoryText",
+			orderBy: map[string]interface{}{"field": "CATEGORY_TEXT"},
+			expected: ids(func(a, b *ent.Todo) bool {
+				if x, y := categories[a.ID], categories[b.ID]; x != y {
+					return x < y
+				}
+				return a.ID < b.ID
+			}),
+		},
+	} {
+		s.Run(tt.name+"/Forward", func() {
+			var (
+				rsp response
+				got []string
+			)
+			for i := 0; i < steps; i++ {
+				err := s.Post(query, &rsp,
+					client.Var("after", rsp.Todos.PageInfo.EndCursor),
+					client.Var("first", step),
+					client.Var("orderBy", tt.orderBy),
+				)
+				s.Require().NoError(err)
+				s.Require().Equal(maxTodos, rsp.Todos.TotalCount)
+				s.Require().Equal(i < steps-1, rsp.Todos.PageInfo.HasNextPage)
+				for _, e := range rsp.Todos.Edges {
+					got = append(got, e.Node.ID)
+				}
+			}
+			s.Require().Equal(tt.expected, got)
+		})
+		s.Run(tt.name+"/Backward", func() {
+			var (
+				rsp response
+				got []string
+			)
+			for i := 0; i < steps; i++ {
+				err := s.Post(query, &rsp,
+					client.Var("before", rsp.Todos.PageInfo.StartCursor),
+					client.Var("last", step),
+					client.Var("orderBy", tt.orderBy),
+				)
+				s.Require().NoError(err)
+				s.Require().Equal(maxTodos, rsp.Todos.TotalCount)
+				s.Require().Equal(i < steps-1, rsp.Todos.PageInfo.HasPreviousPage)
+				page := make([]string, 0, len(rsp.Todos.Edges))
+				for _, e := range rsp.Todos.Edges {
+					page = append(page, e.Node.ID)
+				}
+				got = append(page, got...)
+			}
+			s.Require().Equal(tt.expected, got)
+		})
+	}
+	s.Run("Nested", func() {
+		const query = `query($id: ID!) {
+			node(id: $id) {
+				... on Todo {
+					children(first: 3, orderBy: {field: CHILDREN_COUNT, direction: DESC}) {
+						edges {
+							node {
+								id
+							}
+						}
+					}
+				}
+			}
+		}`
+		var rsp struct {
+			Node struct {
+				Children struct {
+					Edges []struct {
+						Node struct {
+							ID string
+						}
+					}
+				}
+			}
+		}
+		root := idOffset + 1
+		err := s.Post(query, &rsp, client.Var("id", root))
+		s.Require().NoError(err)
+		children := s.ent.Todo.Query().
+			Where(todo.HasParentWith(todo.ID(root))).
+			WithChildren().
+			AllX(ctx)
+		sort.Slice(children, func(i, j int) bool {
+			if n, m := len(children[i].Edges.Children), len(children[j].Edges.Children); n != m {
+				return n > m
+			}
+			return children[i].ID > children[j].ID
+		})
+		s.Require().Len(rsp.Node.Children.Edges, 3)
+		for i, c := range children[:3] {
+			s.Require().Equal(strconv.Itoa(c.ID), rsp.Node.Children.Edges[i].Node.ID)
+		}
+	})
+}
+
+func (s *todoTestSuite) TestAggregate() {
+	const query = `query($where: TodoWhereInput, $groupBy: [TodoGroupField!]) {
+		todosAggregate(where: $where, groupBy: $groupBy) {
+			count
+			status
+			priority
+			sum {
+				priority
+			}
+			avg {
+				priority
+			}
+			min {
+				priority
+			}
+			max {
+				priority
+			}
+		}
+	}`
+	type values struct {
+		Priority *float64
+	}
+	var rsp struct {
+		TodosAggregate []struct {
+			Count    int
+			Status   *todo.Status
+			Priority *int
+			Sum, Avg values
+			Min, Max values
+		}
+	}
+	ctx := context.Background()
+	s.ent.Todo.Update().
+		Where(todo.PriorityLTE(maxTodos / 4)).
+		SetStatus(todo.StatusInProgress).
+		ExecX(ctx)
+
+	s.Run("All", func() {
+		err := s.Post(query, &rsp)
+		s.Require().NoError(err)
+		s.Require().Len(rsp.TodosAggregate, 1)
+		agg := rsp.TodosAggregate[0]
+		s.Require().Equal(maxTodos, agg.Count)
+		s.Require().Nil(agg.Status)
+		s.Require().Nil(agg.Priority)
+		s.Require().Equal(float64(maxTodos*(maxTodos+1)/2), *agg.Sum.Priority)
+		s.Require().Equal(float64(maxTodos+1)/2, *agg.Avg.Priority)
+		s.Require().Equal(float64(1), *agg.Min.Priority)
+		s.Require().Equal(float64(maxTodos), *agg.Max.Priority)
+	})
+	s.Run("GroupBy", func() {
+		err := s.Post(query, &rsp, client.Var("groupBy", []string{"STATUS"}))
+		s.Require().NoError(err)
+		s.Require().Len(rsp.TodosAggregate, 2)
+		completed, inProgress := rsp.TodosAggregate[0], rsp.TodosAggregate[1]
+		s.Require().Equal(todo.StatusCompleted, *completed.Status)
+		s.Require().Equal(maxTodos-maxTodos/4, completed.Count)
+		s.Require().Equal(float64(maxTodos/4+1), *completed.Min.Priority)
+		s.Require().Equal(todo.StatusInProgress, *inProgress.Status)
+		s.Require().Equal(maxTodos/4, inProgress.Count)
+		s.Require().Equal(float64(maxTodos/4), *inProgress.Max.Priority)
+		s.Require().Nil(inProgress.Priority)
+	})
+	s.Run("Where", func() {
+		err := s.Post(query, &rsp,
+			client.Var("where", map[string]interface{}{"priorityGT": maxTodos - 2}),
+			client.Var("groupBy", []string{"STATUS", "PRIORITY"}),
+		)
+		s.Require().NoError(err)
+		s.Require().Len(rsp.TodosAggregate, 2)
+		for i, agg := range rsp.TodosAggregate {
+			s.Require().Equal(1, agg.Count)
+			s.Require().Equal(todo.StatusCompleted, *agg.Status)
+			s.Require().Equal(maxTodos-1+i, *agg.Priority)
+			s.Require().Equal(float64(maxTodos-1+i), *agg.Sum.Priority)
+		}
+	})
+	s.Run("StorageKey", func() {
+		s.ent.Todo.Update().
+			Where(todo.PriorityGT(maxTodos - 2)).
+			SetLabel("urgent").
+			ExecX(ctx)
+		var rsp struct {
+			TodosAggregate []struct {
+				Count int
+				Label *string
+			}
+		}
+		err := s.Post(`query {
+			todosAggregate(groupBy: [LABEL]) {
+				count
+				label
+			}
+		}`, &rsp)
+		s.Require().NoError(err)
+		s.Require().Len(rsp.TodosAggregate, 2)
+		s.Require().Nil(rsp.TodosAggregate[0].Label)
+		s.Require().Equal(maxTodos-2, rsp.TodosAggregate[0].Count)
+		s.Require().Equal("urgent", *rsp.TodosAggregate[1].Label)
+		s.Require().Equal(2, rsp.TodosAggregate[1].Count)
+	})
+	s.Run("Empty", func() {
+		err := s.Post(query, &rsp, client.Var("where", map[string]interface{}{"priorityGT": maxTodos}))
+		s.Require().NoError(err)
+		s.Require().Len(rsp.TodosAggregate, 1)
+		s.Require().Zero(rsp.TodosAggregate[0].Count)
+		s.Require().Nil(rsp.TodosAggregate[0].Sum.Priority)
+	})
+}
+
 func (s *todoTestSuite) TestPaginationFiltering() {
 	const (
 		query = `query($after: Cursor, $first: Int, $before: Cursor, $last: Int, $status: TodoStatus, $hasParent: Boolean, $hasCategory: Boolean) {
@@ -1081,6 +1465,115 @@ func (s *todoTestSuite) TestMutationFieldCollection() {
 	s.Require().Equal(strconv.Itoa(idOffset+1), rsp.CreateTodo.Parent.Text)
 }
 
+func (s *todoTestSuite) TestMutationDelete() {
+	var rsp struct {
+		DeleteTodo string
+	}
+	id := strconv.Itoa(idOffset + maxTodos)
+	err := s.Post(`mutation($id: ID!) {
+		deleteTodo(id: $id)
+	}`, &rsp, client.Var("id", id))
+	s.Require().NoError(err)
+	s.Require().Equal(id, rsp.DeleteTodo)
+	exists, err := s.ent.Todo.Query().Where(todo.ID(idOffset + maxTodos)).Exist(context.Background())
+	s.Require().NoError(err)
+	s.Require().False(exists)
+
+	err = s.Post(`mutation($id: ID!) {
+		deleteTodo(id: $id)
+	}`, &rsp, client.Var("id", id))
+	s.Require().Error(err)
+}
+
+func (s *todoTestSuite) TestMutationNestedCreate() {
+	var rsp struct {
+		CreateTodo struct {
+			Text     string
+			Category struct {
+				Text string
+			}
+			Children struct {
+				TotalCount int
+				Edges      []struct {
+					Node struct {
+						Text     string
+						Children struct {
+							TotalCount int
+						}
+					}
+				}
+			}
+		}
+	}
+	const mutation = `mutation($input: CreateTodoInput!) {
+		createTodo(input: $input) {
+			text
+			category {
+				text
+			}
+			children(orderBy: {field: TEXT}) {
+				totalCount
+				edges {
+					node {
+						text
+						children {
+							totalCount
+						}
+					}
+				}
+			}
+		}
+	}`
+	err := s.Post(mutation, &rsp, client.Var("input", map[string]interface{}{
+		"status":         "IN_PROGRESS",
+		"text":           "parent",
+		"createCategory": map[string]interface{}{"text": "category", "status": "ENABLED"},
+		"createChildren": []map[string]interface{}{
+			{"status": "IN_PROGRESS", "text": "a", "createChildren": []map[string]interface{}{
+				{"status": "COMPLETED", "text": "c"},
+			}},
+			{"status": "COMPLETED", "text": "b"},
+		},
+	}))
+	s.Require().NoError(err)
+	s.Require().Equal("parent", rsp.CreateTodo.Text)
+	s.Require().Equal("category", rsp.CreateTodo.Category.Text)
+	s.Require().Equal(2, rsp.CreateTodo.Children.TotalCount)
+	s.Require().Equal("a", rsp.CreateTodo.Children.Edges[0].Node.Text)
+	s.Require().Equal(1, rsp.CreateTodo.Children.Edges[0].Node.Children.TotalCount)
+	s.Require().Equal("b", rsp.CreateTodo.Children.Edges[1].Node.Text)
+	s.Require().Equal(0, rsp.CreateTodo.Children.Edges[1].Node.Children.TotalCount)
+
+	ctx := context.Background()
+	count := s.ent.Todo.Query().CountX(ctx)
+	s.Run("Rollback", func() {
+		// Empty text fails the validation of the nested child,
+		// and the whole mutation is rolled back by the Transactioner.
+		err := s.Post(mutation, &rsp, client.Var("input", map[string]interface{}{
+			"status": "IN_PROGRESS",
+			"text":   "parent",
+			"createChildren": []map[string]interface{}{
+				{"status": "IN_PROGRESS", "text": "a"},
+				{"status": "IN_PROGRESS", "text": ""},
+			},
+		}))
+		s.Require().Error(err)
+		s.Require().Equal(count, s.ent.Todo.Query().CountX(ctx))
+		s.Require().Equal(1, s.ent.Category.Query().CountX(ctx))
+	})
+	s.Run("Conflict", func() {
+		cat := s.ent.Category.Query().OnlyX(ctx)
+		err := s.Post(mutation, &rsp, client.Var("input", map[string]interface{}{
+			"status":         "IN_PROGRESS",
+			"text":           "parent",
+			"categoryID":     cat.ID,
+			"createCategory": map[string]interface{}{"text": "category", "status": "ENABLED"},
+		}))
+		s.Require().EqualError(err, `[{"message":"ent: categoryID and createCategory cannot be set together in CreateTodoInput","path":["createTodo"]}]`)
+		s.Require().Equal(count, s.ent.Todo.Query().CountX(ctx))
+	})
+}
+
 func (s *todoTestSuite) TestQueryJSONFields() {
 	var (
 		ctx = context.Background()
@@ -1105,6 +1598,66 @@ func (s *todoTestSuite) TestQueryJSONFields() {
 	s.Require().Equal(cat.Strings, rsp.Node.Strings)
 }
 
+func (s *todoTestSuite) TestQueryEdgeSchema() {
+	var (
+		ctx   = context.Background()
+		a8m   = s.ent.User.Create().SetName("a8m").SaveX(ctx)
+		nati  = s.ent.User.Create().SetName("nati").SaveX(ctx)
+		ariel = s.ent.User.Create().SetName("ariel").SaveX(ctx)
+		now   = time.Now()
+	)
+	s.ent.Friendship.Create().SetUser(a8m).SetFriend(nati).SetCreatedAt(now.Add(-time.Hour)).ExecX(ctx)
+	s.ent.Friendship.Create().SetUser(a8m).SetFriend(ariel).SetCreatedAt(now).ExecX(ctx)
+
+	const query = `query($id: ID!, $where: FriendshipWhereInput) {
+		node(id: $id) {
+			... on User {
+				friendships(orderBy: {direction: DESC, field: CREATED_AT}, where: $where) {
+					totalCount
+					edges {
+						node {
+							friend {
+								name
+							}
+						}
+					}
+				}
+			}
+		}
+	}`
+	type response struct {
+		Node struct {
+			Friendships struct {
+				TotalCount int
+				Edges      []struct {
+					Node struct {
+						Friend struct {
+							Name string
+						}
+					}
+				}
+			}
+		}
+	}
+	s.Run("Order", func() {
+		var rsp response
+		err := s.Post(query, &rsp, client.Var("id", a8m.ID))
+		s.Require().NoError(err)
+		s.Require().Equal(2, rsp.Node.Friendships.TotalCount)
+		s.Require().Equal("ariel", rsp.Node.Friendships.Edges[0].Node.Friend.Name)
+		s.Require().Equal("nati", rsp.Node.Friendships.Edges[1].Node.Friend.Name)
+	})
+	s.Run("Filter", func() {
+		var rsp response
+		err := s.Post(query, &rsp, client.Var("id", a8m.ID), client.Var("where", map[string]interface{}{
+			"hasFriendWith": []map[string]interface{}{{"name": "nati"}},
+		}))
+		s.Require().NoError(err)
+		s.Require().Equal(1, rsp.Node.Friendships.TotalCount)
+		s.Require().Equal("nati", rsp.Node.Friendships.Edges[0].Node.Friend.Name)
+	})
+}
+
 func TestPageInfo(t *testing.T) {
 	ctx := context.Background()
 	ec := enttest.Open(
@@ -1660,3 +2213,1280 @@ func TestEdgesFiltering(t *testing.T) {
 		require.EqualValues(t, 4, count.n)
 	})
 }
+
+func TestSubscriptions(t *testing.T) {
+	ec := enttest.Open(
+		t, dialect.SQLite,
+		fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	defer ec.Close()
+	ps := entgql.NewMemoryPubSub()
+	ec.Use(ent.SubscriptionHook(ps))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	r := ec.SubscriptionResolver(ps)
+	created, err := r.TodoCreated(ctx)
+	require.NoError(t, err)
+	updated, err := r.TodoUpdated(ctx, nil)
+	require.NoError(t, err)
+	deleted, err := r.TodoDeleted(ctx)
+	require.NoError(t, err)
+
+	t1 := ec.Todo.Create().SetText("t1").SetStatus(todo.StatusInProgress).SaveX(ctx)
+	require.Equal(t, t1.ID, receiveTodo(t, created).ID)
+
+	t2 := ec.Todo.Create().SetText("t2").SetStatus(todo.StatusInProgress).SaveX(ctx)
+	require.Equal(t, t2.ID, receiveTodo(t, created).ID)
+
+	t1.Update().SetText("t1 updated").ExecX(ctx)
+	require.Equal(t, "t1 updated", receiveTodo(t, updated).Text)
+
+	t.Run("FilterUpdated", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		only, err := r.TodoUpdated(ctx, &t2.ID)
+		require.NoError(t, err)
+		ec.Todo.Update().Where(todo.IDIn(t1.ID, t2.ID)).SetPriority(1).ExecX(ctx)
+		require.Equal(t, t2.ID, receiveTodo(t, only).ID)
+		got := []int{receiveTodo(t, updated).ID, receiveTodo(t, updated).ID}
+		require.ElementsMatch(t, []int{t1.ID, t2.ID}, got)
+		select {
+		case n := <-only:
+			t.Fatalf("unexpected update of todo %d", n.ID)
+		case <-time.After(50 * time.Millisecond):
+		}
+	})
+
+	t.Run("Tx", func(t *testing.T) {
+		tx, err := ec.Tx(ctx)
+		require.NoError(t, err)
+		ctx := ent.NewTxContext(ctx, tx)
+		tt := tx.Todo.Create().SetText("tx").SetStatus(todo.StatusCompleted).SaveX(ctx)
+		select {
+		case <-created:
+			t.Fatal("unexpected event before commit")
+		case <-time.After(50 * time.Millisecond):
+		}
+		require.NoError(t, tx.Commit())
+		require.Equal(t, tt.ID, receiveTodo(t, created).ID)
+		require.NoError(t, ec.Todo.DeleteOneID(tt.ID).Exec(context.Background()))
+		select {
+		case id := <-deleted:
+			require.Equal(t, tt.ID, id)
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for deleted event")
+		}
+	})
+
+	t.Run("Rollback", func(t *testing.T) {
+		tx, err := ec.Tx(ctx)
+		require.NoError(t, err)
+		tx.Todo.Create().SetText("rollback").SetStatus(todo.StatusCompleted).SaveX(ctx)
+		require.NoError(t, tx.Rollback())
+		select {
+		case n := <-created:
+			t.Fatalf("unexpected event for rolled back todo %d", n.ID)
+		case <-time.After(50 * time.Millisecond):
+		}
+	})
+}
+
+func TestComplexityLimit(t *testing.T) {
+	ctx := context.Background()
+	drv, err := sql.Open(dialect.SQLite, fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	require.NoError(t, err)
+	count := &queryCount{Driver: drv}
+	ec := enttest.NewClient(t,
+		enttest.WithOptions(ent.Driver(count)),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	defer ec.Close()
+	g := ec.Group.Create().SetName("group").SaveX(ctx)
+	ec.User.Create().SetName("user").AddGroups(g).ExecX(ctx)
+
+	const query = `query($first: Int, $groups: Int) {
+		users(first: $first) {
+			edges {
+				node {
+					groups(first: $groups) {
+						edges {
+							node {
+								id
+							}
+						}
+					}
+				}
+			}
+		}
+	}`
+	t.Run("Complexity", func(t *testing.T) {
+		srv := handler.NewDefaultServer(gen.NewSchema(ec))
+		srv.Use(&entgql.ComplexityLimit{Funcs: ent.ComplexityFuncs(100), MaxComplexity: 20})
+		gqlc := client.New(srv)
+		var rsp struct {
+			Users struct {
+				Edges []struct {
+					Node struct {
+						Groups struct {
+							Edges []struct {
+								Node struct {
+									ID string
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+		count.reset()
+		err := gqlc.Post(query, &rsp, client.Var("first", 2), client.Var("groups", 1))
+		require.NoError(t, err)
+		require.Len(t, rsp.Users.Edges, 1)
+		require.Len(t, rsp.Users.Edges[0].Node.Groups.Edges, 1)
+		require.NotZero(t, count.value())
+
+		count.reset()
+		err = gqlc.Post(query, &rsp, client.Var("first", 2), client.Var("groups", 3))
+		require.EqualError(t, err, `[{"message":"operation has complexity 22, which exceeds the limit of 20","extensions":{"code":"COMPLEXITY_LIMIT_EXCEEDED"}}]`)
+		require.Zero(t, count.value())
+
+		// Connections without "first" or "last" are multiplied by the default size.
+		err = gqlc.Post(query, &rsp, client.Var("groups", 1))
+		require.EqualError(t, err, `[{"message":"operation has complexity 500, which exceeds the limit of 20","extensions":{"code":"COMPLEXITY_LIMIT_EXCEEDED"}}]`)
+		require.Zero(t, count.value())
+
+		// Offset-paginated fields are multiplied by their "limit" argument.
+		const pageQuery = `query($limit: Int) {
+			usersPage(limit: $limit) {
+				nodes {
+					id
+				}
+			}
+		}`
+		var prsp struct {
+			UsersPage struct {
+				Nodes []struct{ ID string }
+			}
+		}
+		err = gqlc.Post(pageQuery, &prsp, client.Var("limit", 5))
+		require.NoError(t, err)
+		require.Len(t, prsp.UsersPage.Nodes, 1)
+		err = gqlc.Post(pageQuery, &prsp, client.Var("limit", 50))
+		require.EqualError(t, err, `[{"message":"operation has complexity 100, which exceeds the limit of 20","extensions":{"code":"COMPLEXITY_LIMIT_EXCEEDED"}}]`)
+		err = gqlc.Post(pageQuery, &prsp)
+		require.EqualError(t, err, `[{"message":"operation has complexity 200, which exceeds the limit of 20","extensions":{"code":"COMPLEXITY_LIMIT_EXCEEDED"}}]`)
+	})
+	t.Run("Depth", func(t *testing.T) {
+		srv := handler.NewDefaultServer(gen.NewSchema(ec))
+		srv.Use(&entgql.ComplexityLimit{MaxDepth: 6})
+		gqlc := client.New(srv)
+		var rsp struct {
+			Users struct{ TotalCount int }
+		}
+		err := gqlc.Post(`query { users { totalCount } }`, &rsp)
+		require.NoError(t, err)
+		require.Equal(t, 1, rsp.Users.TotalCount)
+
+		count.reset()
+		err = gqlc.Post(query, &rsp, client.Var("first", 1), client.Var("groups", 1))
+		require.EqualError(t, err, `[{"message":"operation has depth 7, which exceeds the limit of 6","extensions":{"code":"DEPTH_LIMIT_EXCEEDED"}}]`)
+		require.Zero(t, count.value())
+	})
+}
+
+func receiveTodo(t *testing.T, ch <-chan *ent.Todo) *ent.Todo {
+	select {
+	case n := <-ch:
+		return n
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for todo event")
+		return nil
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	ctx := context.Background()
+	ec := enttest.Open(t, dialect.SQLite,
+		fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	defer ec.Close()
+	c := ec.Category.Create().SetText("category").SetStatus(category.StatusEnabled).SetCount(10).SaveX(ctx)
+	ec.Todo.Create().SetText("todo").SetStatus(todo.StatusInProgress).SetCategory(c).ExecX(ctx)
+
+	var rules [][]string
+	policy := entgql.AuthorizePolicyFunc(func(_ context.Context, _ interface{}, r []string) error {
+		rules = append(rules, r)
+		return errors.New("admin access required")
+	})
+	newClient := func(p entgql.AuthorizePolicy) *client.Client {
+		srv := handler.NewDefaultServer(gen.NewSchema(ec))
+		if p != nil {
+			srv.Use(entgql.Authorizer{AuthorizePolicy: p})
+		}
+		return client.New(srv)
+	}
+
+	t.Run("Field", func(t *testing.T) {
+		var rsp struct {
+			Node struct {
+				Text  string
+				Count *uint64
+			}
+		}
+		const query = `query($id: ID!) {
+			node(id: $id) {
+				... on Category {
+					text
+					count
+				}
+			}
+		}`
+		rules = nil
+		err := newClient(policy).Post(query, &rsp, client.Var("id", c.ID))
+		require.EqualError(t, err, `[{"message":"admin access required","path":["node","count"]}]`)
+		require.Equal(t, [][]string{{"admin"}}, rules)
+
+		err = newClient(nil).Post(query, &rsp, client.Var("id", c.ID))
+		require.EqualError(t, err, `[{"message":"entgql: unauthorized","path":["node","count"]}]`)
+
+		allow := entgql.AuthorizePolicyFunc(func(context.Context, interface{}, []string) error { return nil })
+		err = newClient(allow).Post(query, &rsp, client.Var("id", c.ID))
+		require.NoError(t, err)
+		require.Equal(t, "category", rsp.Node.Text)
+		require.EqualValues(t, 10, *rsp.Node.Count)
+	})
+
+	t.Run("WhereInput", func(t *testing.T) {
+		var rsp struct {
+			Todos struct {
+				TotalCount int
+			}
+		}
+		const query = `query($where: TodoWhereInput) {
+			todos(where: $where) {
+				totalCount
+			}
+		}`
+		gqlc := newClient(policy)
+		rules = nil
+		err := gqlc.Post(query, &rsp, client.Var("where", map[string]interface{}{
+			"hasCategoryWith": []interface{}{
+				map[string]interface{}{"countGT": 5},
+			},
+		}))
+		require.EqualError(t, err, `[{"message":"admin access required","path":["todos","where","hasCategoryWith",0,"countGT"]}]`)
+		require.Equal(t, [][]string{{"admin"}}, rules)
+
+		rules = nil
+		err = gqlc.Post(query, &rsp, client.Var("where", map[string]interface{}{
+			"hasCategoryWith": []interface{}{
+				map[string]interface{}{"text": "category"},
+			},
+		}))
+		require.NoError(t, err)
+		require.Equal(t, 1, rsp.Todos.TotalCount)
+		require.Empty(t, rules)
+	})
+}
+
+func TestFilteringWhereOps(t *testing.T) {
+	ctx := context.Background()
+	ec := enttest.Open(t, dialect.SQLite,
+		fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	defer ec.Close()
+	srv := handler.NewDefaultServer(gen.NewSchema(ec))
+	gqlc := client.New(srv)
+
+	for i, c := range []*ent.CategoryCreate{
+		ec.Category.Create().SetConfig(&schematype.CategoryConfig{MaxMembers: 5}).SetStrings([]string{"a", "b"}),
+		ec.Category.Create().SetConfig(&schematype.CategoryConfig{MaxMembers: 10}).SetStrings([]string{"b", "c"}),
+		ec.Category.Create().SetConfig(&schematype.CategoryConfig{}),
+		ec.Category.Create(),
+	} {
+		cat := c.SetText(strconv.Itoa(i)).SetStatus(category.StatusEnabled).SaveX(ctx)
+		ec.Todo.Create().SetText(cat.Text).SetStatus(todo.StatusInProgress).SetCategory(cat).ExecX(ctx)
+	}
+
+	const query = `query($where: CategoryWhereInput!) {
+		todos(where: {hasCategoryWith: [$where]}) {
+			edges {
+				node {
+					text
+				}
+			}
+		}
+	}`
+	for _, tt := range []struct {
+		name  string
+		where map[string]interface{}
+		want  []string
+	}{
+		{
+			name:  "HasKey",
+			where: map[string]interface{}{"configHasKey": "maxMembers"},
+			want:  []string{"0", "1"},
+		},
+		{
+			name:  "HasKey/Missing",
+			where: map[string]interface{}{"configHasKey": "minMembers"},
+		},
+		{
+			name: "ValueEQ/Number",
+			where: map[string]interface{}{
+				"configValueEQ": map[string]interface{}{"path": "maxMembers", "value": 10},
+			},
+			want: []string{"1"},
+		},
+		{
+			name: "ValueEQ/String",
+			where: map[string]interface{}{
+				"stringsValueEQ": map[string]interface{}{"path": "[0]", "value": "b"},
+			},
+			want: []string{"1"},
+		},
+		{
+			name: "ValueEQ/And",
+			where: map[string]interface{}{
+				"configHasKey":   "maxMembers",
+				"stringsValueEQ": map[string]interface{}{"path": "[1]", "value": "b"},
+			},
+			want: []string{"0"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var rsp struct {
+				Todos struct {
+					Edges []struct {
+						Node struct {
+							Text string
+						}
+					}
+				}
+			}
+			err := gqlc.Post(query, &rsp, client.Var("where", tt.where))
+			require.NoError(t, err)
+			got := make([]string, 0, len(rsp.Todos.Edges))
+			for _, e := range rsp.Todos.Edges {
+				got = append(got, e.Node.Text)
+			}
+			require.ElementsMatch(t, tt.want, got)
+		})
+	}
+}
+
+type queryLog struct {
+	sync.Mutex
+	queries []string
+	dialect.Driver
+}
+
+func (q *queryLog) reset() {
+	q.Lock()
+	defer q.Unlock()
+	q.queries = nil
+}
+
+func (q *queryLog) Query(ctx context.Context, query string, args, v interface{}) error {
+	q.Lock()
+	q.queries = append(q.queries, query)
+	q.Unlock()
+	return q.Driver.Query(ctx, query, args, v)
+}
+
+// selected returns the selection of the queries on the given table.
+func (q *queryLog) selected(table string) []string {
+	q.Lock()
+	defer q.Unlock()
+	var selected []string
+	for _, query := range q.queries {
+		if i := strings.Index(query, " FROM `"+table+"`"); i != -1 {
+			selected = append(selected, query[:i])
+		}
+	}
+	return selected
+}
+
+func TestFieldProjection(t *testing.T) {
+	ctx := context.Background()
+	drv, err := sql.Open(dialect.SQLite, fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	require.NoError(t, err)
+	log := &queryLog{Driver: drv}
+	ec := enttest.NewClient(t,
+		enttest.WithOptions(ent.Driver(log)),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	srv := handler.NewDefaultServer(gen.NewSchema(ec))
+	gqlc := client.New(srv)
+
+	cat := ec.Category.Create().SetText("category").SetStatus(category.StatusEnabled).SetStrings([]string{"a", "b"}).SaveX(ctx)
+	ec.Todo.Create().SetText("todo").SetStatus(todo.StatusInProgress).SetCategory(cat).ExecX(ctx)
+
+	t.Run("Edge", func(t *testing.T) {
+		var rsp struct {
+			Todos struct {
+				Edges []struct {
+					Node struct {
+						Text     string
+						Category struct {
+							Status string
+						}
+					}
+				}
+			}
+		}
+		log.reset()
+		err := gqlc.Post(`query {
+			todos {
+				edges {
+					node {
+						text
+						category {
+							status
+						}
+					}
+				}
+			}
+		}`, &rsp)
+		require.NoError(t, err)
+		require.Len(t, rsp.Todos.Edges, 1)
+		require.Equal(t, "ENABLED", rsp.Todos.Edges[0].Node.Category.Status)
+		selected := log.selected(category.Table)
+		require.Len(t, selected, 1)
+		// The ordering fields (text and duration) are always selected.
+		require.Equal(t, "SELECT DISTINCT `categories`.`id`, `categories`.`text`, `categories`.`duration`, `categories`.`status`", selected[0])
+	})
+
+	t.Run("Node", func(t *testing.T) {
+		var rsp struct {
+			Node struct {
+				Strings []string
+			}
+		}
+		log.reset()
+		err := gqlc.Post(`query($id: ID!) {
+			node(id: $id) {
+				... on Category {
+					strings
+				}
+			}
+		}`, &rsp, client.Var("id", cat.ID))
+		require.NoError(t, err)
+		require.Equal(t, cat.Strings, rsp.Node.Strings)
+		selected := log.selected(category.Table)
+		require.Len(t, selected, 1)
+		require.Equal(t, "SELECT DISTINCT `categories`.`id`, `categories`.`text`, `categories`.`duration`, `categories`.`strings`", selected[0])
+	})
+}
+
+func TestBatchEdges(t *testing.T) {
+	ctx := context.Background()
+	drv, err := sql.Open(dialect.SQLite, fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	require.NoError(t, err)
+	count := &queryCount{Driver: drv}
+	ec := enttest.NewClient(t,
+		enttest.WithOptions(ent.Driver(count)),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	cats := ec.Category.CreateBulk(
+		ec.Category.Create().SetText("a").SetStatus(category.StatusEnabled),
+		ec.Category.Create().SetText("b").SetStatus(category.StatusEnabled),
+	).SaveX(ctx)
+	for i := 0; i < 10; i++ {
+		ec.Todo.Create().SetText(strconv.Itoa(i)).SetStatus(todo.StatusInProgress).SetCategory(cats[i%2]).ExecX(ctx)
+	}
+	// A todo without a category.
+	ec.Todo.Create().SetText("10").SetStatus(todo.StatusInProgress).ExecX(ctx)
+	todos := ec.Todo.Query().Order(ent.Asc(todo.FieldID)).AllX(ctx)
+
+	// Resolve the category edges of the todos concurrently, as gqlgen does.
+	resolve := func(ctx context.Context) []*ent.Category {
+		var wg sync.WaitGroup
+		result := make([]*ent.Category, len(todos))
+		for i := range todos {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				c, err := todos[i].Category(ctx)
+				require.NoError(t, err)
+				result[i] = c
+			}(i)
+		}
+		wg.Wait()
+		return result
+	}
+
+	count.reset()
+	resolve(ctx)
+	require.EqualValues(t, len(todos), count.value(), "one query for each todo")
+
+	count.reset()
+	result := resolve(entgql.NewBatchLoaderContext(ctx, entgql.NewBatchLoader(0, 0)))
+	require.EqualValues(t, 2, count.value(), "one query for the todos and one for their categories")
+	for i, c := range result[:10] {
+		require.Equal(t, cats[i%2].ID, c.ID)
+		require.Equal(t, cats[i%2].Text, c.Text)
+	}
+	require.Nil(t, result[10])
+}
+
+func TestOffsetPagination(t *testing.T) {
+	ctx := context.Background()
+	ec := enttest.Open(
+		t, dialect.SQLite,
+		fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	srv := handler.NewDefaultServer(gen.NewSchema(ec))
+	gqlc := client.New(srv)
+
+	users := make([]*ent.User, 5)
+	for i := range users {
+		users[i] = ec.User.Create().SetName(strconv.Itoa(i)).SaveX(ctx)
+	}
+	for _, u := range users[1:] {
+		ec.Friendship.Create().SetUser(users[0]).SetFriend(u).ExecX(ctx)
+	}
+
+	type page struct {
+		TotalCount      int
+		HasNextPage     bool
+		HasPreviousPage bool
+		Nodes           []struct {
+			Name    string
+			Friends struct {
+				TotalCount  int
+				HasNextPage bool
+				Nodes       []struct{ Name string }
+			}
+		}
+	}
+	const query = `query($offset: Int, $limit: Int) {
+		usersPage(offset: $offset, limit: $limit) {
+			totalCount
+			hasNextPage
+			hasPreviousPage
+			nodes {
+				name
+			}
+		}
+	}`
+
+	t.Run("Offset", func(t *testing.T) {
+		var rsp struct{ UsersPage page }
+		err := gqlc.Post(query, &rsp, client.Var("offset", 1), client.Var("limit", 2))
+		require.NoError(t, err)
+		require.Equal(t, 5, rsp.UsersPage.TotalCount)
+		require.True(t, rsp.UsersPage.HasNextPage)
+		require.True(t, rsp.UsersPage.HasPreviousPage)
+		require.Len(t, rsp.UsersPage.Nodes, 2)
+		require.Equal(t, "1", rsp.UsersPage.Nodes[0].Name)
+		require.Equal(t, "2", rsp.UsersPage.Nodes[1].Name)
+	})
+
+	t.Run("LastPage", func(t *testing.T) {
+		var rsp struct{ UsersPage page }
+		err := gqlc.Post(query, &rsp, client.Var("offset", 3))
+		require.NoError(t, err)
+		require.Equal(t, 5, rsp.UsersPage.TotalCount)
+		require.False(t, rsp.UsersPage.HasNextPage)
+		require.True(t, rsp.UsersPage.HasPreviousPage)
+		require.Len(t, rsp.UsersPage.Nodes, 2)
+		require.Equal(t, "3", rsp.UsersPage.Nodes[0].Name)
+		require.Equal(t, "4", rsp.UsersPage.Nodes[1].Name)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		var rsp struct{ UsersPage page }
+		err := gqlc.Post(query, &rsp, client.Var("offset", -1))
+		require.Error(t, err)
+	})
+
+	t.Run("Nested", func(t *testing.T) {
+		var rsp struct{ UsersPage page }
+		err := gqlc.Post(`query {
+			usersPage(limit: 2) {
+				nodes {
+					name
+					friends(offset: 1, limit: 2) {
+						totalCount
+						hasNextPage
+						nodes {
+							name
+						}
+					}
+				}
+			}
+		}`, &rsp)
+		require.NoError(t, err)
+		require.Len(t, rsp.UsersPage.Nodes, 2)
+		friends := rsp.UsersPage.Nodes[0].Friends
+		require.Equal(t, 4, friends.TotalCount)
+		require.True(t, friends.HasNextPage)
+		require.Len(t, friends.Nodes, 2)
+		require.Equal(t, "2", friends.Nodes[0].Name)
+		require.Equal(t, "3", friends.Nodes[1].Name)
+		friends = rsp.UsersPage.Nodes[1].Friends
+		require.Equal(t, users[1].QueryFriends().CountX(ctx), friends.TotalCount)
+		require.False(t, friends.HasNextPage)
+		require.Empty(t, friends.Nodes)
+	})
+}
+
+func TestCursorCodec(t *testing.T) {
+	ctx := context.Background()
+	ec := enttest.Open(
+		t, dialect.SQLite,
+		fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	srv := handler.NewDefaultServer(gen.NewSchema(ec))
+	gqlc := client.New(srv)
+	for i := 0; i < 3; i++ {
+		ec.Todo.Create().SetText(strconv.Itoa(i)).SetStatus(todo.StatusInProgress).ExecX(ctx)
+	}
+	codec, err := entgql.NewAESCursorCodec([]byte("0123456789abcdef"))
+	require.NoError(t, err)
+	ent.SetCursorCodec(codec)
+	defer ent.SetCursorCodec(entgql.Base64CursorCodec{})
+
+	const query = `query($after: Cursor) {
+		todos(first: 1, after: $after, orderBy: {field: TEXT}) {
+			edges {
+				node {
+					text
+				}
+			}
+			pageInfo {
+				endCursor
+			}
+		}
+	}`
+	var rsp struct {
+		Todos struct {
+			Edges []struct {
+				Node struct {
+					Text string
+				}
+			}
+			PageInfo struct {
+				EndCursor string
+			}
+		}
+	}
+	err = gqlc.Post(query, &rsp)
+	require.NoError(t, err)
+	cursor := rsp.Todos.PageInfo.EndCursor
+	err = gqlc.Post(query, &rsp, client.Var("after", cursor))
+	require.NoError(t, err)
+	require.Len(t, rsp.Todos.Edges, 1)
+	require.Equal(t, "1", rsp.Todos.Edges[0].Node.Text)
+
+	forged := []byte(cursor)
+	forged[len(forged)/2] ^= 1
+	err = gqlc.Post(query, &rsp, client.Var("after", string(forged)))
+	var jerr client.RawJsonError
+	require.True(t, errors.As(err, &jerr))
+	var errs gqlerror.List
+	err = json.Unmarshal(jerr.RawMessage, &errs)
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	require.Equal(t, "INVALID_PAGINATION", errs[0].Extensions["code"])
+}
+
+func TestGoClient(t *testing.T) {
+	ctx := context.Background()
+	ec := enttest.Open(
+		t, dialect.SQLite,
+		fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	srv := httptest.NewServer(handler.NewDefaultServer(gen.NewSchema(ec)))
+	defer srv.Close()
+	gqlc := gqlclient.NewClient(srv.URL)
+
+	var ids []int
+	for i, status := range []todo.Status{todo.StatusInProgress, todo.StatusCompleted, todo.StatusInProgress} {
+		node, err := gqlc.CreateTodo(ctx, ent.CreateTodoInput{
+			Text:     strconv.Itoa(i),
+			Status:   status,
+			Priority: pointer.ToInt(i),
+		})
+		require.NoError(t, err)
+		require.NotZero(t, node.ID)
+		require.Equal(t, strconv.Itoa(i), node.Text)
+		require.Equal(t, status, node.Status)
+		require.Equal(t, i, node.Priority)
+		require.False(t, node.CreatedAt.IsZero())
+		ids = append(ids, node.ID)
+	}
+
+	order := []*ent.TodoOrder{{Direction: ent.OrderDirectionDesc, Field: ent.TodoOrderFieldText}}
+	conn, err := gqlc.QueryTodos(ctx, pointer.ToInt(2), nil, nil, order)
+	require.NoError(t, err)
+	require.Equal(t, 3, conn.TotalCount)
+	require.True(t, conn.PageInfo.HasNextPage)
+	require.Len(t, conn.Edges, 2)
+	require.Equal(t, ids[2], conn.Edges[0].Node.ID)
+	require.Equal(t, ids[1], conn.Edges[1].Node.ID)
+	require.Equal(t, conn.Edges[1].Cursor, *conn.PageInfo.EndCursor)
+
+	conn, err = gqlc.QueryTodos(ctx, pointer.ToInt(2), conn.PageInfo.EndCursor, nil, order)
+	require.NoError(t, err)
+	require.False(t, conn.PageInfo.HasNextPage)
+	require.Len(t, conn.Edges, 1)
+	require.Equal(t, ids[0], conn.Edges[0].Node.ID)
+
+	completed := todo.StatusCompleted
+	conn, err = gqlc.QueryTodos(ctx, nil, nil, &ent.TodoWhereInput{Status: &completed}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, conn.TotalCount)
+	require.Equal(t, ids[1], conn.Edges[0].Node.ID)
+
+	id, err := gqlc.DeleteTodo(ctx, ids[0])
+	require.NoError(t, err)
+	require.Equal(t, ids[0], id)
+	_, err = gqlc.DeleteTodo(ctx, ids[0])
+	var errs gqlerror.List
+	require.True(t, errors.As(err, &errs))
+	require.Len(t, errs, 1)
+}
+
+func TestSoftDelete(t *testing.T) {
+	ctx := context.Background()
+	ec := enttest.Open(
+		t, dialect.SQLite,
+		fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	srv := handler.NewDefaultServer(gen.NewSchema(ec))
+	gqlc := client.New(srv)
+
+	// Todos 2 and 3 are deleted. Todo 4 is a child of the deleted todo 3.
+	cat := ec.Category.Create().SetText("category").SetStatus(category.StatusEnabled).SaveX(ctx)
+	t0 := ec.Todo.Create().SetText("0").SetStatus(todo.StatusInProgress).SaveX(ctx)
+	ec.Todo.Create().SetText("1").SetStatus(todo.StatusInProgress).SetParent(t0).SetCategory(cat).ExecX(ctx)
+	t2 := ec.Todo.Create().SetText("2").SetStatus(todo.StatusInProgress).SetParent(t0).SetDeletedAt(time.Now()).SaveX(ctx)
+	t3 := ec.Todo.Create().SetText("3").SetStatus(todo.StatusInProgress).SetCategory(cat).SetDeletedAt(time.Now()).SaveX(ctx)
+	ec.Todo.Create().SetText("4").SetStatus(todo.StatusInProgress).SetParent(t3).ExecX(ctx)
+
+	type node struct {
+		Text   string
+		Parent *struct{ Text string }
+	}
+	texts := func(nodes []node) []string {
+		var vs []string
+		for _, n := range nodes {
+			vs = append(vs, n.Text)
+		}
+		return vs
+	}
+
+	t.Run("Paginate", func(t *testing.T) {
+		var rsp struct {
+			Todos struct {
+				TotalCount int
+				Edges      []struct{ Node node }
+			}
+		}
+		const query = `query($includeDeleted: Boolean, $hasParent: Boolean) {
+			todos(orderBy: {field: TEXT}, includeDeleted: $includeDeleted, where: {hasParent: $hasParent}) {
+				totalCount
+				edges { node { text parent { text } } }
+			}
+		}`
+		edges := func() []node {
+			var nodes []node
+			for _, e := range rsp.Todos.Edges {
+				nodes = append(nodes, e.Node)
+			}
+			return nodes
+		}
+		err := gqlc.Post(query, &rsp)
+		require.NoError(t, err)
+		require.Equal(t, 3, rsp.Todos.TotalCount)
+		require.Equal(t, []string{"0", "1", "4"}, texts(edges()))
+		require.Nil(t, edges()[2].Parent, "parent of todo 4 was deleted")
+
+		err = gqlc.Post(query, &rsp, client.Var("includeDeleted", true))
+		require.NoError(t, err)
+		require.Equal(t, 5, rsp.Todos.TotalCount)
+		require.Equal(t, []string{"0", "1", "2", "3", "4"}, texts(edges()))
+
+		err = gqlc.Post(query, &rsp, client.Var("hasParent", false))
+		require.NoError(t, err)
+		require.Equal(t, []string{"0", "4"}, texts(edges()), "edge predicates ignore deleted todos")
+		err = gqlc.Post(query, &rsp, client.Var("hasParent", true))
+		require.NoError(t, err)
+		require.Equal(t, []string{"1"}, texts(edges()))
+	})
+
+	t.Run("Edges", func(t *testing.T) {
+		var rsp struct {
+			Node struct {
+				Children struct {
+					TotalCount int
+					Edges      []struct{ Node node }
+				}
+			}
+		}
+		const query = `query($id: ID!, $includeDeleted: Boolean) {
+			node(id: $id) {
+				... on Todo {
+					children(orderBy: {field: TEXT}, includeDeleted: $includeDeleted) { totalCount edges { node { text } } }
+				}
+			}
+		}`
+		err := gqlc.Post(query, &rsp, client.Var("id", t0.ID))
+		require.NoError(t, err)
+		require.Equal(t, 1, rsp.Node.Children.TotalCount)
+		require.Len(t, rsp.Node.Children.Edges, 1)
+		require.Equal(t, "1", rsp.Node.Children.Edges[0].Node.Text)
+		err = gqlc.Post(query, &rsp, client.Var("id", t0.ID), client.Var("includeDeleted", true))
+		require.NoError(t, err)
+		require.Equal(t, 2, rsp.Node.Children.TotalCount)
+		require.Len(t, rsp.Node.Children.Edges, 2)
+		require.Equal(t, "2", rsp.Node.Children.Edges[1].Node.Text)
+
+		var crsp struct {
+			Node struct {
+				Todos struct{ TotalCount int }
+			}
+		}
+		err = gqlc.Post(`query($id: ID!) {
+			node(id: $id) {
+				... on Category {
+					todos { totalCount }
+				}
+			}
+		}`, &crsp, client.Var("id", cat.ID))
+		require.NoError(t, err)
+		require.Equal(t, 1, crsp.Node.Todos.TotalCount)
+	})
+
+	t.Run("Node", func(t *testing.T) {
+		const query = `query($ids: [ID!]!) {
+			nodes(ids: $ids) {
+				... on Todo { text }
+			}
+		}`
+		var rsp struct {
+			Nodes []*struct{ Text string }
+		}
+		err := gqlc.Post(query, &rsp, client.Var("ids", []int{t0.ID, t2.ID}))
+		var jerr client.RawJsonError
+		require.True(t, errors.As(err, &jerr))
+
+		// Deleted todos are included in operations executed with the include-deleted context.
+		srv := handler.NewDefaultServer(gen.NewSchema(ec))
+		srv.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+			return next(entgql.NewIncludeDeletedContext(ctx))
+		})
+		err = client.New(srv).Post(query, &rsp, client.Var("ids", []int{t0.ID, t2.ID}))
+		require.NoError(t, err)
+		require.Len(t, rsp.Nodes, 2)
+		require.Equal(t, "2", rsp.Nodes[1].Text)
+	})
+
+	t.Run("Aggregate", func(t *testing.T) {
+		var rsp struct {
+			TodosAggregate []struct{ Count int }
+		}
+		err := gqlc.Post(`query { todosAggregate { count } }`, &rsp)
+		require.NoError(t, err)
+		require.Equal(t, 3, rsp.TodosAggregate[0].Count)
+		err = gqlc.Post(`query { todosAggregate(groupBy: [STATUS]) { count } }`, &rsp)
+		require.NoError(t, err)
+		require.Equal(t, 3, rsp.TodosAggregate[0].Count)
+	})
+
+	t.Run("Subscription", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		ps := entgql.NewMemoryPubSub()
+		ec.Use(ent.SubscriptionHook(ps))
+		updated, err := ec.SubscriptionResolver(ps).TodoUpdated(ctx, nil)
+		require.NoError(t, err)
+		ec.Todo.UpdateOne(t2).SetPriority(1).ExecX(ctx)
+		ec.Todo.UpdateOne(t0).SetPriority(1).ExecX(ctx)
+		require.Equal(t, t0.ID, receiveTodo(t, updated).ID, "updates of deleted todos are not emitted")
+	})
+}
+
+func TestBulkMutations(t *testing.T) {
+	ctx := context.Background()
+	ec := enttest.Open(
+		t, dialect.SQLite,
+		fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	gqlc := client.New(handler.NewDefaultServer(gen.NewSchema(ec)))
+
+	var crsp struct {
+		CreateTodos []struct {
+			ID     string
+			Text   string
+			Status todo.Status
+		}
+	}
+	err := gqlc.Post(`mutation($inputs: [CreateTodoInput!]!) {
+		createTodos(inputs: $inputs) { id text status }
+	}`, &crsp, client.Var("inputs", []map[string]interface{}{
+		{"text": "a", "status": todo.StatusInProgress},
+		{"text": "b", "status": todo.StatusInProgress},
+		{"text": "c", "status": todo.StatusCompleted},
+	}))
+	require.NoError(t, err)
+	require.Len(t, crsp.CreateTodos, 3)
+	require.Equal(t, "c", crsp.CreateTodos[2].Text)
+	require.Equal(t, 3, ec.Todo.Query().CountX(ctx))
+
+	var ursp struct {
+		UpdateTodos int
+	}
+	err = gqlc.Post(`mutation {
+		updateTodos(where: {status: IN_PROGRESS}, input: {priority: 2})
+	}`, &ursp)
+	require.NoError(t, err)
+	require.Equal(t, 2, ursp.UpdateTodos)
+	require.Equal(t, 2, ec.Todo.Query().Where(todo.Priority(2)).CountX(ctx))
+
+	var drsp struct {
+		DeleteTodos int
+	}
+	err = gqlc.Post(`mutation {
+		deleteTodos(where: {textIn: ["a", "c"]})
+	}`, &drsp)
+	require.NoError(t, err)
+	require.Equal(t, 2, drsp.DeleteTodos)
+	require.Equal(t, []string{"b"}, ec.Todo.Query().Select(todo.FieldText).StringsX(ctx))
+
+	// An empty filter is rejected, as it matches all todos.
+	err = gqlc.Post(`mutation { deleteTodos(where: {}) }`, &drsp)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), ent.ErrEmptyTodoWhereInput.Error())
+	require.Equal(t, 1, ec.Todo.Query().CountX(ctx))
+}
+
+func TestOptimisticLock(t *testing.T) {
+	ctx := context.Background()
+	ec := enttest.Open(
+		t, dialect.SQLite,
+		fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	gqlc := client.New(handler.NewDefaultServer(gen.NewSchema(ec)))
+	td := ec.Todo.Create().SetText("a").SetStatus(todo.StatusInProgress).SaveX(ctx)
+
+	const mutation = `mutation($id: ID!, $input: UpdateTodoInput!) {
+		updateTodo(id: $id, input: $input) { text version }
+	}`
+	var rsp struct {
+		UpdateTodo struct {
+			Text    string
+			Version int
+		}
+	}
+	err := gqlc.Post(mutation, &rsp, client.Var("id", td.ID), client.Var("input", map[string]interface{}{
+		"text":    "b",
+		"version": 0,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, "b", rsp.UpdateTodo.Text)
+	require.Equal(t, 1, rsp.UpdateTodo.Version)
+
+	// A stale version is rejected, and the todo is left unchanged.
+	err = gqlc.Post(mutation, &rsp, client.Var("id", td.ID), client.Var("input", map[string]interface{}{
+		"text":    "c",
+		"version": 0,
+	}))
+	var jerr client.RawJsonError
+	require.True(t, errors.As(err, &jerr))
+	var errs gqlerror.List
+	err = json.Unmarshal(jerr.RawMessage, &errs)
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	require.Equal(t, "VERSION_CONFLICT", errs[0].Extensions["code"])
+	td = ec.Todo.GetX(ctx, td.ID)
+	require.Equal(t, "b", td.Text)
+	require.Equal(t, 1, td.Version)
+
+	// Unknown nodes are reported as not found.
+	_, err = ec.Todo.UpdateOneID(td.ID + 1).SetInput(ent.UpdateTodoInput{Version: pointer.ToInt(1)}).Save(ctx)
+	require.True(t, ent.IsNotFound(err))
+	// The version is required by the UpdateOne builders.
+	_, err = ec.Todo.UpdateOneID(td.ID).SetInput(ent.UpdateTodoInput{}).Save(ctx)
+	var gqlErr *gqlerror.Error
+	require.True(t, errors.As(err, &gqlErr))
+	require.Equal(t, entgql.CodeInvalidInput, gqlErr.Extensions["code"])
+	require.Equal(t, ast.Path{ast.PathName("input"), ast.PathName("version")}, gqlErr.Extensions["inputPath"])
+	_, err = ec.Todo.UpdateOneID(td.ID).SetInput(ent.UpdateTodoInput{Version: pointer.ToInt(1)}).Save(ctx)
+	require.NoError(t, err)
+}
+
+func TestJSONTypes(t *testing.T) {
+	ctx := context.Background()
+	ec := enttest.Open(
+		t, dialect.SQLite,
+		fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	gqlc := client.New(handler.NewDefaultServer(gen.NewSchema(ec)))
+
+	var rsp struct {
+		CreateTodo struct {
+			Category struct {
+				Types struct {
+					Public bool
+					Tags   []string
+					Owner  struct {
+						Name         string
+						EmailAddress string `json:"email_address"`
+					}
+				}
+			}
+		}
+	}
+	err := gqlc.Post(`mutation($input: CreateTodoInput!) {
+		createTodo(input: $input) {
+			category { types { public tags owner { name email_address } } }
+		}
+	}`, &rsp, client.Var("input", map[string]interface{}{
+		"text":   "a",
+		"status": todo.StatusInProgress,
+		"createCategory": map[string]interface{}{
+			"text":   "c",
+			"status": category.StatusEnabled,
+			"types": map[string]interface{}{
+				"public": true,
+				"tags":   []string{"x", "y"},
+				"owner":  map[string]interface{}{"name": "a8m", "email_address": "a8m@example.com"},
+			},
+		},
+	}))
+	require.NoError(t, err)
+	types := rsp.CreateTodo.Category.Types
+	require.True(t, types.Public)
+	require.Equal(t, []string{"x", "y"}, types.Tags)
+	require.Equal(t, "a8m", types.Owner.Name)
+	require.Equal(t, "a8m@example.com", types.Owner.EmailAddress)
+	require.Equal(t, &schematype.CategoryTypes{
+		Public: true,
+		Tags:   []string{"x", "y"},
+		Owner:  &schematype.CategoryOwner{Name: "a8m", Email: "a8m@example.com"},
+	}, ec.Category.Query().OnlyX(ctx).Types)
+}
+
+func TestInputValidation(t *testing.T) {
+	ctx := context.Background()
+	ec := enttest.Open(
+		t, dialect.SQLite,
+		fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	gqlc := client.New(handler.NewDefaultServer(gen.NewSchema(ec)))
+	inputErrors := func(err error) gqlerror.List {
+		var jerr client.RawJsonError
+		require.True(t, errors.As(err, &jerr))
+		var errs gqlerror.List
+		require.NoError(t, json.Unmarshal(jerr.RawMessage, &errs))
+		return errs
+	}
+
+	// The declared constraints are checked by the @constraint directive.
+	var rsp struct{ CreateTodo struct{ ID string } }
+	err := gqlc.Post(`mutation($input: CreateTodoInput!) {
+		createTodo(input: $input) { id }
+	}`, &rsp, client.Var("input", map[string]interface{}{
+		"text":   "",
+		"status": todo.StatusInProgress,
+	}))
+	errs := inputErrors(err)
+	require.Len(t, errs, 1)
+	require.Equal(t, entgql.CodeInvalidInput, errs[0].Extensions["code"])
+	require.Equal(t, "input.text: value is less than the required length", errs[0].Message)
+	require.Equal(t, []interface{}{"input", "text"}, errs[0].Extensions["inputPath"])
+
+	// The field validators of the nested inputs are checked before the mutation is executed.
+	var bulk struct{ CreateTodos []struct{ ID string } }
+	err = gqlc.Post(`mutation($inputs: [CreateTodoInput!]!) {
+		createTodos(inputs: $inputs) { id }
+	}`, &bulk, client.Var("inputs", []map[string]interface{}{
+		{
+			"text":   "a",
+			"status": todo.StatusInProgress,
+		},
+		{
+			"text":           "b",
+			"status":         todo.StatusInProgress,
+			"createCategory": map[string]interface{}{"text": "", "status": category.StatusEnabled},
+			"createChildren": []map[string]interface{}{
+				{"text": "c", "status": todo.StatusInProgress, "createCategory": map[string]interface{}{"text": "", "status": category.StatusEnabled}},
+			},
+		},
+	}))
+	errs = inputErrors(err)
+	require.Len(t, errs, 2)
+	for i, path := range [][]interface{}{
+		{"inputs", float64(1), "createChildren", float64(0), "createCategory", "text"},
+		{"inputs", float64(1), "createCategory", "text"},
+	} {
+		require.Equal(t, entgql.CodeInvalidInput, errs[i].Extensions["code"])
+		require.Equal(t, path, errs[i].Extensions["inputPath"])
+	}
+	require.Equal(t, "inputs[1].createCategory.text: value is less than the required length", errs[1].Message)
+	require.Zero(t, ec.Todo.Query().CountX(ctx))
+	require.Zero(t, ec.Category.Query().CountX(ctx))
+
+	input := ent.UpdateTodoInput{Text: pointer.ToString("")}
+	err = input.Validate()
+	require.True(t, errors.As(err, &errs))
+	require.Len(t, errs, 1)
+	require.Equal(t, "input.text: value is less than the required length", errs[0].Message)
+	input.Text = pointer.ToString("a")
+	require.NoError(t, input.Validate())
+}
+
+func TestUpsertMutation(t *testing.T) {
+	ctx := context.Background()
+	ec := enttest.Open(
+		t, dialect.SQLite,
+		fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	gqlc := client.New(handler.NewDefaultServer(gen.NewSchema(ec)))
+	other := ec.Category.Create().SetText("other").SetStatus(category.StatusEnabled).SetSlug("other").SaveX(ctx)
+
+	const mutation = `mutation($input: UpsertCategoryInput!) {
+		upsertCategory(input: $input) { id text status slug }
+	}`
+	var rsp struct {
+		UpsertCategory struct {
+			ID, Text, Status, Slug string
+		}
+	}
+	err := gqlc.Post(mutation, &rsp, client.Var("input", map[string]interface{}{
+		"slug":   "work",
+		"text":   "Work",
+		"status": category.StatusEnabled,
+	}))
+	require.NoError(t, err)
+	id := rsp.UpsertCategory.ID
+	require.Equal(t, "Work", rsp.UpsertCategory.Text)
+	require.Equal(t, 2, ec.Category.Query().CountX(ctx))
+
+	// Pushing the same slug again updates the existing category.
+	err = gqlc.Post(mutation, &rsp, client.Var("input", map[string]interface{}{
+		"slug":   "work",
+		"text":   "Work items",
+		"status": category.StatusDisabled,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, id, rsp.UpsertCategory.ID)
+	require.Equal(t, "Work items", rsp.UpsertCategory.Text)
+	require.Equal(t, "DISABLED", rsp.UpsertCategory.Status)
+	require.Equal(t, 2, ec.Category.Query().CountX(ctx))
+	require.Equal(t, "other", ec.Category.GetX(ctx, other.ID).Text)
+
+	// The conflict field is required.
+	err = gqlc.Post(mutation, &rsp, client.Var("input", map[string]interface{}{
+		"text":   "Home",
+		"status": category.StatusEnabled,
+	}))
+	require.Error(t, err)
+
+	c, err := ec.Category.UpsertInput(ctx, ent.UpsertCategoryInput{Slug: "other", Text: "Other", Status: category.StatusEnabled})
+	require.NoError(t, err)
+	require.Equal(t, other.ID, c.ID)
+	require.Equal(t, "Other", c.Text)
+}
+
+func TestInterfacePagination(t *testing.T) {
+	ctx := context.Background()
+	ec := enttest.Open(
+		t, dialect.SQLite,
+		fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	gqlc := client.New(handler.NewDefaultServer(gen.NewSchema(ec)))
+
+	users := ec.User.CreateBulk(ec.User.Create(), ec.User.Create(), ec.User.Create()).SaveX(ctx)
+	ec.Friendship.Create().SetUser(users[0]).SetFriend(users[1]).ExecX(ctx)
+	ec.Friendship.Create().SetUser(users[0]).SetFriend(users[2]).ExecX(ctx)
+	for i := 0; i < 3; i++ {
+		ec.Todo.Create().SetText(strconv.Itoa(i)).SetStatus(todo.StatusInProgress).SaveX(ctx)
+	}
+	friendships := ec.Friendship.Query().CountX(ctx)
+
+	const query = `query($after: Cursor, $first: Int, $before: Cursor, $last: Int) {
+		timestamped(after: $after, first: $first, before: $before, last: $last) {
+			totalCount
+			pageInfo { hasNextPage hasPreviousPage startCursor endCursor }
+			edges {
+				node {
+					__typename
+					id
+					createdAt
+					... on Todo { text }
+				}
+			}
+		}
+	}`
+	type response struct {
+		Timestamped struct {
+			TotalCount int
+			PageInfo   struct {
+				HasNextPage, HasPreviousPage bool
+				StartCursor, EndCursor       string
+			}
+			Edges []struct {
+				Node struct {
+					Typename  string `json:"__typename"`
+					ID        string
+					CreatedAt string
+					Text      string
+				}
+			}
+		}
+	}
+	nodes := func(rsp response) []string {
+		var names []string
+		for _, e := range rsp.Timestamped.Edges {
+			names = append(names, e.Node.Typename+e.Node.Text)
+		}
+		return names
+	}
+
+	var first response
+	err := gqlc.Post(query, &first, client.Var("first", friendships+1))
+	require.NoError(t, err)
+	require.Equal(t, friendships+3, first.Timestamped.TotalCount)
+	require.True(t, first.Timestamped.PageInfo.HasNextPage)
+	require.False(t, first.Timestamped.PageInfo.HasPreviousPage)
+	require.Len(t, first.Timestamped.Edges, friendships+1)
+	require.Equal(t, "Friendship", first.Timestamped.Edges[0].Node.Typename)
+	require.NotEmpty(t, first.Timestamped.Edges[0].Node.CreatedAt)
+	require.Equal(t, "Todo0", nodes(first)[friendships])
+
+	var next response
+	err = gqlc.Post(query, &next, client.Var("after", first.Timestamped.PageInfo.EndCursor), client.Var("first", 5))
+	require.NoError(t, err)
+	require.Equal(t, []string{"Todo1", "Todo2"}, nodes(next))
+	require.False(t, next.Timestamped.PageInfo.HasNextPage)
+	require.True(t, next.Timestamped.PageInfo.HasPreviousPage)
+
+	var last response
+	err = gqlc.Post(query, &last, client.Var("before", next.Timestamped.PageInfo.StartCursor), client.Var("last", 2))
+	require.NoError(t, err)
+	require.Equal(t, []string{"Friendship", "Todo0"}, nodes(last))
+	require.True(t, last.Timestamped.PageInfo.HasPreviousPage)
+	require.True(t, last.Timestamped.PageInfo.HasNextPage)
+	require.Equal(t, first.Timestamped.PageInfo.EndCursor, last.Timestamped.PageInfo.EndCursor)
+
+	_, err = ec.PaginateTimestamped(ctx, &ent.Cursor{ID: users[0].ID, Value: "User"}, nil, nil, nil)
+	require.Error(t, err)
+}
@@ -195,9 +195,6 @@ func (c *Category) String() string {
 	return builder.String()
 }
 
-// IsEntity implement fedruntime.Entity
-func (c Category) IsEntity() {}
-
 // Categories is a parsable slice of Category.
 type Categories []*Category
 
@@ -18,6 +18,9 @@ import (
 func main() {
 	ex, err := entgql.NewExtension(
 		entgql.WithConfigPath("./gqlgen.yml"),
+		entgql.WithSchemaGenerator(),
+		entgql.WithSchemaPath("./ent.graphql"),
+		entgql.WithFederation(),
 	)
 	if err != nil {
 		log.Fatalf("creating entgql extension: %v", err)
@@ -40,7 +43,7 @@ func main() {
 			//
 			// Code generated by entc, DO NOT EDIT.
 		`,
-	}, entc.Extensions(ex), entc.TemplateDir("./ent/template"))
+	}, entc.Extensions(ex))
 	if err != nil {
 		log.Fatalf("running ent codegen: %v", err)
 	}
@@ -19,11 +19,14 @@ package ent
 import (
 	"context"
 
+	"entgo.io/contrib/entgql/internal/todofed/ent/category"
+	"entgo.io/contrib/entgql/internal/todofed/ent/todo"
 	"entgo.io/ent/dialect/sql"
 	"github.com/99designs/gqlgen/graphql"
 )
 
-// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context,
+// and to select only the columns of the requested fields.
 func (c *CategoryQuery) CollectFields(ctx context.Context, satisfies ...string) (*CategoryQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
 	if fc == nil {
@@ -37,9 +40,15 @@ func (c *CategoryQuery) CollectFields(ctx context.Context, satisfies ...string)
 
 func (c *CategoryQuery) collectField(ctx context.Context, op *graphql.OperationContext, field graphql.CollectedField, path []string, satisfies ...string) error {
 	path = append([]string(nil), path...)
+	var (
+		collected      bool
+		fieldSeen      = make(map[string]struct{}, len(category.Columns))
+		selectedFields = []string{category.FieldID, category.FieldText, category.FieldDuration}
+	)
 	for _, field := range graphql.CollectFields(op, field.Selections, satisfies) {
 		switch field.Name {
 		case "todos":
+			collected = true
 			var (
 				path  = append(path, field.Name)
 				query = &TodoQuery{config: c.config}
@@ -48,8 +57,37 @@ func (c *CategoryQuery) collectField(ctx context.Context, op *graphql.OperationC
 				return err
 			}
 			c.withTodos = query
+		case "status":
+			if _, ok := fieldSeen[category.FieldStatus]; !ok {
+				selectedFields = append(selectedFields, category.FieldStatus)
+				fieldSeen[category.FieldStatus] = struct{}{}
+			}
+			collected = true
+		case "config":
+			if _, ok := fieldSeen[category.FieldConfig]; !ok {
+				selectedFields = append(selectedFields, category.FieldConfig)
+				fieldSeen[category.FieldConfig] = struct{}{}
+			}
+			collected = true
+		case "count":
+			if _, ok := fieldSeen[category.FieldCount]; !ok {
+				selectedFields = append(selectedFields, category.FieldCount)
+				fieldSeen[category.FieldCount] = struct{}{}
+			}
+			collected = true
+		case "strings":
+			if _, ok := fieldSeen[category.FieldStrings]; !ok {
+				selectedFields = append(selectedFields, category.FieldStrings)
+				fieldSeen[category.FieldStrings] = struct{}{}
+			}
+			collected = true
+		case "id":
+			collected = true
 		}
 	}
+	if collected {
+		c.Select(selectedFields...)
+	}
 	return nil
 }
 
@@ -79,17 +117,7 @@ func newCategoryPaginateArgs(rv map[string]interface{}) *categoryPaginateArgs {
 	if v, ok := rv[orderByField]; ok {
 		switch v := v.(type) {
 		case map[string]interface{}:
-			var (
-				err1, err2 error
-				order      = &CategoryOrder{Field: &CategoryOrderField{}}
-			)
-			if d, ok := v[directionField]; ok {
-				err1 = order.Direction.UnmarshalGQL(d)
-			}
-			if f, ok := v[fieldField]; ok {
-				err2 = order.Field.UnmarshalGQL(f)
-			}
-			if err1 == nil && err2 == nil {
+			if order, ok := unmarshalCategoryOrder(v); ok {
 				args.opts = append(args.opts, WithCategoryOrder(order))
 			}
 		case *CategoryOrder:
@@ -101,7 +129,22 @@ func newCategoryPaginateArgs(rv map[string]interface{}) *categoryPaginateArgs {
 	return args
 }
 
-// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func unmarshalCategoryOrder(v map[string]interface{}) (*CategoryOrder, bool) {
+	var (
+		err1, err2 error
+		order      = &CategoryOrder{Direction: OrderDirectionAsc, Field: &CategoryOrderField{}}
+	)
+	if d, ok := v[directionField]; ok {
+		err1 = order.Direction.UnmarshalGQL(d)
+	}
+	if f, ok := v[fieldField]; ok {
+		err2 = order.Field.UnmarshalGQL(f)
+	}
+	return order, err1 == nil && err2 == nil
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context,
+// and to select only the columns of the requested fields.
 func (t *TodoQuery) CollectFields(ctx context.Context, satisfies ...string) (*TodoQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
 	if fc == nil {
@@ -115,9 +158,15 @@ func (t *TodoQuery) CollectFields(ctx context.Context, satisfies ...string) (*To
 
 func (t *TodoQuery) collectField(ctx context.Context, op *graphql.OperationContext, field graphql.CollectedField, path []string, satisfies ...string) error {
 	path = append([]string(nil), path...)
+	var (
+		collected      bool
+		fieldSeen      = make(map[string]struct{}, len(todo.Columns))
+		selectedFields = []string{todo.FieldID, todo.FieldCreatedAt, todo.FieldStatus, todo.FieldPriority, todo.FieldText}
+	)
 	for _, field := range graphql.CollectFields(op, field.Selections, satisfies) {
 		switch field.Name {
 		case "parent":
+			collected = true
 			var (
 				path  = append(path, field.Name)
 				query = &TodoQuery{config: t.config}
@@ -127,6 +176,7 @@ func (t *TodoQuery) collectField(ctx context.Context, op *graphql.OperationConte
 			}
 			t.withParent = query
 		case "children":
+			collected = true
 			var (
 				path  = append(path, field.Name)
 				query = &TodoQuery{config: t.config}
@@ -136,6 +186,7 @@ func (t *TodoQuery) collectField(ctx context.Context, op *graphql.OperationConte
 			}
 			t.withChildren = query
 		case "category":
+			collected = true
 			var (
 				path  = append(path, field.Name)
 				query = &CategoryQuery{config: t.config}
@@ -144,8 +195,19 @@ func (t *TodoQuery) collectField(ctx context.Context, op *graphql.OperationConte
 				return err
 			}
 			t.withCategory = query
+		case "blob":
+			if _, ok := fieldSeen[todo.FieldBlob]; !ok {
+				selectedFields = append(selectedFields, todo.FieldBlob)
+				fieldSeen[todo.FieldBlob] = struct{}{}
+			}
+			collected = true
+		case "id":
+			collected = true
 		}
 	}
+	if collected {
+		t.Select(selectedFields...)
+	}
 	return nil
 }
 
@@ -175,17 +237,7 @@ func newTodoPaginateArgs(rv map[string]interface{}) *todoPaginateArgs {
 	if v, ok := rv[orderByField]; ok {
 		switch v := v.(type) {
 		case map[string]interface{}:
-			var (
-				err1, err2 error
-				order      = &TodoOrder{Field: &TodoOrderField{}}
-			)
-			if d, ok := v[directionField]; ok {
-				err1 = order.Direction.UnmarshalGQL(d)
-			}
-			if f, ok := v[fieldField]; ok {
-				err2 = order.Field.UnmarshalGQL(f)
-			}
-			if err1 == nil && err2 == nil {
+			if order, ok := unmarshalTodoOrder(v); ok {
 				args.opts = append(args.opts, WithTodoOrder(order))
 			}
 		case *TodoOrder:
@@ -197,15 +249,32 @@ func newTodoPaginateArgs(rv map[string]interface{}) *todoPaginateArgs {
 	return args
 }
 
+func unmarshalTodoOrder(v map[string]interface{}) (*TodoOrder, bool) {
+	var (
+		err1, err2 error
+		order      = &TodoOrder{Direction: OrderDirectionAsc, Field: &TodoOrderField{}}
+	)
+	if d, ok := v[directionField]; ok {
+		err1 = order.Direction.UnmarshalGQL(d)
+	}
+	if f, ok := v[fieldField]; ok {
+		err2 = order.Field.UnmarshalGQL(f)
+	}
+	return order, err1 == nil && err2 == nil
+}
+
 const (
-	afterField     = "after"
-	firstField     = "first"
-	beforeField    = "before"
-	lastField      = "last"
-	orderByField   = "orderBy"
-	directionField = "direction"
-	fieldField     = "field"
-	whereField     = "where"
+	afterField          = "after"
+	firstField          = "first"
+	beforeField         = "before"
+	lastField           = "last"
+	offsetField         = "offset"
+	limitField          = "limit"
+	orderByField        = "orderBy"
+	directionField      = "direction"
+	fieldField          = "field"
+	whereField          = "where"
+	includeDeletedField = "includeDeleted"
 )
 
 func fieldArgs(ctx context.Context, whereInput interface{}, path ...string) map[string]interface{} {
@@ -237,7 +306,7 @@ func fieldArgs(ctx context.Context, whereInput interface{}, path ...string) map[
 
 // unmarshalArgs allows extracting the field arguments from their raw representation.
 func unmarshalArgs(ctx context.Context, whereInput interface{}, args map[string]interface{}) map[string]interface{} {
-	for _, k := range []string{firstField, lastField} {
+	for _, k := range []string{firstField, lastField, offsetField, limitField} {
 		v, ok := args[k]
 		if !ok {
 			continue
@@ -257,6 +326,12 @@ func unmarshalArgs(ctx context.Context, whereInput interface{}, args map[string]
 			args[k] = &c
 		}
 	}
+	if v, ok := args[includeDeletedField]; ok {
+		b, err := graphql.UnmarshalBoolean(v)
+		if err == nil {
+			args[includeDeletedField] = &b
+		}
+	}
 	if v, ok := args[whereField]; ok && whereInput != nil {
 		if err := graphql.UnmarshalInputFromContext(ctx, v, whereInput); err == nil {
 			args[whereField] = whereInput
@@ -266,25 +341,34 @@ func unmarshalArgs(ctx context.Context, whereInput interface{}, args map[string]
 	return args
 }
 
-func limitRows(partitionBy string, limit int, orderBy ...sql.Querier) func(s *sql.Selector) {
+func limitRows(partitionBy string, limit int, orderBy func(*sql.Selector) sql.Querier) func(s *sql.Selector) {
+	return offsetRows(partitionBy, 0, limit, orderBy)
+}
+
+// offsetRows returns a modifier that skips the first offset rows of each partition, and
+// limits the rest to the given limit. Zero limit means the rows are not limited.
+func offsetRows(partitionBy string, offset, limit int, orderBy func(*sql.Selector) sql.Querier) func(s *sql.Selector) {
 	return func(s *sql.Selector) {
 		d := sql.Dialect(s.Dialect())
 		s.SetDistinct(false)
+		src := d.Select("*").From(d.Table("src_query"))
 		with := d.With("src_query").
 			As(s.Clone()).
 			With("limited_query").
 			As(
-				d.Select("*").
-					AppendSelectExprAs(
-						sql.RowNumber().PartitionBy(partitionBy).OrderExpr(orderBy...),
-						"row_number",
-					).
-					From(d.Table("src_query")),
+				src.AppendSelectExprAs(
+					sql.RowNumber().PartitionBy(partitionBy).OrderExpr(orderBy(src)),
+					"row_number",
+				),
 			)
 		t := d.Table("limited_query").As(s.TableName())
+		p := sql.GT(t.C("row_number"), offset)
+		if limit > 0 {
+			p = sql.And(p, sql.LTE(t.C("row_number"), offset+limit))
+		}
 		*s = *d.Select(s.UnqualifiedColumns()...).
 			From(t).
-			Where(sql.LTE(t.C("row_number"), limit)).
+			Where(p).
 			Prefix(with)
 	}
 }
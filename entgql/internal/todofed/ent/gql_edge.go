@@ -16,36 +16,178 @@
 
 package ent
 
-import "context"
+import (
+	"context"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/contrib/entgql/internal/todofed/ent/category"
+	"entgo.io/contrib/entgql/internal/todofed/ent/todo"
+)
 
 func (c *Category) Todos(ctx context.Context) ([]*Todo, error) {
 	result, err := c.Edges.TodosOrErr()
 	if IsNotLoaded(err) {
-		result, err = c.QueryTodos().All(ctx)
+		result, err = c.loadTodos(ctx)
 	}
 	return result, err
 }
 
+// loadTodos queries the todos edge of the Category. If a BatchLoader is attached to
+// the context, the lookups of sibling nodes are batched into a single query.
+func (c *Category) loadTodos(ctx context.Context) ([]*Todo, error) {
+	l := entgql.BatchLoaderFromContext(ctx)
+	if l == nil {
+		return c.QueryTodos().All(ctx)
+	}
+	v, err := l.Load(ctx, "Category.todos", c.ID, func(ctx context.Context, keys []interface{}) (map[interface{}]interface{}, error) {
+		ids := make([]int, len(keys))
+		for i := range keys {
+			ids[i] = keys[i].(int)
+		}
+		nodes, err := (&CategoryClient{config: c.config}).Query().
+			Where(category.IDIn(ids...)).
+			WithTodos().
+			Select(category.FieldID).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[interface{}]interface{}, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n.Edges.Todos
+		}
+		return m, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	result, _ := v.([]*Todo)
+	return result, nil
+}
+
 func (t *Todo) Parent(ctx context.Context) (*Todo, error) {
 	result, err := t.Edges.ParentOrErr()
 	if IsNotLoaded(err) {
-		result, err = t.QueryParent().Only(ctx)
+		result, err = t.loadParent(ctx)
 	}
 	return result, MaskNotFound(err)
 }
 
+// loadParent queries the parent edge of the Todo. If a BatchLoader is attached to
+// the context, the lookups of sibling nodes are batched into a single query.
+func (t *Todo) loadParent(ctx context.Context) (*Todo, error) {
+	l := entgql.BatchLoaderFromContext(ctx)
+	if l == nil {
+		return t.QueryParent().Only(ctx)
+	}
+	v, err := l.Load(ctx, "Todo.parent", t.ID, func(ctx context.Context, keys []interface{}) (map[interface{}]interface{}, error) {
+		ids := make([]int, len(keys))
+		for i := range keys {
+			ids[i] = keys[i].(int)
+		}
+		nodes, err := (&TodoClient{config: t.config}).Query().
+			Where(todo.IDIn(ids...)).
+			WithParent().
+			Select(todo.FieldID).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[interface{}]interface{}, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n.Edges.Parent
+		}
+		return m, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result, ok := v.(*Todo); ok && result != nil {
+		return result, nil
+	}
+	return nil, &NotFoundError{todo.Label}
+}
+
 func (t *Todo) Children(ctx context.Context) ([]*Todo, error) {
 	result, err := t.Edges.ChildrenOrErr()
 	if IsNotLoaded(err) {
-		result, err = t.QueryChildren().All(ctx)
+		result, err = t.loadChildren(ctx)
 	}
 	return result, err
 }
 
+// loadChildren queries the children edge of the Todo. If a BatchLoader is attached to
+// the context, the lookups of sibling nodes are batched into a single query.
+func (t *Todo) loadChildren(ctx context.Context) ([]*Todo, error) {
+	l := entgql.BatchLoaderFromContext(ctx)
+	if l == nil {
+		return t.QueryChildren().All(ctx)
+	}
+	v, err := l.Load(ctx, "Todo.children", t.ID, func(ctx context.Context, keys []interface{}) (map[interface{}]interface{}, error) {
+		ids := make([]int, len(keys))
+		for i := range keys {
+			ids[i] = keys[i].(int)
+		}
+		nodes, err := (&TodoClient{config: t.config}).Query().
+			Where(todo.IDIn(ids...)).
+			WithChildren().
+			Select(todo.FieldID).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[interface{}]interface{}, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n.Edges.Children
+		}
+		return m, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	result, _ := v.([]*Todo)
+	return result, nil
+}
+
 func (t *Todo) Category(ctx context.Context) (*Category, error) {
 	result, err := t.Edges.CategoryOrErr()
 	if IsNotLoaded(err) {
-		result, err = t.QueryCategory().Only(ctx)
+		result, err = t.loadCategory(ctx)
 	}
 	return result, MaskNotFound(err)
 }
+
+// loadCategory queries the category edge of the Todo. If a BatchLoader is attached to
+// the context, the lookups of sibling nodes are batched into a single query.
+func (t *Todo) loadCategory(ctx context.Context) (*Category, error) {
+	l := entgql.BatchLoaderFromContext(ctx)
+	if l == nil {
+		return t.QueryCategory().Only(ctx)
+	}
+	v, err := l.Load(ctx, "Todo.category", t.ID, func(ctx context.Context, keys []interface{}) (map[interface{}]interface{}, error) {
+		ids := make([]int, len(keys))
+		for i := range keys {
+			ids[i] = keys[i].(int)
+		}
+		nodes, err := (&TodoClient{config: t.config}).Query().
+			Where(todo.IDIn(ids...)).
+			WithCategory().
+			Select(todo.FieldID).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[interface{}]interface{}, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n.Edges.Category
+		}
+		return m, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result, ok := v.(*Category); ok && result != nil {
+		return result, nil
+	}
+	return nil, &NotFoundError{category.Label}
+}
@@ -0,0 +1,80 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/contrib/entgql/internal/todofed/ent/category"
+	"entgo.io/contrib/entgql/internal/todofed/ent/todo"
+)
+
+// IsEntity implements the fedruntime.Entity interface.
+func (c Category) IsEntity() {}
+
+// Entities returns the Category entities with the given IDs. It is used by the Apollo Federation
+// entity resolvers for resolving all representations of the type in one query. The returned list
+// is ordered as the given IDs, and holds nil for the IDs that were not found.
+func (c *CategoryClient) Entities(ctx context.Context, ids ...int) ([]*Category, error) {
+	query := c.Query().
+		Where(category.IDIn(ids...))
+	query, err := query.CollectFields(ctx, "Category")
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	idmap := make(map[int]*Category, len(nodes))
+	for _, node := range nodes {
+		idmap[node.ID] = node
+	}
+	entities := make([]*Category, len(ids))
+	for i, id := range ids {
+		entities[i] = idmap[id]
+	}
+	return entities, nil
+}
+
+// IsEntity implements the fedruntime.Entity interface.
+func (t Todo) IsEntity() {}
+
+// Entities returns the Todo entities with the given IDs. It is used by the Apollo Federation
+// entity resolvers for resolving all representations of the type in one query. The returned list
+// is ordered as the given IDs, and holds nil for the IDs that were not found.
+func (c *TodoClient) Entities(ctx context.Context, ids ...int) ([]*Todo, error) {
+	query := c.Query().
+		Where(todo.IDIn(ids...))
+	query, err := query.CollectFields(ctx, "Todo")
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	idmap := make(map[int]*Todo, len(nodes))
+	for _, node := range nodes {
+		idmap[node.ID] = node
+	}
+	entities := make([]*Todo, len(ids))
+	for i, id := range ids {
+		entities[i] = idmap[id]
+	}
+	return entities, nil
+}
@@ -79,74 +79,110 @@ func (o OrderDirection) reverse() OrderDirection {
 	return OrderDirectionDesc
 }
 
-func (o OrderDirection) orderFunc(field string) OrderFunc {
+func (o OrderDirection) orderFunc(column func(*sql.Selector) string) OrderFunc {
+	return func(s *sql.Selector) {
+		if o == OrderDirectionDesc {
+			s.OrderBy(sql.Desc(column(s)))
+		} else {
+			s.OrderBy(sql.Asc(column(s)))
+		}
+	}
+}
+
+// predicate returns the predicate of the values that come after a value in this direction.
+func (o OrderDirection) predicate() func(string, interface{}) *sql.Predicate {
 	if o == OrderDirectionDesc {
-		return Desc(field)
+		return sql.LT
 	}
-	return Asc(field)
+	return sql.GT
 }
 
-func cursorsToPredicates(direction OrderDirection, after, before *Cursor, field, idField string) []func(s *sql.Selector) {
+// cursorsToPredicates returns the keyset predicates of the given cursors. The cursor
+// values are matched with the given columns and directions, and the idField is used
+// as a tie-breaker that follows the last direction.
+func cursorsToPredicates(directions []OrderDirection, after, before *Cursor, columns []func(*sql.Selector) string, idField string) []func(s *sql.Selector) {
 	var predicates []func(s *sql.Selector)
 	if after != nil {
-		if after.Value != nil {
-			var predicate func([]string, ...interface{}) *sql.Predicate
-			if direction == OrderDirectionAsc {
-				predicate = sql.CompositeGT
-			} else {
-				predicate = sql.CompositeLT
-			}
-			predicates = append(predicates, func(s *sql.Selector) {
-				s.Where(predicate(
-					s.Columns(field, idField),
-					after.Value, after.ID,
-				))
-			})
-		} else {
-			var predicate func(string, interface{}) *sql.Predicate
-			if direction == OrderDirectionAsc {
-				predicate = sql.GT
-			} else {
-				predicate = sql.LT
-			}
-			predicates = append(predicates, func(s *sql.Selector) {
-				s.Where(predicate(
-					s.C(idField),
-					after.ID,
-				))
-			})
-		}
+		predicates = append(predicates, cursorPredicate(directions, after, columns, idField, false))
 	}
 	if before != nil {
-		if before.Value != nil {
-			var predicate func([]string, ...interface{}) *sql.Predicate
-			if direction == OrderDirectionAsc {
-				predicate = sql.CompositeLT
+		predicates = append(predicates, cursorPredicate(directions, before, columns, idField, true))
+	}
+	return predicates
+}
+
+// cursorPredicate returns the predicate of the rows that come after the cursor
+// in the given order, or before it in case reverse is true.
+func cursorPredicate(directions []OrderDirection, c *Cursor, columns []func(*sql.Selector) string, idField string, reverse bool) func(s *sql.Selector) {
+	var (
+		same = true
+		ds   = make([]OrderDirection, len(directions))
+	)
+	for i, d := range directions {
+		if reverse {
+			d = d.reverse()
+		}
+		ds[i] = d
+		same = same && d == ds[0]
+	}
+	last := ds[len(ds)-1]
+	values := cursorValues(c, len(columns))
+	return func(s *sql.Selector) {
+		fields := make([]string, len(columns))
+		for i := range columns {
+			fields[i] = columns[i](s)
+		}
+		switch {
+		case values == nil:
+			s.Where(last.predicate()(s.C(idField), c.ID))
+		case same:
+			args := append(values[:len(values):len(values)], c.ID)
+			if last == OrderDirectionAsc {
+				s.Where(sql.CompositeGT(append(fields, s.C(idField)), args...))
 			} else {
-				predicate = sql.CompositeGT
+				s.Where(sql.CompositeLT(append(fields, s.C(idField)), args...))
 			}
-			predicates = append(predicates, func(s *sql.Selector) {
-				s.Where(predicate(
-					s.Columns(field, idField),
-					before.Value, before.ID,
-				))
-			})
-		} else {
-			var predicate func(string, interface{}) *sql.Predicate
-			if direction == OrderDirectionAsc {
-				predicate = sql.LT
-			} else {
-				predicate = sql.GT
+		default:
+			// Mixed directions are expanded to the following form:
+			// (f1 > v1) OR (f1 = v1 AND f2 < v2) OR ... OR (f1 = v1 AND ... AND fn = vn AND id > ID).
+			ors := make([]*sql.Predicate, 0, len(fields)+1)
+			for i := 0; i <= len(fields); i++ {
+				ands := make([]*sql.Predicate, 0, i+1)
+				for j := 0; j < i; j++ {
+					ands = append(ands, sql.EQ(fields[j], values[j]))
+				}
+				if i < len(fields) {
+					ands = append(ands, ds[i].predicate()(fields[i], values[i]))
+				} else {
+					ands = append(ands, last.predicate()(s.C(idField), c.ID))
+				}
+				ors = append(ors, sql.And(ands...))
 			}
-			predicates = append(predicates, func(s *sql.Selector) {
-				s.Where(predicate(
-					s.C(idField),
-					before.ID,
-				))
-			})
+			s.Where(sql.Or(ors...))
 		}
 	}
-	return predicates
+}
+
+// cursorValues returns the ordering values of the cursor. A cursor of a single
+// field holds its value as is, and a cursor of multiple fields holds a list.
+func cursorValues(c *Cursor, n int) []interface{} {
+	switch {
+	case c.Value == nil || n == 0:
+		return nil
+	case n == 1:
+		return []interface{}{c.Value}
+	}
+	values, ok := c.Value.([]interface{})
+	if !ok || len(values) != n {
+		return nil
+	}
+	return values
+}
+
+// subqueryExpr returns the SQL expression of the given scalar subquery.
+func subqueryExpr(subquery *sql.Selector) string {
+	query, _ := subquery.Query()
+	return "(" + query + ")"
 }
 
 // PageInfo of a connection type.
@@ -240,12 +276,30 @@ func hasCollectedField(ctx context.Context, path ...string) bool {
 }
 
 const (
-	edgesField      = "edges"
-	nodeField       = "node"
-	pageInfoField   = "pageInfo"
-	totalCountField = "totalCount"
+	edgesField       = "edges"
+	nodeField        = "node"
+	nodesField       = "nodes"
+	pageInfoField    = "pageInfo"
+	hasNextPageField = "hasNextPage"
+	totalCountField  = "totalCount"
 )
 
+func validateOffsetLimit(offset, limit *int) (err *gqlerror.Error) {
+	switch {
+	case offset != nil && *offset < 0:
+		err = &gqlerror.Error{
+			Message: "`offset` on a page cannot be less than zero.",
+		}
+		errcode.Set(err, errInvalidPagination)
+	case limit != nil && *limit < 0:
+		err = &gqlerror.Error{
+			Message: "`limit` on a page cannot be less than zero.",
+		}
+		errcode.Set(err, errInvalidPagination)
+	}
+	return err
+}
+
 func paginateLimit(first, last *int) int {
 	var limit int
 	if first != nil {
@@ -357,7 +411,7 @@ func newCategoryPager(opts []CategoryPaginateOption) (*categoryPager, error) {
 	return pager, nil
 }
 
-func (p *categoryPager) applyFilter(query *CategoryQuery) (*CategoryQuery, error) {
+func (p *categoryPager) applyFilter(ctx context.Context, query *CategoryQuery) (*CategoryQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
@@ -370,8 +424,8 @@ func (p *categoryPager) toCursor(c *Category) Cursor {
 
 func (p *categoryPager) applyCursors(query *CategoryQuery, after, before *Cursor) *CategoryQuery {
 	for _, predicate := range cursorsToPredicates(
-		p.order.Direction, after, before,
-		p.order.Field.field, DefaultCategoryOrder.Field.field,
+		[]OrderDirection{p.order.Direction}, after, before,
+		[]func(*sql.Selector) string{p.order.Field.column}, DefaultCategoryOrder.Field.field,
 	) {
 		query = query.Where(predicate)
 	}
@@ -383,24 +437,26 @@ func (p *categoryPager) applyOrder(query *CategoryQuery, reverse bool) *Category
 	if reverse {
 		direction = direction.reverse()
 	}
-	query = query.Order(direction.orderFunc(p.order.Field.field))
+	query = query.Order(direction.orderFunc(p.order.Field.column))
 	if p.order.Field != DefaultCategoryOrder.Field {
-		query = query.Order(direction.orderFunc(DefaultCategoryOrder.Field.field))
+		query = query.Order(direction.orderFunc(DefaultCategoryOrder.Field.column))
 	}
 	return query
 }
 
-func (p *categoryPager) orderExpr(reverse bool) sql.Querier {
+func (p *categoryPager) orderExpr(reverse bool) func(*sql.Selector) sql.Querier {
 	direction := p.order.Direction
 	if reverse {
 		direction = direction.reverse()
 	}
-	return sql.ExprFunc(func(b *sql.Builder) {
-		b.Ident(p.order.Field.field).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultCategoryOrder.Field {
-			b.Comma().Ident(DefaultCategoryOrder.Field.field).Pad().WriteString(string(direction))
-		}
-	})
+	return func(s *sql.Selector) sql.Querier {
+		return sql.ExprFunc(func(b *sql.Builder) {
+			b.Ident(p.order.Field.column(s)).Pad().WriteString(string(direction))
+			if p.order.Field != DefaultCategoryOrder.Field {
+				b.Comma().Ident(DefaultCategoryOrder.Field.column(s)).Pad().WriteString(string(direction))
+			}
+		})
+	}
 }
 
 // Paginate executes the query and returns a relay based cursor connection to Category.
@@ -415,7 +471,7 @@ func (c *CategoryQuery) Paginate(
 	if err != nil {
 		return nil, err
 	}
-	if c, err = pager.applyFilter(c); err != nil {
+	if c, err = pager.applyFilter(ctx, c); err != nil {
 		return nil, err
 	}
 	conn := &CategoryConnection{Edges: []*CategoryEdge{}}
@@ -520,6 +576,11 @@ type CategoryOrderField struct {
 	toCursor func(*Category) Cursor
 }
 
+// column returns the ordering column (or expression) of the field in the given selector.
+func (f *CategoryOrderField) column(s *sql.Selector) string {
+	return s.C(f.field)
+}
+
 // CategoryOrder defines the ordering of Category.
 type CategoryOrder struct {
 	Direction OrderDirection      `json:"direction"`
@@ -649,7 +710,7 @@ func newTodoPager(opts []TodoPaginateOption) (*todoPager, error) {
 	return pager, nil
 }
 
-func (p *todoPager) applyFilter(query *TodoQuery) (*TodoQuery, error) {
+func (p *todoPager) applyFilter(ctx context.Context, query *TodoQuery) (*TodoQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
@@ -662,8 +723,8 @@ func (p *todoPager) toCursor(t *Todo) Cursor {
 
 func (p *todoPager) applyCursors(query *TodoQuery, after, before *Cursor) *TodoQuery {
 	for _, predicate := range cursorsToPredicates(
-		p.order.Direction, after, before,
-		p.order.Field.field, DefaultTodoOrder.Field.field,
+		[]OrderDirection{p.order.Direction}, after, before,
+		[]func(*sql.Selector) string{p.order.Field.column}, DefaultTodoOrder.Field.field,
 	) {
 		query = query.Where(predicate)
 	}
@@ -675,24 +736,26 @@ func (p *todoPager) applyOrder(query *TodoQuery, reverse bool) *TodoQuery {
 	if reverse {
 		direction = direction.reverse()
 	}
-	query = query.Order(direction.orderFunc(p.order.Field.field))
+	query = query.Order(direction.orderFunc(p.order.Field.column))
 	if p.order.Field != DefaultTodoOrder.Field {
-		query = query.Order(direction.orderFunc(DefaultTodoOrder.Field.field))
+		query = query.Order(direction.orderFunc(DefaultTodoOrder.Field.column))
 	}
 	return query
 }
 
-func (p *todoPager) orderExpr(reverse bool) sql.Querier {
+func (p *todoPager) orderExpr(reverse bool) func(*sql.Selector) sql.Querier {
 	direction := p.order.Direction
 	if reverse {
 		direction = direction.reverse()
 	}
-	return sql.ExprFunc(func(b *sql.Builder) {
-		b.Ident(p.order.Field.field).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultTodoOrder.Field {
-			b.Comma().Ident(DefaultTodoOrder.Field.field).Pad().WriteString(string(direction))
-		}
-	})
+	return func(s *sql.Selector) sql.Querier {
+		return sql.ExprFunc(func(b *sql.Builder) {
+			b.Ident(p.order.Field.column(s)).Pad().WriteString(string(direction))
+			if p.order.Field != DefaultTodoOrder.Field {
+				b.Comma().Ident(DefaultTodoOrder.Field.column(s)).Pad().WriteString(string(direction))
+			}
+		})
+	}
 }
 
 // Paginate executes the query and returns a relay based cursor connection to Todo.
@@ -707,7 +770,7 @@ func (t *TodoQuery) Paginate(
 	if err != nil {
 		return nil, err
 	}
-	if t, err = pager.applyFilter(t); err != nil {
+	if t, err = pager.applyFilter(ctx, t); err != nil {
 		return nil, err
 	}
 	conn := &TodoConnection{Edges: []*TodoEdge{}}
@@ -840,6 +903,11 @@ type TodoOrderField struct {
 	toCursor func(*Todo) Cursor
 }
 
+// column returns the ordering column (or expression) of the field in the given selector.
+func (f *TodoOrderField) column(s *sql.Selector) string {
+	return s.C(f.field)
+}
+
 // TodoOrder defines the ordering of Todo.
 type TodoOrder struct {
 	Direction OrderDirection  `json:"direction"`
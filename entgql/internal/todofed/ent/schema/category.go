@@ -38,11 +38,15 @@ func (Category) Fields() []ent.Field {
 			NotEmpty().
 			Annotations(
 				entgql.OrderField("TEXT"),
+				entgql.Directives(entgql.Shareable()),
 			),
 		field.Enum("status").
 			NamedValues(
 				"Enabled", "ENABLED",
 				"Disabled", "DISABLED",
+			).
+			Annotations(
+				entgql.Type("CategoryStatus"),
 			),
 		field.Other("config", &schematype.CategoryConfig{}).
 			SchemaType(map[string]string{
@@ -275,9 +275,6 @@ func (t *Todo) String() string {
 	return builder.String()
 }
 
-// IsEntity implement fedruntime.Entity
-func (t Todo) IsEntity() {}
-
 // Todos is a parsable slice of Todo.
 type Todos []*Todo
 
@@ -103,9 +103,6 @@ func (vs *VerySecret) String() string {
 	return builder.String()
 }
 
-// IsEntity implement fedruntime.Entity
-func (vs VerySecret) IsEntity() {}
-
 // VerySecrets is a parsable slice of VerySecret.
 type VerySecrets []*VerySecret
 
@@ -23,9 +23,20 @@ import (
 	"entgo.io/contrib/entgql/internal/todofed/ent"
 )
 
-func (r *entityResolver) FindTodoByID(ctx context.Context, id int) (*ent.Todo, error) {
-	todo, err := r.client.Todo.Get(ctx, id)
-	return todo, ent.MaskNotFound(err)
+func (r *entityResolver) FindManyCategoryByIDs(ctx context.Context, reps []*CategoryByIDsInput) ([]*ent.Category, error) {
+	ids := make([]int, len(reps))
+	for i := range reps {
+		ids[i] = reps[i].ID
+	}
+	return r.client.Category.Entities(ctx, ids...)
+}
+
+func (r *entityResolver) FindManyTodoByIDs(ctx context.Context, reps []*TodoByIDsInput) ([]*ent.Todo, error) {
+	ids := make([]int, len(reps))
+	for i := range reps {
+		ids[i] = reps[i].ID
+	}
+	return r.client.Todo.Entities(ctx, ids...)
 }
 
 // Entity returns EntityResolver implementation.
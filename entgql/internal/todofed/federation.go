@@ -65,6 +65,10 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 
 	isMulti := func(typeName string) bool {
 		switch typeName {
+		case "Category":
+			return true
+		case "Todo":
+			return true
 		default:
 			return false
 		}
@@ -80,26 +84,6 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 		}()
 
 		switch typeName {
-		case "Todo":
-			resolverName, err := entityResolverNameForTodo(ctx, rep)
-			if err != nil {
-				return fmt.Errorf(`finding resolver for Entity "Todo": %w`, err)
-			}
-			switch resolverName {
-
-			case "findTodoByID":
-				id0, err := ec.unmarshalNID2int(ctx, rep["id"])
-				if err != nil {
-					return fmt.Errorf(`unmarshalling param 0 for findTodoByID(): %w`, err)
-				}
-				entity, err := ec.resolvers.Entity().FindTodoByID(ctx, id0)
-				if err != nil {
-					return fmt.Errorf(`resolving Entity "Todo": %w`, err)
-				}
-
-				list[idx[i]] = entity
-				return nil
-			}
 
 		}
 		return fmt.Errorf("%w: %s", ErrUnknownType, typeName)
@@ -116,6 +100,54 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 
 		switch typeName {
 
+		case "Category":
+			_reps := make([]*CategoryByIDsInput, len(reps))
+
+			for i, rep := range reps {
+				id0, err := ec.unmarshalNID2int(ctx, rep["id"])
+				if err != nil {
+					return errors.New(fmt.Sprintf("Field %s undefined in schema.", "id"))
+				}
+
+				_reps[i] = &CategoryByIDsInput{
+					ID: id0,
+				}
+			}
+
+			entities, err := ec.resolvers.Entity().FindManyCategoryByIDs(ctx, _reps)
+			if err != nil {
+				return err
+			}
+
+			for i, entity := range entities {
+				list[idx[i]] = entity
+			}
+			return nil
+
+		case "Todo":
+			_reps := make([]*TodoByIDsInput, len(reps))
+
+			for i, rep := range reps {
+				id0, err := ec.unmarshalNID2int(ctx, rep["id"])
+				if err != nil {
+					return errors.New(fmt.Sprintf("Field %s undefined in schema.", "id"))
+				}
+
+				_reps[i] = &TodoByIDsInput{
+					ID: id0,
+				}
+			}
+
+			entities, err := ec.resolvers.Entity().FindManyTodoByIDs(ctx, _reps)
+			if err != nil {
+				return err
+			}
+
+			for i, entity := range entities {
+				list[idx[i]] = entity
+			}
+			return nil
+
 		default:
 			return errors.New("unknown type: " + typeName)
 		}
@@ -169,6 +201,23 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 	}
 }
 
+func entityResolverNameForCategory(ctx context.Context, rep map[string]interface{}) (string, error) {
+	for {
+		var (
+			m   map[string]interface{}
+			val interface{}
+			ok  bool
+		)
+		_ = val
+		m = rep
+		if _, ok = m["id"]; !ok {
+			break
+		}
+		return "findManyCategoryByIDs", nil
+	}
+	return "", fmt.Errorf("%w for Category", ErrTypeNotFound)
+}
+
 func entityResolverNameForTodo(ctx context.Context, rep map[string]interface{}) (string, error) {
 	for {
 		var (
@@ -181,7 +230,7 @@ func entityResolverNameForTodo(ctx context.Context, rep map[string]interface{})
 		if _, ok = m["id"]; !ok {
 			break
 		}
-		return "findTodoByID", nil
+		return "findManyTodoByIDs", nil
 	}
 	return "", fmt.Errorf("%w for Todo", ErrTypeNotFound)
 }
@@ -6,6 +6,14 @@ import (
 	"entgo.io/contrib/entgql/internal/todofed/ent/todo"
 )
 
+type CategoryByIDsInput struct {
+	ID int `json:"ID"`
+}
+
+type TodoByIDsInput struct {
+	ID int `json:"ID"`
+}
+
 type TodoInput struct {
 	Status     todo.Status `json:"status"`
 	Priority   *int        `json:"priority"`
@@ -55,6 +55,7 @@ func main() {
 
 	srv := handler.NewDefaultServer(todofed.NewSchema(client))
 	srv.Use(entgql.Transactioner{TxOpener: client})
+	srv.Use(entgql.Batcher{})
 	if cli.Debug {
 		srv.Use(&debug.Tracer{})
 	}
@@ -799,3 +799,54 @@ func (s *todoTestSuite) TestQueryJSONFields() {
 	s.Require().Equal(cat.Text, rsp.Node.Text)
 	s.Require().Equal(cat.Strings, rsp.Node.Strings)
 }
+
+func (s *todoTestSuite) TestEntities() {
+	var (
+		ctx = context.Background()
+		cat = s.ent.Category.Create().SetText("category").SetStatus(category.StatusEnabled).SaveX(ctx)
+		rsp struct {
+			Entities []*struct {
+				Typename string `json:"__typename"`
+				ID       string
+				Text     string
+			} `json:"_entities"`
+		}
+	)
+	err := s.Post(`query entities($representations: [_Any!]!) {
+		_entities(representations: $representations) {
+			__typename
+			... on Todo {
+				id
+				text
+			}
+			... on Category {
+				id
+				text
+			}
+		}
+	}`, &rsp, client.Var("representations", []map[string]interface{}{
+		{"__typename": "Todo", "id": idOffset + 2},
+		{"__typename": "Category", "id": cat.ID},
+		{"__typename": "Todo", "id": idOffset + maxTodos + 1},
+		{"__typename": "Todo", "id": idOffset + 1},
+	}))
+	s.Require().NoError(err)
+	s.Require().Len(rsp.Entities, 4)
+	s.Require().Equal("Todo", rsp.Entities[0].Typename)
+	s.Require().Equal(strconv.Itoa(idOffset+2), rsp.Entities[0].Text)
+	s.Require().Equal("Category", rsp.Entities[1].Typename)
+	s.Require().Equal(strconv.Itoa(cat.ID), rsp.Entities[1].ID)
+	s.Require().Equal(cat.Text, rsp.Entities[1].Text)
+	s.Require().Nil(rsp.Entities[2])
+	s.Require().Equal(strconv.Itoa(idOffset+1), rsp.Entities[3].ID)
+
+	var service struct {
+		Service struct {
+			SDL string
+		} `json:"_service"`
+	}
+	err = s.Post(`query { _service { sdl } }`, &service)
+	s.Require().NoError(err)
+	s.Require().Contains(service.Service.SDL, `type Todo implements Node @key(fields: "id")`)
+	s.Require().Contains(service.Service.SDL, `text: String! @shareable`)
+}
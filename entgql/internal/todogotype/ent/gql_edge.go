@@ -19,15 +19,21 @@ package ent
 import (
 	"context"
 
+	"entgo.io/contrib/entgql"
+	"entgo.io/contrib/entgql/internal/todogotype/ent/category"
+	"entgo.io/contrib/entgql/internal/todogotype/ent/friendship"
+	"entgo.io/contrib/entgql/internal/todogotype/ent/todo"
+	"entgo.io/contrib/entgql/internal/todogotype/ent/user"
 	"github.com/99designs/gqlgen/graphql"
 )
 
 func (c *Category) Todos(
-	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *TodoOrder, where *TodoWhereInput,
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy []*TodoOrder, where *TodoWhereInput, includeDeleted *bool,
 ) (*TodoConnection, error) {
 	opts := []TodoPaginateOption{
 		WithTodoOrder(orderBy),
 		WithTodoFilter(where.Filter),
+		WithTodoIncludeDeleted(includeDeleted),
 	}
 	totalCount := c.Edges.totalCount[0]
 	if nodes, err := c.Edges.TodosOrErr(); err == nil || totalCount != nil {
@@ -50,7 +56,7 @@ func (c *Category) Todos(
 	if err != nil {
 		return nil, err
 	}
-	if query, err = pager.applyFilter(query); err != nil {
+	if query, err = pager.applyFilter(ctx, query); err != nil {
 		return nil, err
 	}
 	conn := &TodoConnection{Edges: []*TodoEdge{}}
@@ -77,6 +83,7 @@ func (c *Category) Todos(
 
 	query = pager.applyCursors(query, after, before)
 	query = pager.applyOrder(query, last != nil)
+	query = pager.applyOrderValues(query)
 	if limit := paginateLimit(first, last); limit != 0 {
 		query.Limit(limit)
 	}
@@ -94,6 +101,92 @@ func (c *Category) Todos(
 	return conn, nil
 }
 
+func (f *Friendship) User(ctx context.Context) (*User, error) {
+	result, err := f.Edges.UserOrErr()
+	if IsNotLoaded(err) {
+		result, err = f.loadUser(ctx)
+	}
+	return result, err
+}
+
+// loadUser queries the user edge of the Friendship. If a BatchLoader is attached to
+// the context, the lookups of sibling nodes are batched into a single query.
+func (f *Friendship) loadUser(ctx context.Context) (*User, error) {
+	l := entgql.BatchLoaderFromContext(ctx)
+	if l == nil {
+		return f.QueryUser().Only(ctx)
+	}
+	v, err := l.Load(ctx, "Friendship.user", f.ID, func(ctx context.Context, keys []interface{}) (map[interface{}]interface{}, error) {
+		ids := make([]string, len(keys))
+		for i := range keys {
+			ids[i] = keys[i].(string)
+		}
+		nodes, err := (&FriendshipClient{config: f.config}).Query().
+			Where(friendship.IDIn(ids...)).
+			WithUser().
+			Select(friendship.FieldID, friendship.FieldUserID, friendship.FieldFriendID).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[interface{}]interface{}, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n.Edges.User
+		}
+		return m, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result, ok := v.(*User); ok && result != nil {
+		return result, nil
+	}
+	return nil, &NotFoundError{user.Label}
+}
+
+func (f *Friendship) Friend(ctx context.Context) (*User, error) {
+	result, err := f.Edges.FriendOrErr()
+	if IsNotLoaded(err) {
+		result, err = f.loadFriend(ctx)
+	}
+	return result, err
+}
+
+// loadFriend queries the friend edge of the Friendship. If a BatchLoader is attached to
+// the context, the lookups of sibling nodes are batched into a single query.
+func (f *Friendship) loadFriend(ctx context.Context) (*User, error) {
+	l := entgql.BatchLoaderFromContext(ctx)
+	if l == nil {
+		return f.QueryFriend().Only(ctx)
+	}
+	v, err := l.Load(ctx, "Friendship.friend", f.ID, func(ctx context.Context, keys []interface{}) (map[interface{}]interface{}, error) {
+		ids := make([]string, len(keys))
+		for i := range keys {
+			ids[i] = keys[i].(string)
+		}
+		nodes, err := (&FriendshipClient{config: f.config}).Query().
+			Where(friendship.IDIn(ids...)).
+			WithFriend().
+			Select(friendship.FieldID, friendship.FieldUserID, friendship.FieldFriendID).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[interface{}]interface{}, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n.Edges.Friend
+		}
+		return m, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result, ok := v.(*User); ok && result != nil {
+		return result, nil
+	}
+	return nil, &NotFoundError{user.Label}
+}
+
 func (gr *Group) Users(
 	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, where *UserWhereInput,
 ) (*UserConnection, error) {
@@ -121,7 +214,7 @@ func (gr *Group) Users(
 	if err != nil {
 		return nil, err
 	}
-	if query, err = pager.applyFilter(query); err != nil {
+	if query, err = pager.applyFilter(ctx, query); err != nil {
 		return nil, err
 	}
 	conn := &UserConnection{Edges: []*UserEdge{}}
@@ -168,17 +261,53 @@ func (gr *Group) Users(
 func (t *Todo) Parent(ctx context.Context) (*Todo, error) {
 	result, err := t.Edges.ParentOrErr()
 	if IsNotLoaded(err) {
-		result, err = t.QueryParent().Only(ctx)
+		result, err = t.loadParent(ctx)
 	}
 	return result, MaskNotFound(err)
 }
 
+// loadParent queries the parent edge of the Todo. If a BatchLoader is attached to
+// the context, the lookups of sibling nodes are batched into a single query.
+func (t *Todo) loadParent(ctx context.Context) (*Todo, error) {
+	l := entgql.BatchLoaderFromContext(ctx)
+	if l == nil {
+		return t.QueryParent().excludeDeleted(ctx).Only(ctx)
+	}
+	v, err := l.Load(ctx, "Todo.parent", t.ID, func(ctx context.Context, keys []interface{}) (map[interface{}]interface{}, error) {
+		ids := make([]string, len(keys))
+		for i := range keys {
+			ids[i] = keys[i].(string)
+		}
+		nodes, err := (&TodoClient{config: t.config}).Query().
+			Where(todo.IDIn(ids...)).
+			WithParent(func(q *TodoQuery) { q.excludeDeleted(ctx) }).
+			Select(todo.FieldID, todo.FieldCategoryID).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[interface{}]interface{}, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n.Edges.Parent
+		}
+		return m, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result, ok := v.(*Todo); ok && result != nil {
+		return result, nil
+	}
+	return nil, &NotFoundError{todo.Label}
+}
+
 func (t *Todo) Children(
-	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *TodoOrder, where *TodoWhereInput,
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy []*TodoOrder, where *TodoWhereInput, includeDeleted *bool,
 ) (*TodoConnection, error) {
 	opts := []TodoPaginateOption{
 		WithTodoOrder(orderBy),
 		WithTodoFilter(where.Filter),
+		WithTodoIncludeDeleted(includeDeleted),
 	}
 	totalCount := t.Edges.totalCount[1]
 	if nodes, err := t.Edges.ChildrenOrErr(); err == nil || totalCount != nil {
@@ -201,7 +330,7 @@ func (t *Todo) Children(
 	if err != nil {
 		return nil, err
 	}
-	if query, err = pager.applyFilter(query); err != nil {
+	if query, err = pager.applyFilter(ctx, query); err != nil {
 		return nil, err
 	}
 	conn := &TodoConnection{Edges: []*TodoEdge{}}
@@ -228,6 +357,7 @@ func (t *Todo) Children(
 
 	query = pager.applyCursors(query, after, before)
 	query = pager.applyOrder(query, last != nil)
+	query = pager.applyOrderValues(query)
 	if limit := paginateLimit(first, last); limit != 0 {
 		query.Limit(limit)
 	}
@@ -248,11 +378,46 @@ func (t *Todo) Children(
 func (t *Todo) Category(ctx context.Context) (*Category, error) {
 	result, err := t.Edges.CategoryOrErr()
 	if IsNotLoaded(err) {
-		result, err = t.QueryCategory().Only(ctx)
+		result, err = t.loadCategory(ctx)
 	}
 	return result, MaskNotFound(err)
 }
 
+// loadCategory queries the category edge of the Todo. If a BatchLoader is attached to
+// the context, the lookups of sibling nodes are batched into a single query.
+func (t *Todo) loadCategory(ctx context.Context) (*Category, error) {
+	l := entgql.BatchLoaderFromContext(ctx)
+	if l == nil {
+		return t.QueryCategory().Only(ctx)
+	}
+	v, err := l.Load(ctx, "Todo.category", t.ID, func(ctx context.Context, keys []interface{}) (map[interface{}]interface{}, error) {
+		ids := make([]string, len(keys))
+		for i := range keys {
+			ids[i] = keys[i].(string)
+		}
+		nodes, err := (&TodoClient{config: t.config}).Query().
+			Where(todo.IDIn(ids...)).
+			WithCategory().
+			Select(todo.FieldID, todo.FieldCategoryID).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[interface{}]interface{}, len(nodes))
+		for _, n := range nodes {
+			m[n.ID] = n.Edges.Category
+		}
+		return m, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result, ok := v.(*Category); ok && result != nil {
+		return result, nil
+	}
+	return nil, &NotFoundError{category.Label}
+}
+
 func (u *User) Groups(
 	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, where *GroupWhereInput,
 ) (*GroupConnection, error) {
@@ -280,7 +445,7 @@ func (u *User) Groups(
 	if err != nil {
 		return nil, err
 	}
-	if query, err = pager.applyFilter(query); err != nil {
+	if query, err = pager.applyFilter(ctx, query); err != nil {
 		return nil, err
 	}
 	conn := &GroupConnection{Edges: []*GroupEdge{}}
@@ -324,10 +489,132 @@ func (u *User) Groups(
 	return conn, nil
 }
 
-func (u *User) Friends(ctx context.Context) ([]*User, error) {
-	result, err := u.Edges.FriendsOrErr()
-	if IsNotLoaded(err) {
-		result, err = u.QueryFriends().All(ctx)
+func (u *User) Friends(
+	ctx context.Context, offset, limit *int, where *UserWhereInput,
+) (*UserPage, error) {
+	opts := []UserPaginateOption{
+		WithUserFilter(where.Filter),
 	}
-	return result, err
+	totalCount := u.Edges.totalCount[1]
+	if nodes, err := u.Edges.FriendsOrErr(); err == nil || totalCount != nil {
+		page := &UserPage{}
+		if totalCount != nil {
+			page.TotalCount = *totalCount
+		}
+		page.build(nodes, offset, limit)
+		return page, nil
+	}
+	query := u.QueryFriends()
+	if err := validateOffsetLimit(offset, limit); err != nil {
+		return nil, err
+	}
+	pager, err := newUserPager(opts)
+	if err != nil {
+		return nil, err
+	}
+	if query, err = pager.applyFilter(ctx, query); err != nil {
+		return nil, err
+	}
+	page := &UserPage{Nodes: []*User{}}
+	if hasCollectedField(ctx, totalCountField) {
+		if totalCount != nil {
+			page.TotalCount = *totalCount
+		} else if page.TotalCount, err = query.Clone().Count(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if !hasCollectedField(ctx, nodesField) && !hasCollectedField(ctx, hasNextPageField) || limit != nil && *limit == 0 {
+		page.HasPreviousPage = offset != nil && *offset > 0
+		return page, nil
+	}
+	query = pager.applyOrder(query, false)
+	if offset != nil {
+		query.Offset(*offset)
+	}
+	if limit != nil {
+		query.Limit(*limit + 1)
+	}
+	if field := collectedField(ctx, nodesField); field != nil {
+		if err := query.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{nodesField}); err != nil {
+			return nil, err
+		}
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page.build(nodes, offset, limit)
+	return page, nil
+}
+
+func (u *User) Friendships(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *FriendshipOrder, where *FriendshipWhereInput,
+) (*FriendshipConnection, error) {
+	opts := []FriendshipPaginateOption{
+		WithFriendshipOrder(orderBy),
+		WithFriendshipFilter(where.Filter),
+	}
+	totalCount := u.Edges.totalCount[2]
+	if nodes, err := u.Edges.FriendshipsOrErr(); err == nil || totalCount != nil {
+		conn := &FriendshipConnection{Edges: []*FriendshipEdge{}}
+		if totalCount != nil {
+			conn.TotalCount = *totalCount
+		}
+		pager, err := newFriendshipPager(opts)
+		if err != nil {
+			return nil, err
+		}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	query := u.QueryFriendships()
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newFriendshipPager(opts)
+	if err != nil {
+		return nil, err
+	}
+	if query, err = pager.applyFilter(ctx, query); err != nil {
+		return nil, err
+	}
+	conn := &FriendshipConnection{Edges: []*FriendshipEdge{}}
+	if !hasCollectedField(ctx, edgesField) || first != nil && *first == 0 || last != nil && *last == 0 {
+		if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+			if totalCount != nil {
+				conn.TotalCount = *totalCount
+			} else if conn.TotalCount, err = query.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+		return conn, nil
+	}
+
+	if (after != nil || first != nil || before != nil || last != nil) && hasCollectedField(ctx, totalCountField) {
+		count, err := query.Clone().Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+		conn.TotalCount = count
+	}
+
+	query = pager.applyCursors(query, after, before)
+	query = pager.applyOrder(query, last != nil)
+	if limit := paginateLimit(first, last); limit != 0 {
+		query.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := query.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+
+	nodes, err := query.All(ctx)
+	if err != nil || len(nodes) == 0 {
+		return conn, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
 }
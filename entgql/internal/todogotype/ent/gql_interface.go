@@ -0,0 +1,246 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/contrib/entgql/internal/todogotype/ent/friendship"
+	"entgo.io/contrib/entgql/internal/todogotype/ent/todo"
+	"entgo.io/ent/dialect/sql"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Timestamped is implemented by the types of the Timestamped GraphQL interface.
+type Timestamped interface {
+	IsTimestamped()
+}
+
+// IsTimestamped implements the Timestamped interface.
+func (*Friendship) IsTimestamped() {}
+
+// IsTimestamped implements the Timestamped interface.
+func (*Todo) IsTimestamped() {}
+
+// TimestampedEdge is the edge representation of Timestamped.
+type TimestampedEdge struct {
+	Node   Timestamped `json:"node"`
+	Cursor Cursor      `json:"cursor"`
+}
+
+// TimestampedConnection is the connection containing edges to Timestamped.
+type TimestampedConnection struct {
+	Edges      []*TimestampedEdge `json:"edges"`
+	PageInfo   PageInfo           `json:"pageInfo"`
+	TotalCount int                `json:"totalCount"`
+}
+
+// PaginateTimestamped returns a relay based cursor connection to the nodes of all types
+// that implement the Timestamped interface. The nodes are ordered by their type
+// (Friendship, Todo) and then by their id.
+func (c *Client) PaginateTimestamped(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int) (*TimestampedConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pagers := []struct {
+		typ   string
+		count func(context.Context) (int, error)
+		page  func(ctx context.Context, after, before *Cursor, limit int, reverse bool) ([]*TimestampedEdge, error)
+	}{
+		{
+			typ: "Friendship",
+			count: func(ctx context.Context) (int, error) {
+				return c.Friendship.Query().Count(ctx)
+			},
+			page: func(ctx context.Context, after, before *Cursor, limit int, reverse bool) ([]*TimestampedEdge, error) {
+				query := c.Friendship.Query()
+				if after != nil {
+					query.Where(func(s *sql.Selector) {
+						s.Where(sql.GT(s.C(friendship.FieldID), after.ID))
+					})
+				}
+				if before != nil {
+					query.Where(func(s *sql.Selector) {
+						s.Where(sql.LT(s.C(friendship.FieldID), before.ID))
+					})
+				}
+				if reverse {
+					query.Order(Desc(friendship.FieldID))
+				} else {
+					query.Order(Asc(friendship.FieldID))
+				}
+				if limit > 0 {
+					query.Limit(limit)
+				}
+				if field := collectedField(ctx, edgesField, nodeField); field != nil {
+					if err := query.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}, "Friendship"); err != nil {
+						return nil, err
+					}
+				}
+				nodes, err := query.All(ctx)
+				if err != nil {
+					return nil, err
+				}
+				edges := make([]*TimestampedEdge, len(nodes))
+				for i, n := range nodes {
+					edges[i] = &TimestampedEdge{
+						Node:   n,
+						Cursor: Cursor{ID: n.ID, Value: "Friendship"},
+					}
+				}
+				return edges, nil
+			},
+		},
+		{
+			typ: "Todo",
+			count: func(ctx context.Context) (int, error) {
+				return c.Todo.Query().excludeDeleted(ctx).Count(ctx)
+			},
+			page: func(ctx context.Context, after, before *Cursor, limit int, reverse bool) ([]*TimestampedEdge, error) {
+				query := c.Todo.Query().excludeDeleted(ctx)
+				if after != nil {
+					query.Where(func(s *sql.Selector) {
+						s.Where(sql.GT(s.C(todo.FieldID), after.ID))
+					})
+				}
+				if before != nil {
+					query.Where(func(s *sql.Selector) {
+						s.Where(sql.LT(s.C(todo.FieldID), before.ID))
+					})
+				}
+				if reverse {
+					query.Order(Desc(todo.FieldID))
+				} else {
+					query.Order(Asc(todo.FieldID))
+				}
+				if limit > 0 {
+					query.Limit(limit)
+				}
+				if field := collectedField(ctx, edgesField, nodeField); field != nil {
+					if err := query.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}, "Todo"); err != nil {
+						return nil, err
+					}
+				}
+				nodes, err := query.All(ctx)
+				if err != nil {
+					return nil, err
+				}
+				edges := make([]*TimestampedEdge, len(nodes))
+				for i, n := range nodes {
+					edges[i] = &TimestampedEdge{
+						Node:   n,
+						Cursor: Cursor{ID: n.ID, Value: "Todo"},
+					}
+				}
+				return edges, nil
+			},
+		},
+	}
+	conn := &TimestampedConnection{Edges: []*TimestampedEdge{}}
+	if hasCollectedField(ctx, totalCountField) || !hasCollectedField(ctx, edgesField) && hasCollectedField(ctx, pageInfoField) {
+		for _, p := range pagers {
+			count, err := p.count(ctx)
+			if err != nil {
+				return nil, err
+			}
+			conn.TotalCount += count
+		}
+	}
+	if !hasCollectedField(ctx, edgesField) || first != nil && *first == 0 || last != nil && *last == 0 {
+		conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+		conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		return conn, nil
+	}
+	// The cursors hold the types of their nodes, and
+	// limit the implementations that are paginated.
+	index := func(cursor *Cursor, def int) (int, error) {
+		if cursor == nil {
+			return def, nil
+		}
+		for j, p := range pagers {
+			if p.typ == fmt.Sprint(cursor.Value) {
+				return j, nil
+			}
+		}
+		err := &gqlerror.Error{
+			Message: "The given cursor is invalid.",
+		}
+		errcode.Set(err, errInvalidPagination)
+		return 0, err
+	}
+	start, err := index(after, 0)
+	if err != nil {
+		return nil, err
+	}
+	end, err := index(before, len(pagers)-1)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		edges   []*TimestampedEdge
+		limit   = paginateLimit(first, last)
+		reverse = last != nil
+	)
+	for j := start; j <= end && (limit == 0 || len(edges) < limit); j++ {
+		k := j
+		if reverse {
+			k = end - (j - start)
+		}
+		var a, b *Cursor
+		if k == start {
+			a = after
+		}
+		if k == end {
+			b = before
+		}
+		n := 0
+		if limit > 0 {
+			n = limit - len(edges)
+		}
+		page, err := pagers[k].page(ctx, a, b, n, reverse)
+		if err != nil {
+			return nil, err
+		}
+		edges = append(edges, page...)
+	}
+	conn.PageInfo.HasNextPage = before != nil
+	conn.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(edges) {
+		conn.PageInfo.HasNextPage = true
+		edges = edges[:len(edges)-1]
+	} else if last != nil && *last+1 == len(edges) {
+		conn.PageInfo.HasPreviousPage = true
+		edges = edges[:len(edges)-1]
+	}
+	if reverse {
+		for l, r := 0, len(edges)-1; l < r; l, r = l+1, r-1 {
+			edges[l], edges[r] = edges[r], edges[l]
+		}
+	}
+	conn.Edges = append(conn.Edges, edges...)
+	if l := len(edges); l > 0 {
+		conn.PageInfo.StartCursor = &edges[0].Cursor
+		conn.PageInfo.EndCursor = &edges[l-1].Cursor
+	}
+	if conn.TotalCount == 0 {
+		conn.TotalCount = len(edges)
+	}
+	return conn, nil
+}
@@ -0,0 +1,162 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/contrib/entgql/internal/todogotype/ent/schema/bigintgql"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// MutationResolver implements the resolvers of the mutation fields
+// generated by the entgql.MutationFields annotation.
+type MutationResolver struct {
+	client *Client
+}
+
+// MutationResolver returns a resolver for the generated mutation fields.
+// The client attached to the resolver context (e.g. the transactional client
+// attached by the entgql.Transactioner) takes precedence over c.
+func (c *Client) MutationResolver() *MutationResolver {
+	return &MutationResolver{client: c}
+}
+
+func (r *MutationResolver) clientFromContext(ctx context.Context) *Client {
+	if client := FromContext(ctx); client != nil {
+		return client
+	}
+	return r.client
+}
+
+// inputErrors adds the given input validation errors, except the last
+// one, to the response, and returns the last one to fail the field.
+func inputErrors(ctx context.Context, errs gqlerror.List) error {
+	for _, err := range errs[:len(errs)-1] {
+		graphql.AddError(ctx, err)
+	}
+	return errs[len(errs)-1]
+}
+
+// CreateCategory resolves the "createCategory" mutation field by creating a new Category.
+func (r *MutationResolver) CreateCategory(ctx context.Context, input CreateCategoryInput) (*Category, error) {
+	if errs := input.validate(ast.Path{ast.PathName("input")}); len(errs) > 0 {
+		return nil, inputErrors(ctx, errs)
+	}
+	return r.clientFromContext(ctx).Category.
+		Create().
+		SetInput(input).
+		Save(ctx)
+}
+
+// DeleteCategory resolves the "deleteCategory" mutation field by deleting the Category with the given id.
+func (r *MutationResolver) DeleteCategory(ctx context.Context, id bigintgql.BigInt) (bigintgql.BigInt, error) {
+	if err := r.clientFromContext(ctx).Category.DeleteOneID(id).Exec(ctx); err != nil {
+		var zero bigintgql.BigInt
+		return zero, err
+	}
+	return id, nil
+}
+
+// UpsertCategory resolves the "upsertCategory" mutation field by creating a new Category,
+// or updating the Category that conflicts with the given input.
+func (r *MutationResolver) UpsertCategory(ctx context.Context, input UpsertCategoryInput) (*Category, error) {
+	if errs := input.validate(ast.Path{ast.PathName("input")}); len(errs) > 0 {
+		return nil, inputErrors(ctx, errs)
+	}
+	return r.clientFromContext(ctx).Category.UpsertInput(ctx, input)
+}
+
+// CreateTodo resolves the "createTodo" mutation field by creating a new Todo.
+func (r *MutationResolver) CreateTodo(ctx context.Context, input CreateTodoInput) (*Todo, error) {
+	if errs := input.validate(ast.Path{ast.PathName("input")}); len(errs) > 0 {
+		return nil, inputErrors(ctx, errs)
+	}
+	return r.clientFromContext(ctx).Todo.
+		Create().
+		SetInput(input).
+		Save(ctx)
+}
+
+// UpdateTodo resolves the "updateTodo" mutation field by updating the Todo with the given id.
+func (r *MutationResolver) UpdateTodo(ctx context.Context, id string, input UpdateTodoInput) (*Todo, error) {
+	if errs := input.validate(ast.Path{ast.PathName("input")}); len(errs) > 0 {
+		return nil, inputErrors(ctx, errs)
+	}
+	return r.clientFromContext(ctx).Todo.
+		UpdateOneID(id).
+		SetInput(input).
+		Save(ctx)
+}
+
+// DeleteTodo resolves the "deleteTodo" mutation field by deleting the Todo with the given id.
+func (r *MutationResolver) DeleteTodo(ctx context.Context, id string) (string, error) {
+	if err := r.clientFromContext(ctx).Todo.DeleteOneID(id).Exec(ctx); err != nil {
+		var zero string
+		return zero, err
+	}
+	return id, nil
+}
+
+// CreateTodos resolves the "createTodos" mutation field by creating the Todos of the given inputs in bulk.
+func (r *MutationResolver) CreateTodos(ctx context.Context, inputs []*CreateTodoInput) ([]*Todo, error) {
+	var errs gqlerror.List
+	for i := range inputs {
+		errs = append(errs, inputs[i].validate(ast.Path{ast.PathName("inputs"), ast.PathIndex(i)})...)
+	}
+	if len(errs) > 0 {
+		return nil, inputErrors(ctx, errs)
+	}
+	client := r.clientFromContext(ctx)
+	builders := make([]*TodoCreate, len(inputs))
+	for i := range inputs {
+		builders[i] = client.Todo.Create().SetInput(*inputs[i])
+	}
+	return client.Todo.CreateBulk(builders...).Save(ctx)
+}
+
+// UpdateTodos resolves the "updateTodos" mutation field by updating the Todos that match
+// the given filter. It returns the number of affected Todos.
+func (r *MutationResolver) UpdateTodos(ctx context.Context, where TodoWhereInput, input UpdateTodoInput) (int, error) {
+	p, err := where.P()
+	if err != nil {
+		return 0, err
+	}
+	if errs := input.validate(ast.Path{ast.PathName("input")}); len(errs) > 0 {
+		return 0, inputErrors(ctx, errs)
+	}
+	return r.clientFromContext(ctx).Todo.
+		Update().
+		Where(p).
+		SetInput(input).
+		Save(ctx)
+}
+
+// DeleteTodos resolves the "deleteTodos" mutation field by deleting the Todos that match
+// the given filter. It returns the number of affected Todos.
+func (r *MutationResolver) DeleteTodos(ctx context.Context, where TodoWhereInput) (int, error) {
+	p, err := where.P()
+	if err != nil {
+		return 0, err
+	}
+	return r.clientFromContext(ctx).Todo.
+		Delete().
+		Where(p).
+		Exec(ctx)
+}
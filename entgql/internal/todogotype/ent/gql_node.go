@@ -24,6 +24,7 @@ import (
 
 	"entgo.io/contrib/entgql"
 	"entgo.io/contrib/entgql/internal/todogotype/ent/category"
+	"entgo.io/contrib/entgql/internal/todogotype/ent/friendship"
 	"entgo.io/contrib/entgql/internal/todogotype/ent/group"
 	"entgo.io/contrib/entgql/internal/todogotype/ent/pet"
 	"entgo.io/contrib/entgql/internal/todogotype/ent/schema/bigintgql"
@@ -71,7 +72,7 @@ func (c *Category) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     c.marshalID(),
 		Type:   "Category",
-		Fields: make([]*Field, 6),
+		Fields: make([]*Field, 8),
 		Edges:  make([]*Edge, 1),
 	}
 	var buf []byte
@@ -123,11 +124,27 @@ func (c *Category) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "strings",
 		Value: string(buf),
 	}
+	if buf, err = json.Marshal(c.Types); err != nil {
+		return nil, err
+	}
+	node.Fields[6] = &Field{
+		Type:  "*schematype.CategoryTypes",
+		Name:  "types",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(c.Slug); err != nil {
+		return nil, err
+	}
+	node.Fields[7] = &Field{
+		Type:  "string",
+		Name:  "slug",
+		Value: string(buf),
+	}
 	node.Edges[0] = &Edge{
 		Type: "Todo",
 		Name: "todos",
 	}
-	err = c.QueryTodos().
+	err = c.QueryTodos().excludeDeleted(ctx).
 		Select(todo.FieldID).
 		Scan(ctx, &node.Edges[0].IDs)
 	if err != nil {
@@ -136,6 +153,61 @@ func (c *Category) Node(ctx context.Context) (node *Node, err error) {
 	return node, nil
 }
 
+func (f *Friendship) Node(ctx context.Context) (node *Node, err error) {
+	node = &Node{
+		ID:     f.ID,
+		Type:   "Friendship",
+		Fields: make([]*Field, 3),
+		Edges:  make([]*Edge, 2),
+	}
+	var buf []byte
+	if buf, err = json.Marshal(f.CreatedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[0] = &Field{
+		Type:  "time.Time",
+		Name:  "created_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(f.UserID); err != nil {
+		return nil, err
+	}
+	node.Fields[1] = &Field{
+		Type:  "string",
+		Name:  "user_id",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(f.FriendID); err != nil {
+		return nil, err
+	}
+	node.Fields[2] = &Field{
+		Type:  "string",
+		Name:  "friend_id",
+		Value: string(buf),
+	}
+	node.Edges[0] = &Edge{
+		Type: "User",
+		Name: "user",
+	}
+	err = f.QueryUser().
+		Select(user.FieldID).
+		Scan(ctx, &node.Edges[0].IDs)
+	if err != nil {
+		return nil, err
+	}
+	node.Edges[1] = &Edge{
+		Type: "User",
+		Name: "friend",
+	}
+	err = f.QueryFriend().
+		Select(user.FieldID).
+		Scan(ctx, &node.Edges[1].IDs)
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
 func (gr *Group) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     gr.ID,
@@ -194,7 +266,7 @@ func (t *Todo) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     t.ID,
 		Type:   "Todo",
-		Fields: make([]*Field, 5),
+		Fields: make([]*Field, 8),
 		Edges:  make([]*Edge, 3),
 	}
 	var buf []byte
@@ -230,10 +302,34 @@ func (t *Todo) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "text",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(t.CategoryID); err != nil {
+	if buf, err = json.Marshal(t.DeletedAt); err != nil {
 		return nil, err
 	}
 	node.Fields[4] = &Field{
+		Type:  "time.Time",
+		Name:  "deleted_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(t.Version); err != nil {
+		return nil, err
+	}
+	node.Fields[5] = &Field{
+		Type:  "int",
+		Name:  "version",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(t.Label); err != nil {
+		return nil, err
+	}
+	node.Fields[6] = &Field{
+		Type:  "string",
+		Name:  "label",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(t.CategoryID); err != nil {
+		return nil, err
+	}
+	node.Fields[7] = &Field{
 		Type:  "bigintgql.BigInt",
 		Name:  "category_id",
 		Value: string(buf),
@@ -242,7 +338,7 @@ func (t *Todo) Node(ctx context.Context) (node *Node, err error) {
 		Type: "Todo",
 		Name: "parent",
 	}
-	err = t.QueryParent().
+	err = t.QueryParent().excludeDeleted(ctx).
 		Select(todo.FieldID).
 		Scan(ctx, &node.Edges[0].IDs)
 	if err != nil {
@@ -252,7 +348,7 @@ func (t *Todo) Node(ctx context.Context) (node *Node, err error) {
 		Type: "Todo",
 		Name: "children",
 	}
-	err = t.QueryChildren().
+	err = t.QueryChildren().excludeDeleted(ctx).
 		Select(todo.FieldID).
 		Scan(ctx, &node.Edges[1].IDs)
 	if err != nil {
@@ -276,7 +372,7 @@ func (u *User) Node(ctx context.Context) (node *Node, err error) {
 		ID:     u.ID,
 		Type:   "User",
 		Fields: make([]*Field, 1),
-		Edges:  make([]*Edge, 2),
+		Edges:  make([]*Edge, 3),
 	}
 	var buf []byte
 	if buf, err = json.Marshal(u.Name); err != nil {
@@ -307,6 +403,16 @@ func (u *User) Node(ctx context.Context) (node *Node, err error) {
 	if err != nil {
 		return nil, err
 	}
+	node.Edges[2] = &Edge{
+		Type: "Friendship",
+		Name: "friendships",
+	}
+	err = u.QueryFriendships().
+		Select(friendship.FieldID).
+		Scan(ctx, &node.Edges[2].IDs)
+	if err != nil {
+		return nil, err
+	}
 	return node, nil
 }
 
@@ -393,6 +499,18 @@ func (c *Client) noder(ctx context.Context, table string, id string) (Noder, err
 			return nil, err
 		}
 		return n, nil
+	case friendship.Table:
+		query := c.Friendship.Query().
+			Where(friendship.ID(id))
+		query, err := query.CollectFields(ctx, "Friendship")
+		if err != nil {
+			return nil, err
+		}
+		n, err := query.Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
 	case group.Table:
 		query := c.Group.Query().
 			Where(group.ID(id))
@@ -424,6 +542,7 @@ func (c *Client) noder(ctx context.Context, table string, id string) (Noder, err
 	case todo.Table:
 		query := c.Todo.Query().
 			Where(todo.ID(id))
+		query = query.excludeDeleted(ctx)
 		query, err := query.CollectFields(ctx, "Todo")
 		if err != nil {
 			return nil, err
@@ -540,6 +659,22 @@ func (c *Client) noders(ctx context.Context, table string, ids []string) ([]Node
 				*noder = node
 			}
 		}
+	case friendship.Table:
+		query := c.Friendship.Query().
+			Where(friendship.IDIn(ids...))
+		query, err := query.CollectFields(ctx, "Friendship")
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case group.Table:
 		query := c.Group.Query().
 			Where(group.IDIn(ids...))
@@ -581,6 +716,7 @@ func (c *Client) noders(ctx context.Context, table string, ids []string) ([]Node
 	case todo.Table:
 		query := c.Todo.Query().
 			Where(todo.IDIn(ids...))
+		query = query.excludeDeleted(ctx)
 		query, err := query.CollectFields(ctx, "Todo")
 		if err != nil {
 			return nil, err
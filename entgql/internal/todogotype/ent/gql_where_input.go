@@ -21,8 +21,10 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/contrib/entgql/internal/todo/ent/schema/schematype"
 	"entgo.io/contrib/entgql/internal/todogotype/ent/category"
+	"entgo.io/contrib/entgql/internal/todogotype/ent/friendship"
 	"entgo.io/contrib/entgql/internal/todogotype/ent/group"
 	"entgo.io/contrib/entgql/internal/todogotype/ent/pet"
 	"entgo.io/contrib/entgql/internal/todogotype/ent/predicate"
@@ -71,16 +73,18 @@ type CategoryWhereInput struct {
 	StatusNotIn []category.Status `json:"statusNotIn,omitempty"`
 
 	// "config" field predicates.
-	Config       *schematype.CategoryConfig   `json:"config,omitempty"`
-	ConfigNEQ    *schematype.CategoryConfig   `json:"configNEQ,omitempty"`
-	ConfigIn     []*schematype.CategoryConfig `json:"configIn,omitempty"`
-	ConfigNotIn  []*schematype.CategoryConfig `json:"configNotIn,omitempty"`
-	ConfigGT     *schematype.CategoryConfig   `json:"configGT,omitempty"`
-	ConfigGTE    *schematype.CategoryConfig   `json:"configGTE,omitempty"`
-	ConfigLT     *schematype.CategoryConfig   `json:"configLT,omitempty"`
-	ConfigLTE    *schematype.CategoryConfig   `json:"configLTE,omitempty"`
-	ConfigIsNil  bool                         `json:"configIsNil,omitempty"`
-	ConfigNotNil bool                         `json:"configNotNil,omitempty"`
+	Config        *schematype.CategoryConfig   `json:"config,omitempty"`
+	ConfigNEQ     *schematype.CategoryConfig   `json:"configNEQ,omitempty"`
+	ConfigIn      []*schematype.CategoryConfig `json:"configIn,omitempty"`
+	ConfigNotIn   []*schematype.CategoryConfig `json:"configNotIn,omitempty"`
+	ConfigGT      *schematype.CategoryConfig   `json:"configGT,omitempty"`
+	ConfigGTE     *schematype.CategoryConfig   `json:"configGTE,omitempty"`
+	ConfigLT      *schematype.CategoryConfig   `json:"configLT,omitempty"`
+	ConfigLTE     *schematype.CategoryConfig   `json:"configLTE,omitempty"`
+	ConfigIsNil   bool                         `json:"configIsNil,omitempty"`
+	ConfigNotNil  bool                         `json:"configNotNil,omitempty"`
+	ConfigHasKey  *string                      `json:"configHasKey,omitempty"`
+	ConfigValueEQ *entgql.JSONPathValue        `json:"configValueEQ,omitempty"`
 
 	// "duration" field predicates.
 	Duration       *time.Duration  `json:"duration,omitempty"`
@@ -106,6 +110,26 @@ type CategoryWhereInput struct {
 	CountIsNil  bool     `json:"countIsNil,omitempty"`
 	CountNotNil bool     `json:"countNotNil,omitempty"`
 
+	// "strings" field predicates.
+	StringsValueEQ *entgql.JSONPathValue `json:"stringsValueEQ,omitempty"`
+
+	// "slug" field predicates.
+	Slug             *string  `json:"slug,omitempty"`
+	SlugNEQ          *string  `json:"slugNEQ,omitempty"`
+	SlugIn           []string `json:"slugIn,omitempty"`
+	SlugNotIn        []string `json:"slugNotIn,omitempty"`
+	SlugGT           *string  `json:"slugGT,omitempty"`
+	SlugGTE          *string  `json:"slugGTE,omitempty"`
+	SlugLT           *string  `json:"slugLT,omitempty"`
+	SlugLTE          *string  `json:"slugLTE,omitempty"`
+	SlugContains     *string  `json:"slugContains,omitempty"`
+	SlugHasPrefix    *string  `json:"slugHasPrefix,omitempty"`
+	SlugHasSuffix    *string  `json:"slugHasSuffix,omitempty"`
+	SlugIsNil        bool     `json:"slugIsNil,omitempty"`
+	SlugNotNil       bool     `json:"slugNotNil,omitempty"`
+	SlugEqualFold    *string  `json:"slugEqualFold,omitempty"`
+	SlugContainsFold *string  `json:"slugContainsFold,omitempty"`
+
 	// "todos" edge predicates.
 	HasTodos     *bool             `json:"hasTodos,omitempty"`
 	HasTodosWith []*TodoWhereInput `json:"hasTodosWith,omitempty"`
@@ -287,6 +311,12 @@ func (i *CategoryWhereInput) P() (predicate.Category, error) {
 	if i.ConfigNotNil {
 		predicates = append(predicates, category.ConfigNotNil())
 	}
+	if i.ConfigHasKey != nil {
+		predicates = append(predicates, predicate.Category(entgql.JSONHasKey(category.FieldConfig, *i.ConfigHasKey)))
+	}
+	if i.ConfigValueEQ != nil {
+		predicates = append(predicates, predicate.Category(entgql.JSONValueEQ(category.FieldConfig, *i.ConfigValueEQ)))
+	}
 	if i.Duration != nil {
 		predicates = append(predicates, category.DurationEQ(*i.Duration))
 	}
@@ -347,16 +377,64 @@ func (i *CategoryWhereInput) P() (predicate.Category, error) {
 	if i.CountNotNil {
 		predicates = append(predicates, category.CountNotNil())
 	}
+	if i.StringsValueEQ != nil {
+		predicates = append(predicates, predicate.Category(entgql.JSONValueEQ(category.FieldStrings, *i.StringsValueEQ)))
+	}
+	if i.Slug != nil {
+		predicates = append(predicates, category.SlugEQ(*i.Slug))
+	}
+	if i.SlugNEQ != nil {
+		predicates = append(predicates, category.SlugNEQ(*i.SlugNEQ))
+	}
+	if len(i.SlugIn) > 0 {
+		predicates = append(predicates, category.SlugIn(i.SlugIn...))
+	}
+	if len(i.SlugNotIn) > 0 {
+		predicates = append(predicates, category.SlugNotIn(i.SlugNotIn...))
+	}
+	if i.SlugGT != nil {
+		predicates = append(predicates, category.SlugGT(*i.SlugGT))
+	}
+	if i.SlugGTE != nil {
+		predicates = append(predicates, category.SlugGTE(*i.SlugGTE))
+	}
+	if i.SlugLT != nil {
+		predicates = append(predicates, category.SlugLT(*i.SlugLT))
+	}
+	if i.SlugLTE != nil {
+		predicates = append(predicates, category.SlugLTE(*i.SlugLTE))
+	}
+	if i.SlugContains != nil {
+		predicates = append(predicates, category.SlugContains(*i.SlugContains))
+	}
+	if i.SlugHasPrefix != nil {
+		predicates = append(predicates, category.SlugHasPrefix(*i.SlugHasPrefix))
+	}
+	if i.SlugHasSuffix != nil {
+		predicates = append(predicates, category.SlugHasSuffix(*i.SlugHasSuffix))
+	}
+	if i.SlugIsNil {
+		predicates = append(predicates, category.SlugIsNil())
+	}
+	if i.SlugNotNil {
+		predicates = append(predicates, category.SlugNotNil())
+	}
+	if i.SlugEqualFold != nil {
+		predicates = append(predicates, category.SlugEqualFold(*i.SlugEqualFold))
+	}
+	if i.SlugContainsFold != nil {
+		predicates = append(predicates, category.SlugContainsFold(*i.SlugContainsFold))
+	}
 
 	if i.HasTodos != nil {
-		p := category.HasTodos()
+		p := category.HasTodosWith(todo.DeletedAtIsNil())
 		if !*i.HasTodos {
-			p = category.Not(p)
+			p = category.Or(category.Not(category.HasTodos()), category.Not(p))
 		}
 		predicates = append(predicates, p)
 	}
 	if len(i.HasTodosWith) > 0 {
-		with := make([]predicate.Todo, 0, len(i.HasTodosWith))
+		with := make([]predicate.Todo, 0, len(i.HasTodosWith)+1)
 		for _, w := range i.HasTodosWith {
 			p, err := w.P()
 			if err != nil {
@@ -364,6 +442,7 @@ func (i *CategoryWhereInput) P() (predicate.Category, error) {
 			}
 			with = append(with, p)
 		}
+		with = append(with, todo.DeletedAtIsNil())
 		predicates = append(predicates, category.HasTodosWith(with...))
 	}
 	switch len(predicates) {
@@ -376,6 +455,316 @@ func (i *CategoryWhereInput) P() (predicate.Category, error) {
 	}
 }
 
+// FriendshipWhereInput represents a where input for filtering Friendship queries.
+type FriendshipWhereInput struct {
+	Predicates []predicate.Friendship  `json:"-"`
+	Not        *FriendshipWhereInput   `json:"not,omitempty"`
+	Or         []*FriendshipWhereInput `json:"or,omitempty"`
+	And        []*FriendshipWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *string  `json:"id,omitempty"`
+	IDNEQ   *string  `json:"idNEQ,omitempty"`
+	IDIn    []string `json:"idIn,omitempty"`
+	IDNotIn []string `json:"idNotIn,omitempty"`
+	IDGT    *string  `json:"idGT,omitempty"`
+	IDGTE   *string  `json:"idGTE,omitempty"`
+	IDLT    *string  `json:"idLT,omitempty"`
+	IDLTE   *string  `json:"idLTE,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "user_id" field predicates.
+	UserID             *string  `json:"userID,omitempty"`
+	UserIDNEQ          *string  `json:"userIDNEQ,omitempty"`
+	UserIDIn           []string `json:"userIDIn,omitempty"`
+	UserIDNotIn        []string `json:"userIDNotIn,omitempty"`
+	UserIDGT           *string  `json:"userIDGT,omitempty"`
+	UserIDGTE          *string  `json:"userIDGTE,omitempty"`
+	UserIDLT           *string  `json:"userIDLT,omitempty"`
+	UserIDLTE          *string  `json:"userIDLTE,omitempty"`
+	UserIDContains     *string  `json:"userIDContains,omitempty"`
+	UserIDHasPrefix    *string  `json:"userIDHasPrefix,omitempty"`
+	UserIDHasSuffix    *string  `json:"userIDHasSuffix,omitempty"`
+	UserIDEqualFold    *string  `json:"userIDEqualFold,omitempty"`
+	UserIDContainsFold *string  `json:"userIDContainsFold,omitempty"`
+
+	// "friend_id" field predicates.
+	FriendID             *string  `json:"friendID,omitempty"`
+	FriendIDNEQ          *string  `json:"friendIDNEQ,omitempty"`
+	FriendIDIn           []string `json:"friendIDIn,omitempty"`
+	FriendIDNotIn        []string `json:"friendIDNotIn,omitempty"`
+	FriendIDGT           *string  `json:"friendIDGT,omitempty"`
+	FriendIDGTE          *string  `json:"friendIDGTE,omitempty"`
+	FriendIDLT           *string  `json:"friendIDLT,omitempty"`
+	FriendIDLTE          *string  `json:"friendIDLTE,omitempty"`
+	FriendIDContains     *string  `json:"friendIDContains,omitempty"`
+	FriendIDHasPrefix    *string  `json:"friendIDHasPrefix,omitempty"`
+	FriendIDHasSuffix    *string  `json:"friendIDHasSuffix,omitempty"`
+	FriendIDEqualFold    *string  `json:"friendIDEqualFold,omitempty"`
+	FriendIDContainsFold *string  `json:"friendIDContainsFold,omitempty"`
+
+	// "user" edge predicates.
+	HasUser     *bool             `json:"hasUser,omitempty"`
+	HasUserWith []*UserWhereInput `json:"hasUserWith,omitempty"`
+
+	// "friend" edge predicates.
+	HasFriend     *bool             `json:"hasFriend,omitempty"`
+	HasFriendWith []*UserWhereInput `json:"hasFriendWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *FriendshipWhereInput) AddPredicates(predicates ...predicate.Friendship) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the FriendshipWhereInput filter on the FriendshipQuery builder.
+func (i *FriendshipWhereInput) Filter(q *FriendshipQuery) (*FriendshipQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyFriendshipWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyFriendshipWhereInput is returned in case the FriendshipWhereInput is empty.
+var ErrEmptyFriendshipWhereInput = errors.New("ent: empty predicate FriendshipWhereInput")
+
+// P returns a predicate for filtering friendships.
+// An error is returned if the input is empty or invalid.
+func (i *FriendshipWhereInput) P() (predicate.Friendship, error) {
+	var predicates []predicate.Friendship
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, friendship.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Friendship, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, friendship.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Friendship, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, friendship.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, friendship.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, friendship.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, friendship.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, friendship.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, friendship.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, friendship.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, friendship.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, friendship.IDLTE(*i.IDLTE))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, friendship.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, friendship.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, friendship.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, friendship.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, friendship.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, friendship.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, friendship.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, friendship.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.UserID != nil {
+		predicates = append(predicates, friendship.UserIDEQ(*i.UserID))
+	}
+	if i.UserIDNEQ != nil {
+		predicates = append(predicates, friendship.UserIDNEQ(*i.UserIDNEQ))
+	}
+	if len(i.UserIDIn) > 0 {
+		predicates = append(predicates, friendship.UserIDIn(i.UserIDIn...))
+	}
+	if len(i.UserIDNotIn) > 0 {
+		predicates = append(predicates, friendship.UserIDNotIn(i.UserIDNotIn...))
+	}
+	if i.UserIDGT != nil {
+		predicates = append(predicates, friendship.UserIDGT(*i.UserIDGT))
+	}
+	if i.UserIDGTE != nil {
+		predicates = append(predicates, friendship.UserIDGTE(*i.UserIDGTE))
+	}
+	if i.UserIDLT != nil {
+		predicates = append(predicates, friendship.UserIDLT(*i.UserIDLT))
+	}
+	if i.UserIDLTE != nil {
+		predicates = append(predicates, friendship.UserIDLTE(*i.UserIDLTE))
+	}
+	if i.UserIDContains != nil {
+		predicates = append(predicates, friendship.UserIDContains(*i.UserIDContains))
+	}
+	if i.UserIDHasPrefix != nil {
+		predicates = append(predicates, friendship.UserIDHasPrefix(*i.UserIDHasPrefix))
+	}
+	if i.UserIDHasSuffix != nil {
+		predicates = append(predicates, friendship.UserIDHasSuffix(*i.UserIDHasSuffix))
+	}
+	if i.UserIDEqualFold != nil {
+		predicates = append(predicates, friendship.UserIDEqualFold(*i.UserIDEqualFold))
+	}
+	if i.UserIDContainsFold != nil {
+		predicates = append(predicates, friendship.UserIDContainsFold(*i.UserIDContainsFold))
+	}
+	if i.FriendID != nil {
+		predicates = append(predicates, friendship.FriendIDEQ(*i.FriendID))
+	}
+	if i.FriendIDNEQ != nil {
+		predicates = append(predicates, friendship.FriendIDNEQ(*i.FriendIDNEQ))
+	}
+	if len(i.FriendIDIn) > 0 {
+		predicates = append(predicates, friendship.FriendIDIn(i.FriendIDIn...))
+	}
+	if len(i.FriendIDNotIn) > 0 {
+		predicates = append(predicates, friendship.FriendIDNotIn(i.FriendIDNotIn...))
+	}
+	if i.FriendIDGT != nil {
+		predicates = append(predicates, friendship.FriendIDGT(*i.FriendIDGT))
+	}
+	if i.FriendIDGTE != nil {
+		predicates = append(predicates, friendship.FriendIDGTE(*i.FriendIDGTE))
+	}
+	if i.FriendIDLT != nil {
+		predicates = append(predicates, friendship.FriendIDLT(*i.FriendIDLT))
+	}
+	if i.FriendIDLTE != nil {
+		predicates = append(predicates, friendship.FriendIDLTE(*i.FriendIDLTE))
+	}
+	if i.FriendIDContains != nil {
+		predicates = append(predicates, friendship.FriendIDContains(*i.FriendIDContains))
+	}
+	if i.FriendIDHasPrefix != nil {
+		predicates = append(predicates, friendship.FriendIDHasPrefix(*i.FriendIDHasPrefix))
+	}
+	if i.FriendIDHasSuffix != nil {
+		predicates = append(predicates, friendship.FriendIDHasSuffix(*i.FriendIDHasSuffix))
+	}
+	if i.FriendIDEqualFold != nil {
+		predicates = append(predicates, friendship.FriendIDEqualFold(*i.FriendIDEqualFold))
+	}
+	if i.FriendIDContainsFold != nil {
+		predicates = append(predicates, friendship.FriendIDContainsFold(*i.FriendIDContainsFold))
+	}
+
+	if i.HasUser != nil {
+		p := friendship.HasUser()
+		if !*i.HasUser {
+			p = friendship.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasUserWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasUserWith))
+		for _, w := range i.HasUserWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasUserWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, friendship.HasUserWith(with...))
+	}
+	if i.HasFriend != nil {
+		p := friendship.HasFriend()
+		if !*i.HasFriend {
+			p = friendship.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasFriendWith) > 0 {
+		with := make([]predicate.User, 0, len(i.HasFriendWith))
+		for _, w := range i.HasFriendWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasFriendWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, friendship.HasFriendWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyFriendshipWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return friendship.And(predicates...), nil
+	}
+}
+
 // GroupWhereInput represents a where input for filtering Group queries.
 type GroupWhereInput struct {
 	Predicates []predicate.Group  `json:"-"`
@@ -812,6 +1201,45 @@ type TodoWhereInput struct {
 	TextEqualFold    *string  `json:"textEqualFold,omitempty"`
 	TextContainsFold *string  `json:"textContainsFold,omitempty"`
 
+	// "deleted_at" field predicates.
+	DeletedAt       *time.Time  `json:"deletedAt,omitempty"`
+	DeletedAtNEQ    *time.Time  `json:"deletedAtNEQ,omitempty"`
+	DeletedAtIn     []time.Time `json:"deletedAtIn,omitempty"`
+	DeletedAtNotIn  []time.Time `json:"deletedAtNotIn,omitempty"`
+	DeletedAtGT     *time.Time  `json:"deletedAtGT,omitempty"`
+	DeletedAtGTE    *time.Time  `json:"deletedAtGTE,omitempty"`
+	DeletedAtLT     *time.Time  `json:"deletedAtLT,omitempty"`
+	DeletedAtLTE    *time.Time  `json:"deletedAtLTE,omitempty"`
+	DeletedAtIsNil  bool        `json:"deletedAtIsNil,omitempty"`
+	DeletedAtNotNil bool        `json:"deletedAtNotNil,omitempty"`
+
+	// "version" field predicates.
+	Version      *int  `json:"version,omitempty"`
+	VersionNEQ   *int  `json:"versionNEQ,omitempty"`
+	VersionIn    []int `json:"versionIn,omitempty"`
+	VersionNotIn []int `json:"versionNotIn,omitempty"`
+	VersionGT    *int  `json:"versionGT,omitempty"`
+	VersionGTE   *int  `json:"versionGTE,omitempty"`
+	VersionLT    *int  `json:"versionLT,omitempty"`
+	VersionLTE   *int  `json:"versionLTE,omitempty"`
+
+	// "label" field predicates.
+	Label             *string  `json:"label,omitempty"`
+	LabelNEQ          *string  `json:"labelNEQ,omitempty"`
+	LabelIn           []string `json:"labelIn,omitempty"`
+	LabelNotIn        []string `json:"labelNotIn,omitempty"`
+	LabelGT           *string  `json:"labelGT,omitempty"`
+	LabelGTE          *string  `json:"labelGTE,omitempty"`
+	LabelLT           *string  `json:"labelLT,omitempty"`
+	LabelLTE          *string  `json:"labelLTE,omitempty"`
+	LabelContains     *string  `json:"labelContains,omitempty"`
+	LabelHasPrefix    *string  `json:"labelHasPrefix,omitempty"`
+	LabelHasSuffix    *string  `json:"labelHasSuffix,omitempty"`
+	LabelIsNil        bool     `json:"labelIsNil,omitempty"`
+	LabelNotNil       bool     `json:"labelNotNil,omitempty"`
+	LabelEqualFold    *string  `json:"labelEqualFold,omitempty"`
+	LabelContainsFold *string  `json:"labelContainsFold,omitempty"`
+
 	// "category_id" field predicates.
 	CategoryID             *bigintgql.BigInt  `json:"categoryID,omitempty"`
 	CategoryIDNEQ          *bigintgql.BigInt  `json:"categoryIDNEQ,omitempty"`
@@ -1036,6 +1464,105 @@ func (i *TodoWhereInput) P() (predicate.Todo, error) {
 	if i.TextContainsFold != nil {
 		predicates = append(predicates, todo.TextContainsFold(*i.TextContainsFold))
 	}
+	if i.DeletedAt != nil {
+		predicates = append(predicates, todo.DeletedAtEQ(*i.DeletedAt))
+	}
+	if i.DeletedAtNEQ != nil {
+		predicates = append(predicates, todo.DeletedAtNEQ(*i.DeletedAtNEQ))
+	}
+	if len(i.DeletedAtIn) > 0 {
+		predicates = append(predicates, todo.DeletedAtIn(i.DeletedAtIn...))
+	}
+	if len(i.DeletedAtNotIn) > 0 {
+		predicates = append(predicates, todo.DeletedAtNotIn(i.DeletedAtNotIn...))
+	}
+	if i.DeletedAtGT != nil {
+		predicates = append(predicates, todo.DeletedAtGT(*i.DeletedAtGT))
+	}
+	if i.DeletedAtGTE != nil {
+		predicates = append(predicates, todo.DeletedAtGTE(*i.DeletedAtGTE))
+	}
+	if i.DeletedAtLT != nil {
+		predicates = append(predicates, todo.DeletedAtLT(*i.DeletedAtLT))
+	}
+	if i.DeletedAtLTE != nil {
+		predicates = append(predicates, todo.DeletedAtLTE(*i.DeletedAtLTE))
+	}
+	if i.DeletedAtIsNil {
+		predicates = append(predicates, todo.DeletedAtIsNil())
+	}
+	if i.DeletedAtNotNil {
+		predicates = append(predicates, todo.DeletedAtNotNil())
+	}
+	if i.Version != nil {
+		predicates = append(predicates, todo.VersionEQ(*i.Version))
+	}
+	if i.VersionNEQ != nil {
+		predicates = append(predicates, todo.VersionNEQ(*i.VersionNEQ))
+	}
+	if len(i.VersionIn) > 0 {
+		predicates = append(predicates, todo.VersionIn(i.VersionIn...))
+	}
+	if len(i.VersionNotIn) > 0 {
+		predicates = append(predicates, todo.VersionNotIn(i.VersionNotIn...))
+	}
+	if i.VersionGT != nil {
+		predicates = append(predicates, todo.VersionGT(*i.VersionGT))
+	}
+	if i.VersionGTE != nil {
+		predicates = append(predicates, todo.VersionGTE(*i.VersionGTE))
+	}
+	if i.VersionLT != nil {
+		predicates = append(predicates, todo.VersionLT(*i.VersionLT))
+	}
+	if i.VersionLTE != nil {
+		predicates = append(predicates, todo.VersionLTE(*i.VersionLTE))
+	}
+	if i.Label != nil {
+		predicates = append(predicates, todo.LabelEQ(*i.Label))
+	}
+	if i.LabelNEQ != nil {
+		predicates = append(predicates, todo.LabelNEQ(*i.LabelNEQ))
+	}
+	if len(i.LabelIn) > 0 {
+		predicates = append(predicates, todo.LabelIn(i.LabelIn...))
+	}
+	if len(i.LabelNotIn) > 0 {
+		predicates = append(predicates, todo.LabelNotIn(i.LabelNotIn...))
+	}
+	if i.LabelGT != nil {
+		predicates = append(predicates, todo.LabelGT(*i.LabelGT))
+	}
+	if i.LabelGTE != nil {
+		predicates = append(predicates, todo.LabelGTE(*i.LabelGTE))
+	}
+	if i.LabelLT != nil {
+		predicates = append(predicates, todo.LabelLT(*i.LabelLT))
+	}
+	if i.LabelLTE != nil {
+		predicates = append(predicates, todo.LabelLTE(*i.LabelLTE))
+	}
+	if i.LabelContains != nil {
+		predicates = append(predicates, todo.LabelContains(*i.LabelContains))
+	}
+	if i.LabelHasPrefix != nil {
+		predicates = append(predicates, todo.LabelHasPrefix(*i.LabelHasPrefix))
+	}
+	if i.LabelHasSuffix != nil {
+		predicates = append(predicates, todo.LabelHasSuffix(*i.LabelHasSuffix))
+	}
+	if i.LabelIsNil {
+		predicates = append(predicates, todo.LabelIsNil())
+	}
+	if i.LabelNotNil {
+		predicates = append(predicates, todo.LabelNotNil())
+	}
+	if i.LabelEqualFold != nil {
+		predicates = append(predicates, todo.LabelEqualFold(*i.LabelEqualFold))
+	}
+	if i.LabelContainsFold != nil {
+		predicates = append(predicates, todo.LabelContainsFold(*i.LabelContainsFold))
+	}
 	if i.CategoryID != nil {
 		predicates = append(predicates, todo.CategoryIDEQ(*i.CategoryID))
 	}
@@ -1083,14 +1610,14 @@ func (i *TodoWhereInput) P() (predicate.Todo, error) {
 	}
 
 	if i.HasParent != nil {
-		p := todo.HasParent()
+		p := todo.HasParentWith(todo.DeletedAtIsNil())
 		if !*i.HasParent {
-			p = todo.Not(p)
+			p = todo.Or(todo.Not(todo.HasParent()), todo.Not(p))
 		}
 		predicates = append(predicates, p)
 	}
 	if len(i.HasParentWith) > 0 {
-		with := make([]predicate.Todo, 0, len(i.HasParentWith))
+		with := make([]predicate.Todo, 0, len(i.HasParentWith)+1)
 		for _, w := range i.HasParentWith {
 			p, err := w.P()
 			if err != nil {
@@ -1098,17 +1625,18 @@ func (i *TodoWhereInput) P() (predicate.Todo, error) {
 			}
 			with = append(with, p)
 		}
+		with = append(with, todo.DeletedAtIsNil())
 		predicates = append(predicates, todo.HasParentWith(with...))
 	}
 	if i.HasChildren != nil {
-		p := todo.HasChildren()
+		p := todo.HasChildrenWith(todo.DeletedAtIsNil())
 		if !*i.HasChildren {
-			p = todo.Not(p)
+			p = todo.Or(todo.Not(todo.HasChildren()), todo.Not(p))
 		}
 		predicates = append(predicates, p)
 	}
 	if len(i.HasChildrenWith) > 0 {
-		with := make([]predicate.Todo, 0, len(i.HasChildrenWith))
+		with := make([]predicate.Todo, 0, len(i.HasChildrenWith)+1)
 		for _, w := range i.HasChildrenWith {
 			p, err := w.P()
 			if err != nil {
@@ -1116,6 +1644,7 @@ func (i *TodoWhereInput) P() (predicate.Todo, error) {
 			}
 			with = append(with, p)
 		}
+		with = append(with, todo.DeletedAtIsNil())
 		predicates = append(predicates, todo.HasChildrenWith(with...))
 	}
 	if i.HasCategory != nil {
@@ -1185,6 +1714,10 @@ type UserWhereInput struct {
 	// "friends" edge predicates.
 	HasFriends     *bool             `json:"hasFriends,omitempty"`
 	HasFriendsWith []*UserWhereInput `json:"hasFriendsWith,omitempty"`
+
+	// "friendships" edge predicates.
+	HasFriendships     *bool                   `json:"hasFriendships,omitempty"`
+	HasFriendshipsWith []*FriendshipWhereInput `json:"hasFriendshipsWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
@@ -1358,6 +1891,24 @@ func (i *UserWhereInput) P() (predicate.User, error) {
 		}
 		predicates = append(predicates, user.HasFriendsWith(with...))
 	}
+	if i.HasFriendships != nil {
+		p := user.HasFriendships()
+		if !*i.HasFriendships {
+			p = user.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasFriendshipsWith) > 0 {
+		with := make([]predicate.Friendship, 0, len(i.HasFriendshipsWith))
+		for _, w := range i.HasFriendshipsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasFriendshipsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, user.HasFriendshipsWith(with...))
+	}
 	switch len(predicates) {
 	case 0:
 		return nil, ErrEmptyUserWhereInput
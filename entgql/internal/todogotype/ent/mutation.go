@@ -70,6 +70,8 @@ type CategoryMutation struct {
 	count         *uint64
 	addcount      *int64
 	strings       *[]string
+	types         **schematype.CategoryTypes
+	slug          *string
 	clearedFields map[string]struct{}
 	todos         map[string]struct{}
 	removedtodos  map[string]struct{}
@@ -493,6 +495,104 @@ func (m *CategoryMutation) ResetStrings() {
 	delete(m.clearedFields, category.FieldStrings)
 }
 
+// SetTypes sets the "types" field.
+func (m *CategoryMutation) SetTypes(st *schematype.CategoryTypes) {
+	m.types = &st
+}
+
+// Types returns the value of the "types" field in the mutation.
+func (m *CategoryMutation) Types() (r *schematype.CategoryTypes, exists bool) {
+	v := m.types
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTypes returns the old "types" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryMutation) OldTypes(ctx context.Context) (v *schematype.CategoryTypes, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTypes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTypes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTypes: %w", err)
+	}
+	return oldValue.Types, nil
+}
+
+// ClearTypes clears the value of the "types" field.
+func (m *CategoryMutation) ClearTypes() {
+	m.types = nil
+	m.clearedFields[category.FieldTypes] = struct{}{}
+}
+
+// TypesCleared returns if the "types" field was cleared in this mutation.
+func (m *CategoryMutation) TypesCleared() bool {
+	_, ok := m.clearedFields[category.FieldTypes]
+	return ok
+}
+
+// ResetTypes resets all changes to the "types" field.
+func (m *CategoryMutation) ResetTypes() {
+	m.types = nil
+	delete(m.clearedFields, category.FieldTypes)
+}
+
+// SetSlug sets the "slug" field.
+func (m *CategoryMutation) SetSlug(s string) {
+	m.slug = &s
+}
+
+// Slug returns the value of the "slug" field in the mutation.
+func (m *CategoryMutation) Slug() (r string, exists bool) {
+	v := m.slug
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlug returns the old "slug" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryMutation) OldSlug(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlug requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+	}
+	return oldValue.Slug, nil
+}
+
+// ClearSlug clears the value of the "slug" field.
+func (m *CategoryMutation) ClearSlug() {
+	m.slug = nil
+	m.clearedFields[category.FieldSlug] = struct{}{}
+}
+
+// SlugCleared returns if the "slug" field was cleared in this mutation.
+func (m *CategoryMutation) SlugCleared() bool {
+	_, ok := m.clearedFields[category.FieldSlug]
+	return ok
+}
+
+// ResetSlug resets all changes to the "slug" field.
+func (m *CategoryMutation) ResetSlug() {
+	m.slug = nil
+	delete(m.clearedFields, category.FieldSlug)
+}
+
 // AddTodoIDs adds the "todos" edge to the Todo entity by ids.
 func (m *CategoryMutation) AddTodoIDs(ids ...string) {
 	if m.todos == nil {
@@ -566,7 +666,7 @@ func (m *CategoryMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *CategoryMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+	fields := make([]string, 0, 8)
 	if m.text != nil {
 		fields = append(fields, category.FieldText)
 	}
@@ -585,6 +685,12 @@ func (m *CategoryMutation) Fields() []string {
 	if m.strings != nil {
 		fields = append(fields, category.FieldStrings)
 	}
+	if m.types != nil {
+		fields = append(fields, category.FieldTypes)
+	}
+	if m.slug != nil {
+		fields = append(fields, category.FieldSlug)
+	}
 	return fields
 }
 
@@ -605,6 +711,10 @@ func (m *CategoryMutation) Field(name string) (ent.Value, bool) {
 		return m.Count()
 	case category.FieldStrings:
 		return m.Strings()
+	case category.FieldTypes:
+		return m.Types()
+	case category.FieldSlug:
+		return m.Slug()
 	}
 	return nil, false
 }
@@ -626,6 +736,10 @@ func (m *CategoryMutation) OldField(ctx context.Context, name string) (ent.Value
 		return m.OldCount(ctx)
 	case category.FieldStrings:
 		return m.OldStrings(ctx)
+	case category.FieldTypes:
+		return m.OldTypes(ctx)
+	case category.FieldSlug:
+		return m.OldSlug(ctx)
 	}
 	return nil, fmt.Errorf("unknown Category field %s", name)
 }
@@ -677,6 +791,20 @@ func (m *CategoryMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetStrings(v)
 		return nil
+	case category.FieldTypes:
+		v, ok := value.(*schematype.CategoryTypes)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTypes(v)
+		return nil
+	case category.FieldSlug:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlug(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Category field %s", name)
 }
@@ -746,6 +874,12 @@ func (m *CategoryMutation) ClearedFields() []string {
 	if m.FieldCleared(category.FieldStrings) {
 		fields = append(fields, category.FieldStrings)
 	}
+	if m.FieldCleared(category.FieldTypes) {
+		fields = append(fields, category.FieldTypes)
+	}
+	if m.FieldCleared(category.FieldSlug) {
+		fields = append(fields, category.FieldSlug)
+	}
 	return fields
 }
 
@@ -772,6 +906,12 @@ func (m *CategoryMutation) ClearField(name string) error {
 	case category.FieldStrings:
 		m.ClearStrings()
 		return nil
+	case category.FieldTypes:
+		m.ClearTypes()
+		return nil
+	case category.FieldSlug:
+		m.ClearSlug()
+		return nil
 	}
 	return fmt.Errorf("unknown Category nullable field %s", name)
 }
@@ -798,6 +938,12 @@ func (m *CategoryMutation) ResetField(name string) error {
 	case category.FieldStrings:
 		m.ResetStrings()
 		return nil
+	case category.FieldTypes:
+		m.ResetTypes()
+		return nil
+	case category.FieldSlug:
+		m.ResetSlug()
+		return nil
 	}
 	return fmt.Errorf("unknown Category field %s", name)
 }
@@ -973,6 +1119,12 @@ func (m FriendshipMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Friendship entities.
+func (m *FriendshipMutation) SetID(id string) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
 func (m *FriendshipMutation) ID() (id string, exists bool) {
@@ -2144,6 +2296,10 @@ type TodoMutation struct {
 	addpriority     *int
 	text            *string
 	blob            *[]byte
+	deleted_at      *time.Time
+	version         *int
+	addversion      *int
+	label           *string
 	clearedFields   map[string]struct{}
 	parent          *string
 	clearedparent   bool
@@ -2476,6 +2632,160 @@ func (m *TodoMutation) ResetBlob() {
 	delete(m.clearedFields, todo.FieldBlob)
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (m *TodoMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *TodoMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Todo entity.
+// If the Todo object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TodoMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *TodoMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[todo.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *TodoMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[todo.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *TodoMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, todo.FieldDeletedAt)
+}
+
+// SetVersion sets the "version" field.
+func (m *TodoMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *TodoMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Todo entity.
+// If the Todo object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TodoMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *TodoMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *TodoMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *TodoMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetLabel sets the "label" field.
+func (m *TodoMutation) SetLabel(s string) {
+	m.label = &s
+}
+
+// Label returns the value of the "label" field in the mutation.
+func (m *TodoMutation) Label() (r string, exists bool) {
+	v := m.label
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabel returns the old "label" field's value of the Todo entity.
+// If the Todo object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TodoMutation) OldLabel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabel: %w", err)
+	}
+	return oldValue.Label, nil
+}
+
+// ClearLabel clears the value of the "label" field.
+func (m *TodoMutation) ClearLabel() {
+	m.label = nil
+	m.clearedFields[todo.FieldLabel] = struct{}{}
+}
+
+// LabelCleared returns if the "label" field was cleared in this mutation.
+func (m *TodoMutation) LabelCleared() bool {
+	_, ok := m.clearedFields[todo.FieldLabel]
+	return ok
+}
+
+// ResetLabel resets all changes to the "label" field.
+func (m *TodoMutation) ResetLabel() {
+	m.label = nil
+	delete(m.clearedFields, todo.FieldLabel)
+}
+
 // SetCategoryID sets the "category_id" field.
 func (m *TodoMutation) SetCategoryID(bi bigintgql.BigInt) {
 	m.category = &bi
@@ -2702,7 +3012,7 @@ func (m *TodoMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *TodoMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+	fields := make([]string, 0, 9)
 	if m.created_at != nil {
 		fields = append(fields, todo.FieldCreatedAt)
 	}
@@ -2718,6 +3028,15 @@ func (m *TodoMutation) Fields() []string {
 	if m.blob != nil {
 		fields = append(fields, todo.FieldBlob)
 	}
+	if m.deleted_at != nil {
+		fields = append(fields, todo.FieldDeletedAt)
+	}
+	if m.version != nil {
+		fields = append(fields, todo.FieldVersion)
+	}
+	if m.label != nil {
+		fields = append(fields, todo.FieldLabel)
+	}
 	if m.category != nil {
 		fields = append(fields, todo.FieldCategoryID)
 	}
@@ -2739,6 +3058,12 @@ func (m *TodoMutation) Field(name string) (ent.Value, bool) {
 		return m.Text()
 	case todo.FieldBlob:
 		return m.Blob()
+	case todo.FieldDeletedAt:
+		return m.DeletedAt()
+	case todo.FieldVersion:
+		return m.Version()
+	case todo.FieldLabel:
+		return m.Label()
 	case todo.FieldCategoryID:
 		return m.CategoryID()
 	}
@@ -2760,6 +3085,12 @@ func (m *TodoMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldText(ctx)
 	case todo.FieldBlob:
 		return m.OldBlob(ctx)
+	case todo.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case todo.FieldVersion:
+		return m.OldVersion(ctx)
+	case todo.FieldLabel:
+		return m.OldLabel(ctx)
 	case todo.FieldCategoryID:
 		return m.OldCategoryID(ctx)
 	}
@@ -2806,6 +3137,27 @@ func (m *TodoMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetBlob(v)
 		return nil
+	case todo.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case todo.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case todo.FieldLabel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabel(v)
+		return nil
 	case todo.FieldCategoryID:
 		v, ok := value.(bigintgql.BigInt)
 		if !ok {
@@ -2824,6 +3176,9 @@ func (m *TodoMutation) AddedFields() []string {
 	if m.addpriority != nil {
 		fields = append(fields, todo.FieldPriority)
 	}
+	if m.addversion != nil {
+		fields = append(fields, todo.FieldVersion)
+	}
 	return fields
 }
 
@@ -2834,6 +3189,8 @@ func (m *TodoMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	case todo.FieldPriority:
 		return m.AddedPriority()
+	case todo.FieldVersion:
+		return m.AddedVersion()
 	}
 	return nil, false
 }
@@ -2850,6 +3207,13 @@ func (m *TodoMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddPriority(v)
 		return nil
+	case todo.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Todo numeric field %s", name)
 }
@@ -2861,6 +3225,12 @@ func (m *TodoMutation) ClearedFields() []string {
 	if m.FieldCleared(todo.FieldBlob) {
 		fields = append(fields, todo.FieldBlob)
 	}
+	if m.FieldCleared(todo.FieldDeletedAt) {
+		fields = append(fields, todo.FieldDeletedAt)
+	}
+	if m.FieldCleared(todo.FieldLabel) {
+		fields = append(fields, todo.FieldLabel)
+	}
 	if m.FieldCleared(todo.FieldCategoryID) {
 		fields = append(fields, todo.FieldCategoryID)
 	}
@@ -2881,6 +3251,12 @@ func (m *TodoMutation) ClearField(name string) error {
 	case todo.FieldBlob:
 		m.ClearBlob()
 		return nil
+	case todo.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case todo.FieldLabel:
+		m.ClearLabel()
+		return nil
 	case todo.FieldCategoryID:
 		m.ClearCategoryID()
 		return nil
@@ -2907,6 +3283,15 @@ func (m *TodoMutation) ResetField(name string) error {
 	case todo.FieldBlob:
 		m.ResetBlob()
 		return nil
+	case todo.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case todo.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case todo.FieldLabel:
+		m.ResetLabel()
+		return nil
 	case todo.FieldCategoryID:
 		m.ResetCategoryID()
 		return nil
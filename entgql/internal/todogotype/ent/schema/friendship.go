@@ -17,7 +17,10 @@ package schema
 import (
 	"time"
 
+	"entgo.io/contrib/entgql"
+	todoschema "entgo.io/contrib/entgql/internal/todo/ent/schema"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -27,11 +30,22 @@ type Friendship struct {
 	ent.Schema
 }
 
+// Mixin returns friendship mixed-in schema.
+func (Friendship) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		todoschema.TimestampedMixin{},
+	}
+}
+
 // Fields of the Friendship.
 func (Friendship) Fields() []ent.Field {
 	return []ent.Field{
+		field.String("id"),
 		field.Time("created_at").
-			Default(time.Now),
+			Default(time.Now).
+			Annotations(
+				entgql.OrderField("CREATED_AT"),
+			),
 		field.String("user_id"),
 		field.String("friend_id"),
 	}
@@ -50,3 +64,10 @@ func (Friendship) Edges() []ent.Edge {
 			Field("friend_id"),
 	}
 }
+
+// Annotations returns Friendship annotations.
+func (Friendship) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.RelayConnection(),
+	}
+}
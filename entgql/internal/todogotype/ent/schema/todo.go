@@ -30,6 +30,7 @@ type Todo struct {
 func (Todo) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		todoschema.FilterFields(todoschema.Todo{}, "category_id"),
+		todoschema.TimestampedMixin{},
 	}
 }
 
@@ -17,6 +17,7 @@ package schema
 import (
 	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -41,6 +42,14 @@ func (User) Edges() []ent.Edge {
 		edge.To("groups", Group.Type).
 			Annotations(entgql.RelayConnection()),
 		edge.To("friends", User.Type).
-			Through("friendships", Friendship.Type),
+			Through("friendships", Friendship.Type).
+			Annotations(entgql.OffsetPagination()),
+	}
+}
+
+// Annotations returns User annotations.
+func (User) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.OffsetPagination(),
 	}
 }
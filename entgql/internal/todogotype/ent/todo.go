@@ -43,6 +43,12 @@ type Todo struct {
 	Text string `json:"text,omitempty"`
 	// Blob holds the value of the "blob" field.
 	Blob []byte `json:"blob,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// Label holds the value of the "label" field.
+	Label string `json:"label,omitempty"`
 	// CategoryID holds the value of the "category_id" field.
 	CategoryID bigintgql.BigInt `json:"category_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -67,6 +73,8 @@ type TodoEdges struct {
 	loadedTypes [4]bool
 	// totalCount holds the count of the edges above.
 	totalCount [3]*int
+	// orderValues holds the values of the ordering fields that are computed from the edges above.
+	orderValues map[string]Value
 }
 
 // ParentOrErr returns the Parent value or an error if the edge
@@ -129,11 +137,11 @@ func (*Todo) scanValues(columns []string) ([]interface{}, error) {
 			values[i] = new([]byte)
 		case todo.FieldCategoryID:
 			values[i] = new(bigintgql.BigInt)
-		case todo.FieldPriority:
+		case todo.FieldPriority, todo.FieldVersion:
 			values[i] = new(sql.NullInt64)
-		case todo.FieldID, todo.FieldStatus, todo.FieldText:
+		case todo.FieldID, todo.FieldStatus, todo.FieldText, todo.FieldLabel:
 			values[i] = new(sql.NullString)
-		case todo.FieldCreatedAt:
+		case todo.FieldCreatedAt, todo.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		case todo.ForeignKeys[0]: // todo_children
 			values[i] = new(sql.NullString)
@@ -190,6 +198,25 @@ func (t *Todo) assignValues(columns []string, values []interface{}) error {
 			} else if value != nil {
 				t.Blob = *value
 			}
+		case todo.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				t.DeletedAt = new(time.Time)
+				*t.DeletedAt = value.Time
+			}
+		case todo.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				t.Version = int(value.Int64)
+			}
+		case todo.FieldLabel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field label", values[i])
+			} else if value.Valid {
+				t.Label = value.String
+			}
 		case todo.FieldCategoryID:
 			if value, ok := values[i].(*bigintgql.BigInt); !ok {
 				return fmt.Errorf("unexpected type %T for field category_id", values[i])
@@ -273,6 +300,17 @@ func (t *Todo) String() string {
 	builder.WriteString("blob=")
 	builder.WriteString(fmt.Sprintf("%v", t.Blob))
 	builder.WriteString(", ")
+	if v := t.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", t.Version))
+	builder.WriteString(", ")
+	builder.WriteString("label=")
+	builder.WriteString(t.Label)
+	builder.WriteString(", ")
 	builder.WriteString("category_id=")
 	builder.WriteString(fmt.Sprintf("%v", t.CategoryID))
 	builder.WriteByte(')')
@@ -38,6 +38,12 @@ const (
 	FieldText = "text"
 	// FieldBlob holds the string denoting the blob field in the database.
 	FieldBlob = "blob"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldLabel holds the string denoting the label field in the database.
+	FieldLabel = "todo_label"
 	// FieldCategoryID holds the string denoting the category_id field in the database.
 	FieldCategoryID = "category_id"
 	// EdgeParent holds the string denoting the parent edge name in mutations.
@@ -82,6 +88,9 @@ var Columns = []string{
 	FieldPriority,
 	FieldText,
 	FieldBlob,
+	FieldDeletedAt,
+	FieldVersion,
+	FieldLabel,
 	FieldCategoryID,
 }
 
@@ -114,6 +123,8 @@ var (
 	DefaultPriority int
 	// TextValidator is a validator for the "text" field. It is called by the builders before save.
 	TextValidator func(string) error
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID string
 )
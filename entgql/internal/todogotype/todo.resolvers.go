@@ -24,10 +24,6 @@ import (
 	"entgo.io/contrib/entgql/internal/todogotype/ent"
 )
 
-func (r *mutationResolver) CreateTodo(ctx context.Context, input ent.CreateTodoInput) (*ent.Todo, error) {
-	panic(fmt.Errorf("not implemented"))
-}
-
 func (r *mutationResolver) ClearTodos(ctx context.Context) (int, error) {
 	panic(fmt.Errorf("not implemented"))
 }
@@ -39,8 +35,3 @@ func (r *queryResolver) Ping(ctx context.Context) (string, error) {
 func (r *todoWhereInputResolver) CreatedToday(ctx context.Context, obj *ent.TodoWhereInput, data *bool) error {
 	panic(fmt.Errorf("not implemented"))
 }
-
-// Mutation returns MutationResolver implementation.
-func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
-
-type mutationResolver struct{ *Resolver }
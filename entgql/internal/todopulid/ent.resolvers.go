@@ -26,6 +26,42 @@ import (
 	"entgo.io/contrib/entgql/internal/todopulid/ent/schema/pulid"
 )
 
+func (r *mutationResolver) CreateCategory(ctx context.Context, input ent.CreateCategoryInput) (*ent.Category, error) {
+	return r.client.MutationResolver().CreateCategory(ctx, input)
+}
+
+func (r *mutationResolver) DeleteCategory(ctx context.Context, id pulid.ID) (pulid.ID, error) {
+	return r.client.MutationResolver().DeleteCategory(ctx, id)
+}
+
+func (r *mutationResolver) UpsertCategory(ctx context.Context, input ent.UpsertCategoryInput) (*ent.Category, error) {
+	return r.client.MutationResolver().UpsertCategory(ctx, input)
+}
+
+func (r *mutationResolver) CreateTodo(ctx context.Context, input ent.CreateTodoInput) (*ent.Todo, error) {
+	return r.client.MutationResolver().CreateTodo(ctx, input)
+}
+
+func (r *mutationResolver) UpdateTodo(ctx context.Context, id pulid.ID, input ent.UpdateTodoInput) (*ent.Todo, error) {
+	return r.client.MutationResolver().UpdateTodo(ctx, id, input)
+}
+
+func (r *mutationResolver) DeleteTodo(ctx context.Context, id pulid.ID) (pulid.ID, error) {
+	return r.client.MutationResolver().DeleteTodo(ctx, id)
+}
+
+func (r *mutationResolver) CreateTodos(ctx context.Context, inputs []*ent.CreateTodoInput) ([]*ent.Todo, error) {
+	return r.client.MutationResolver().CreateTodos(ctx, inputs)
+}
+
+func (r *mutationResolver) UpdateTodos(ctx context.Context, where ent.TodoWhereInput, input ent.UpdateTodoInput) (int, error) {
+	return r.client.MutationResolver().UpdateTodos(ctx, where, input)
+}
+
+func (r *mutationResolver) DeleteTodos(ctx context.Context, where ent.TodoWhereInput) (int, error) {
+	return r.client.MutationResolver().DeleteTodos(ctx, where)
+}
+
 func (r *queryResolver) Node(ctx context.Context, id pulid.ID) (ent.Noder, error) {
 	return r.client.Noder(ctx, id, ent.WithNodeType(ent.IDToType))
 }
@@ -41,14 +77,23 @@ func (r *queryResolver) Groups(ctx context.Context, after *ent.Cursor, first *in
 		)
 }
 
-func (r *queryResolver) Todos(ctx context.Context, after *ent.Cursor, first *int, before *ent.Cursor, last *int, orderBy *ent.TodoOrder, where *ent.TodoWhereInput) (*ent.TodoConnection, error) {
+func (r *queryResolver) Todos(ctx context.Context, after *ent.Cursor, first *int, before *ent.Cursor, last *int, orderBy []*ent.TodoOrder, where *ent.TodoWhereInput, includeDeleted *bool) (*ent.TodoConnection, error) {
 	return r.client.Todo.Query().
 		Paginate(ctx, after, first, before, last,
 			ent.WithTodoOrder(orderBy),
 			ent.WithTodoFilter(where.Filter),
+			ent.WithTodoIncludeDeleted(includeDeleted),
 		)
 }
 
+func (r *queryResolver) TodosAggregate(ctx context.Context, where *ent.TodoWhereInput, groupBy []ent.TodoGroupField) ([]*ent.TodoAggregate, error) {
+	query, err := where.Filter(r.client.Todo.Query())
+	if err != nil {
+		return nil, err
+	}
+	return query.Aggregates(ctx, groupBy)
+}
+
 func (r *queryResolver) Users(ctx context.Context, after *ent.Cursor, first *int, before *ent.Cursor, last *int, where *ent.UserWhereInput) (*ent.UserConnection, error) {
 	return r.client.User.Query().
 		Paginate(ctx, after, first, before, last,
@@ -56,10 +101,37 @@ func (r *queryResolver) Users(ctx context.Context, after *ent.Cursor, first *int
 		)
 }
 
+func (r *queryResolver) UsersPage(ctx context.Context, offset *int, limit *int, where *ent.UserWhereInput) (*ent.UserPage, error) {
+	return r.client.User.Query().
+		PaginateOffset(ctx, offset, limit,
+			ent.WithUserFilter(where.Filter),
+		)
+}
+
+func (r *queryResolver) Timestamped(ctx context.Context, after *ent.Cursor, first *int, before *ent.Cursor, last *int) (*ent.TimestampedConnection, error) {
+	return r.client.PaginateTimestamped(ctx, after, first, before, last)
+}
+
+func (r *subscriptionResolver) TodoCreated(ctx context.Context) (<-chan *ent.Todo, error) {
+	return r.client.SubscriptionResolver(r.pubsub).TodoCreated(ctx)
+}
+
+func (r *subscriptionResolver) TodoUpdated(ctx context.Context, id *pulid.ID) (<-chan *ent.Todo, error) {
+	return r.client.SubscriptionResolver(r.pubsub).TodoUpdated(ctx, id)
+}
+
+func (r *subscriptionResolver) TodoDeleted(ctx context.Context) (<-chan pulid.ID, error) {
+	return r.client.SubscriptionResolver(r.pubsub).TodoDeleted(ctx)
+}
+
 func (r *todoResolver) Status(ctx context.Context, obj *ent.Todo) (todo.Status, error) {
 	panic(fmt.Errorf("not implemented"))
 }
 
+func (r *todoAggregateResolver) Status(ctx context.Context, obj *ent.TodoAggregate) (*todo.Status, error) {
+	panic(fmt.Errorf("not implemented"))
+}
+
 func (r *createTodoInputResolver) Status(ctx context.Context, obj *ent.CreateTodoInput, data todo.Status) error {
 	panic(fmt.Errorf("not implemented"))
 }
@@ -80,19 +152,39 @@ func (r *todoWhereInputResolver) StatusNotIn(ctx context.Context, obj *ent.TodoW
 	panic(fmt.Errorf("not implemented"))
 }
 
+func (r *updateTodoInputResolver) Status(ctx context.Context, obj *ent.UpdateTodoInput, data *todo.Status) error {
+	panic(fmt.Errorf("not implemented"))
+}
+
+// Mutation returns MutationResolver implementation.
+func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
+
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// Subscription returns SubscriptionResolver implementation.
+func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
+
 // Todo returns TodoResolver implementation.
 func (r *Resolver) Todo() TodoResolver { return &todoResolver{r} }
 
+// TodoAggregate returns TodoAggregateResolver implementation.
+func (r *Resolver) TodoAggregate() TodoAggregateResolver { return &todoAggregateResolver{r} }
+
 // CreateTodoInput returns CreateTodoInputResolver implementation.
 func (r *Resolver) CreateTodoInput() CreateTodoInputResolver { return &createTodoInputResolver{r} }
 
 // TodoWhereInput returns TodoWhereInputResolver implementation.
 func (r *Resolver) TodoWhereInput() TodoWhereInputResolver { return &todoWhereInputResolver{r} }
 
+// UpdateTodoInput returns UpdateTodoInputResolver implementation.
+func (r *Resolver) UpdateTodoInput() UpdateTodoInputResolver { return &updateTodoInputResolver{r} }
+
+type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
 type todoResolver struct{ *Resolver }
+type todoAggregateResolver struct{ *Resolver }
 type createTodoInputResolver struct{ *Resolver }
 type todoWhereInputResolver struct{ *Resolver }
+type updateTodoInputResolver struct{ *Resolver }
@@ -45,6 +45,10 @@ type Category struct {
 	Count uint64 `json:"count,omitempty"`
 	// Strings holds the value of the "strings" field.
 	Strings []string `json:"strings,omitempty"`
+	// Types holds the value of the "types" field.
+	Types *schematype.CategoryTypes `json:"types,omitempty"`
+	// Slug holds the value of the "slug" field.
+	Slug string `json:"slug,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CategoryQuery when eager-loading is set.
 	Edges CategoryEdges `json:"edges"`
@@ -75,7 +79,7 @@ func (*Category) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case category.FieldStrings:
+		case category.FieldStrings, category.FieldTypes:
 			values[i] = new([]byte)
 		case category.FieldID:
 			values[i] = new(pulid.ID)
@@ -83,7 +87,7 @@ func (*Category) scanValues(columns []string) ([]interface{}, error) {
 			values[i] = new(schematype.CategoryConfig)
 		case category.FieldDuration, category.FieldCount:
 			values[i] = new(sql.NullInt64)
-		case category.FieldText, category.FieldStatus:
+		case category.FieldText, category.FieldStatus, category.FieldSlug:
 			values[i] = new(sql.NullString)
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type Category", columns[i])
@@ -144,6 +148,20 @@ func (c *Category) assignValues(columns []string, values []interface{}) error {
 					return fmt.Errorf("unmarshal field strings: %w", err)
 				}
 			}
+		case category.FieldTypes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field types", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.Types); err != nil {
+					return fmt.Errorf("unmarshal field types: %w", err)
+				}
+			}
+		case category.FieldSlug:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slug", values[i])
+			} else if value.Valid {
+				c.Slug = value.String
+			}
 		}
 	}
 	return nil
@@ -194,6 +212,12 @@ func (c *Category) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("strings=")
 	builder.WriteString(fmt.Sprintf("%v", c.Strings))
+	builder.WriteString(", ")
+	builder.WriteString("types=")
+	builder.WriteString(fmt.Sprintf("%v", c.Types))
+	builder.WriteString(", ")
+	builder.WriteString("slug=")
+	builder.WriteString(c.Slug)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -41,6 +41,10 @@ const (
 	FieldCount = "count"
 	// FieldStrings holds the string denoting the strings field in the database.
 	FieldStrings = "strings"
+	// FieldTypes holds the string denoting the types field in the database.
+	FieldTypes = "types"
+	// FieldSlug holds the string denoting the slug field in the database.
+	FieldSlug = "slug"
 	// EdgeTodos holds the string denoting the todos edge name in mutations.
 	EdgeTodos = "todos"
 	// Table holds the table name of the category in the database.
@@ -63,6 +67,8 @@ var Columns = []string{
 	FieldDuration,
 	FieldCount,
 	FieldStrings,
+	FieldTypes,
+	FieldSlug,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -26,6 +26,8 @@ import (
 	"entgo.io/contrib/entgql/internal/todopulid/ent/category"
 	"entgo.io/contrib/entgql/internal/todopulid/ent/schema/pulid"
 	"entgo.io/contrib/entgql/internal/todopulid/ent/todo"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -35,6 +37,7 @@ type CategoryCreate struct {
 	config
 	mutation *CategoryMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetText sets the "text" field.
@@ -89,6 +92,26 @@ func (cc *CategoryCreate) SetStrings(s []string) *CategoryCreate {
 	return cc
 }
 
+// SetTypes sets the "types" field.
+func (cc *CategoryCreate) SetTypes(st *schematype.CategoryTypes) *CategoryCreate {
+	cc.mutation.SetTypes(st)
+	return cc
+}
+
+// SetSlug sets the "slug" field.
+func (cc *CategoryCreate) SetSlug(s string) *CategoryCreate {
+	cc.mutation.SetSlug(s)
+	return cc
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (cc *CategoryCreate) SetNillableSlug(s *string) *CategoryCreate {
+	if s != nil {
+		cc.SetSlug(*s)
+	}
+	return cc
+}
+
 // SetID sets the "id" field.
 func (cc *CategoryCreate) SetID(pu pulid.ID) *CategoryCreate {
 	cc.mutation.SetID(pu)
@@ -251,6 +274,7 @@ func (cc *CategoryCreate) createSpec() (*Category, *sqlgraph.CreateSpec) {
 			},
 		}
 	)
+	_spec.OnConflict = cc.conflict
 	if id, ok := cc.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -303,6 +327,22 @@ func (cc *CategoryCreate) createSpec() (*Category, *sqlgraph.CreateSpec) {
 		})
 		_node.Strings = value
 	}
+	if value, ok := cc.mutation.Types(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: category.FieldTypes,
+		})
+		_node.Types = value
+	}
+	if value, ok := cc.mutation.Slug(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: category.FieldSlug,
+		})
+		_node.Slug = value
+	}
 	if nodes := cc.mutation.TodosIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -325,10 +365,462 @@ func (cc *CategoryCreate) createSpec() (*Category, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Category.Create().
+//		SetText(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CategoryUpsert) {
+//			SetText(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (cc *CategoryCreate) OnConflict(opts ...sql.ConflictOption) *CategoryUpsertOne {
+	cc.conflict = opts
+	return &CategoryUpsertOne{
+		create: cc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Category.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (cc *CategoryCreate) OnConflictColumns(columns ...string) *CategoryUpsertOne {
+	cc.conflict = append(cc.conflict, sql.ConflictColumns(columns...))
+	return &CategoryUpsertOne{
+		create: cc,
+	}
+}
+
+type (
+	// CategoryUpsertOne is the builder for "upsert"-ing
+	//  one Category node.
+	CategoryUpsertOne struct {
+		create *CategoryCreate
+	}
+
+	// CategoryUpsert is the "OnConflict" setter.
+	CategoryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetText sets the "text" field.
+func (u *CategoryUpsert) SetText(v string) *CategoryUpsert {
+	u.Set(category.FieldText, v)
+	return u
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *CategoryUpsert) UpdateText() *CategoryUpsert {
+	u.SetExcluded(category.FieldText)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *CategoryUpsert) SetStatus(v category.Status) *CategoryUpsert {
+	u.Set(category.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CategoryUpsert) UpdateStatus() *CategoryUpsert {
+	u.SetExcluded(category.FieldStatus)
+	return u
+}
+
+// SetConfig sets the "config" field.
+func (u *CategoryUpsert) SetConfig(v *schematype.CategoryConfig) *CategoryUpsert {
+	u.Set(category.FieldConfig, v)
+	return u
+}
+
+// UpdateConfig sets the "config" field to the value that was provided on create.
+func (u *CategoryUpsert) UpdateConfig() *CategoryUpsert {
+	u.SetExcluded(category.FieldConfig)
+	return u
+}
+
+// ClearConfig clears the value of the "config" field.
+func (u *CategoryUpsert) ClearConfig() *CategoryUpsert {
+	u.SetNull(category.FieldConfig)
+	return u
+}
+
+// SetDuration sets the "duration" field.
+func (u *CategoryUpsert) SetDuration(v time.Duration) *CategoryUpsert {
+	u.Set(category.FieldDuration, v)
+	return u
+}
+
+// UpdateDuration sets the "duration" field to the value that was provided on create.
+func (u *CategoryUpsert) UpdateDuration() *CategoryUpsert {
+	u.SetExcluded(category.FieldDuration)
+	return u
+}
+
+// AddDuration adds v to the "duration" field.
+func (u *CategoryUpsert) AddDuration(v time.Duration) *CategoryUpsert {
+	u.Add(category.FieldDuration, v)
+	return u
+}
+
+// ClearDuration clears the value of the "duration" field.
+func (u *CategoryUpsert) ClearDuration() *CategoryUpsert {
+	u.SetNull(category.FieldDuration)
+	return u
+}
+
+// SetCount sets the "count" field.
+func (u *CategoryUpsert) SetCount(v uint64) *CategoryUpsert {
+	u.Set(category.FieldCount, v)
+	return u
+}
+
+// UpdateCount sets the "count" field to the value that was provided on create.
+func (u *CategoryUpsert) UpdateCount() *CategoryUpsert {
+	u.SetExcluded(category.FieldCount)
+	return u
+}
+
+// AddCount adds v to the "count" field.
+func (u *CategoryUpsert) AddCount(v uint64) *CategoryUpsert {
+	u.Add(category.FieldCount, v)
+	return u
+}
+
+// ClearCount clears the value of the "count" field.
+func (u *CategoryUpsert) ClearCount() *CategoryUpsert {
+	u.SetNull(category.FieldCount)
+	return u
+}
+
+// SetStrings sets the "strings" field.
+func (u *CategoryUpsert) SetStrings(v []string) *CategoryUpsert {
+	u.Set(category.FieldStrings, v)
+	return u
+}
+
+// UpdateStrings sets the "strings" field to the value that was provided on create.
+func (u *CategoryUpsert) UpdateStrings() *CategoryUpsert {
+	u.SetExcluded(category.FieldStrings)
+	return u
+}
+
+// ClearStrings clears the value of the "strings" field.
+func (u *CategoryUpsert) ClearStrings() *CategoryUpsert {
+	u.SetNull(category.FieldStrings)
+	return u
+}
+
+// SetTypes sets the "types" field.
+func (u *CategoryUpsert) SetTypes(v *schematype.CategoryTypes) *CategoryUpsert {
+	u.Set(category.FieldTypes, v)
+	return u
+}
+
+// UpdateTypes sets the "types" field to the value that was provided on create.
+func (u *CategoryUpsert) UpdateTypes() *CategoryUpsert {
+	u.SetExcluded(category.FieldTypes)
+	return u
+}
+
+// ClearTypes clears the value of the "types" field.
+func (u *CategoryUpsert) ClearTypes() *CategoryUpsert {
+	u.SetNull(category.FieldTypes)
+	return u
+}
+
+// SetSlug sets the "slug" field.
+func (u *CategoryUpsert) SetSlug(v string) *CategoryUpsert {
+	u.Set(category.FieldSlug, v)
+	return u
+}
+
+// UpdateSlug sets the "slug" field to the value that was provided on create.
+func (u *CategoryUpsert) UpdateSlug() *CategoryUpsert {
+	u.SetExcluded(category.FieldSlug)
+	return u
+}
+
+// ClearSlug clears the value of the "slug" field.
+func (u *CategoryUpsert) ClearSlug() *CategoryUpsert {
+	u.SetNull(category.FieldSlug)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Category.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(category.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+//
+func (u *CategoryUpsertOne) UpdateNewValues() *CategoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(category.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//  client.Category.Create().
+//      OnConflict(sql.ResolveWithIgnore()).
+//      Exec(ctx)
+//
+func (u *CategoryUpsertOne) Ignore() *CategoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CategoryUpsertOne) DoNothing() *CategoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CategoryCreate.OnConflict
+// documentation for more info.
+func (u *CategoryUpsertOne) Update(set func(*CategoryUpsert)) *CategoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CategoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetText sets the "text" field.
+func (u *CategoryUpsertOne) SetText(v string) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetText(v)
+	})
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateText() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateText()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CategoryUpsertOne) SetStatus(v category.Status) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateStatus() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetConfig sets the "config" field.
+func (u *CategoryUpsertOne) SetConfig(v *schematype.CategoryConfig) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetConfig(v)
+	})
+}
+
+// UpdateConfig sets the "config" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateConfig() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateConfig()
+	})
+}
+
+// ClearConfig clears the value of the "config" field.
+func (u *CategoryUpsertOne) ClearConfig() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearConfig()
+	})
+}
+
+// SetDuration sets the "duration" field.
+func (u *CategoryUpsertOne) SetDuration(v time.Duration) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetDuration(v)
+	})
+}
+
+// AddDuration adds v to the "duration" field.
+func (u *CategoryUpsertOne) AddDuration(v time.Duration) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.AddDuration(v)
+	})
+}
+
+// UpdateDuration sets the "duration" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateDuration() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateDuration()
+	})
+}
+
+// ClearDuration clears the value of the "duration" field.
+func (u *CategoryUpsertOne) ClearDuration() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearDuration()
+	})
+}
+
+// SetCount sets the "count" field.
+func (u *CategoryUpsertOne) SetCount(v uint64) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetCount(v)
+	})
+}
+
+// AddCount adds v to the "count" field.
+func (u *CategoryUpsertOne) AddCount(v uint64) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.AddCount(v)
+	})
+}
+
+// UpdateCount sets the "count" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateCount() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateCount()
+	})
+}
+
+// ClearCount clears the value of the "count" field.
+func (u *CategoryUpsertOne) ClearCount() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearCount()
+	})
+}
+
+// SetStrings sets the "strings" field.
+func (u *CategoryUpsertOne) SetStrings(v []string) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetStrings(v)
+	})
+}
+
+// UpdateStrings sets the "strings" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateStrings() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateStrings()
+	})
+}
+
+// ClearStrings clears the value of the "strings" field.
+func (u *CategoryUpsertOne) ClearStrings() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearStrings()
+	})
+}
+
+// SetTypes sets the "types" field.
+func (u *CategoryUpsertOne) SetTypes(v *schematype.CategoryTypes) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetTypes(v)
+	})
+}
+
+// UpdateTypes sets the "types" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateTypes() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateTypes()
+	})
+}
+
+// ClearTypes clears the value of the "types" field.
+func (u *CategoryUpsertOne) ClearTypes() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearTypes()
+	})
+}
+
+// SetSlug sets the "slug" field.
+func (u *CategoryUpsertOne) SetSlug(v string) *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetSlug(v)
+	})
+}
+
+// UpdateSlug sets the "slug" field to the value that was provided on create.
+func (u *CategoryUpsertOne) UpdateSlug() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateSlug()
+	})
+}
+
+// ClearSlug clears the value of the "slug" field.
+func (u *CategoryUpsertOne) ClearSlug() *CategoryUpsertOne {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearSlug()
+	})
+}
+
+// Exec executes the query.
+func (u *CategoryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CategoryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CategoryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CategoryUpsertOne) ID(ctx context.Context) (id pulid.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CategoryUpsertOne.ID is not supported by MySQL driver. Use CategoryUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CategoryUpsertOne) IDX(ctx context.Context) pulid.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // CategoryCreateBulk is the builder for creating many Category entities in bulk.
 type CategoryCreateBulk struct {
 	config
 	builders []*CategoryCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Category entities in the database.
@@ -355,6 +847,7 @@ func (ccb *CategoryCreateBulk) Save(ctx context.Context) ([]*Category, error) {
 					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ccb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -404,3 +897,287 @@ func (ccb *CategoryCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Category.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CategoryUpsert) {
+//			SetText(v+v).
+//		}).
+//		Exec(ctx)
+//
+func (ccb *CategoryCreateBulk) OnConflict(opts ...sql.ConflictOption) *CategoryUpsertBulk {
+	ccb.conflict = opts
+	return &CategoryUpsertBulk{
+		create: ccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Category.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+//
+func (ccb *CategoryCreateBulk) OnConflictColumns(columns ...string) *CategoryUpsertBulk {
+	ccb.conflict = append(ccb.conflict, sql.ConflictColumns(columns...))
+	return &CategoryUpsertBulk{
+		create: ccb,
+	}
+}
+
+// CategoryUpsertBulk is the builder for "upsert"-ing
+// a bulk of Category nodes.
+type CategoryUpsertBulk struct {
+	create *CategoryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Category.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(category.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+//
+func (u *CategoryUpsertBulk) UpdateNewValues() *CategoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(category.FieldID)
+				return
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Category.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+//
+func (u *CategoryUpsertBulk) Ignore() *CategoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CategoryUpsertBulk) DoNothing() *CategoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CategoryCreateBulk.OnConflict
+// documentation for more info.
+func (u *CategoryUpsertBulk) Update(set func(*CategoryUpsert)) *CategoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CategoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetText sets the "text" field.
+func (u *CategoryUpsertBulk) SetText(v string) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetText(v)
+	})
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *CategoryUpsertBulk) UpdateText() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateText()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CategoryUpsertBulk) SetStatus(v category.Status) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CategoryUpsertBulk) UpdateStatus() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetConfig sets the "config" field.
+func (u *CategoryUpsertBulk) SetConfig(v *schematype.CategoryConfig) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetConfig(v)
+	})
+}
+
+// UpdateConfig sets the "config" field to the value that was provided on create.
+func (u *CategoryUpsertBulk) UpdateConfig() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateConfig()
+	})
+}
+
+// ClearConfig clears the value of the "config" field.
+func (u *CategoryUpsertBulk) ClearConfig() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearConfig()
+	})
+}
+
+// SetDuration sets the "duration" field.
+func (u *CategoryUpsertBulk) SetDuration(v time.Duration) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetDuration(v)
+	})
+}
+
+// AddDuration adds v to the "duration" field.
+func (u *CategoryUpsertBulk) AddDuration(v time.Duration) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.AddDuration(v)
+	})
+}
+
+// UpdateDuration sets the "duration" field to the value that was provided on create.
+func (u *CategoryUpsertBulk) UpdateDuration() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateDuration()
+	})
+}
+
+// ClearDuration clears the value of the "duration" field.
+func (u *CategoryUpsertBulk) ClearDuration() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearDuration()
+	})
+}
+
+// SetCount sets the "count" field.
+func (u *CategoryUpsertBulk) SetCount(v uint64) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetCount(v)
+	})
+}
+
+// AddCount adds v to the "count" field.
+func (u *CategoryUpsertBulk) AddCount(v uint64) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.AddCount(v)
+	})
+}
+
+// UpdateCount sets the "count" field to the value that was provided on create.
+func (u *CategoryUpsertBulk) UpdateCount() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateCount()
+	})
+}
+
+// ClearCount clears the value of the "count" field.
+func (u *CategoryUpsertBulk) ClearCount() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearCount()
+	})
+}
+
+// SetStrings sets the "strings" field.
+func (u *CategoryUpsertBulk) SetStrings(v []string) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetStrings(v)
+	})
+}
+
+// UpdateStrings sets the "strings" field to the value that was provided on create.
+func (u *CategoryUpsertBulk) UpdateStrings() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateStrings()
+	})
+}
+
+// ClearStrings clears the value of the "strings" field.
+func (u *CategoryUpsertBulk) ClearStrings() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearStrings()
+	})
+}
+
+// SetTypes sets the "types" field.
+func (u *CategoryUpsertBulk) SetTypes(v *schematype.CategoryTypes) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetTypes(v)
+	})
+}
+
+// UpdateTypes sets the "types" field to the value that was provided on create.
+func (u *CategoryUpsertBulk) UpdateTypes() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateTypes()
+	})
+}
+
+// ClearTypes clears the value of the "types" field.
+func (u *CategoryUpsertBulk) ClearTypes() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearTypes()
+	})
+}
+
+// SetSlug sets the "slug" field.
+func (u *CategoryUpsertBulk) SetSlug(v string) *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.SetSlug(v)
+	})
+}
+
+// UpdateSlug sets the "slug" field to the value that was provided on create.
+func (u *CategoryUpsertBulk) UpdateSlug() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.UpdateSlug()
+	})
+}
+
+// ClearSlug clears the value of the "slug" field.
+func (u *CategoryUpsertBulk) ClearSlug() *CategoryUpsertBulk {
+	return u.Update(func(s *CategoryUpsert) {
+		s.ClearSlug()
+	})
+}
+
+// Exec executes the query.
+func (u *CategoryUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CategoryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CategoryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CategoryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
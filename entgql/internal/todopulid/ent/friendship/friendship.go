@@ -18,6 +18,8 @@ package friendship
 
 import (
 	"time"
+
+	"entgo.io/contrib/entgql/internal/todopulid/ent/schema/pulid"
 )
 
 const (
@@ -74,4 +76,6 @@ func ValidColumn(column string) bool {
 var (
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() pulid.ID
 )
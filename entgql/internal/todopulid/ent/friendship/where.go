@@ -26,28 +26,28 @@ import (
 )
 
 // ID filters vertices based on their ID field.
-func ID(id int) predicate.Friendship {
+func ID(id pulid.ID) predicate.Friendship {
 	return predicate.Friendship(func(s *sql.Selector) {
 		s.Where(sql.EQ(s.C(FieldID), id))
 	})
 }
 
 // IDEQ applies the EQ predicate on the ID field.
-func IDEQ(id int) predicate.Friendship {
+func IDEQ(id pulid.ID) predicate.Friendship {
 	return predicate.Friendship(func(s *sql.Selector) {
 		s.Where(sql.EQ(s.C(FieldID), id))
 	})
 }
 
 // IDNEQ applies the NEQ predicate on the ID field.
-func IDNEQ(id int) predicate.Friendship {
+func IDNEQ(id pulid.ID) predicate.Friendship {
 	return predicate.Friendship(func(s *sql.Selector) {
 		s.Where(sql.NEQ(s.C(FieldID), id))
 	})
 }
 
 // IDIn applies the In predicate on the ID field.
-func IDIn(ids ...int) predicate.Friendship {
+func IDIn(ids ...pulid.ID) predicate.Friendship {
 	return predicate.Friendship(func(s *sql.Selector) {
 		// if not arguments were provided, append the FALSE constants,
 		// since we can't apply "IN ()". This will make this predicate falsy.
@@ -64,7 +64,7 @@ func IDIn(ids ...int) predicate.Friendship {
 }
 
 // IDNotIn applies the NotIn predicate on the ID field.
-func IDNotIn(ids ...int) predicate.Friendship {
+func IDNotIn(ids ...pulid.ID) predicate.Friendship {
 	return predicate.Friendship(func(s *sql.Selector) {
 		// if not arguments were provided, append the FALSE constants,
 		// since we can't apply "IN ()". This will make this predicate falsy.
@@ -81,28 +81,28 @@ func IDNotIn(ids ...int) predicate.Friendship {
 }
 
 // IDGT applies the GT predicate on the ID field.
-func IDGT(id int) predicate.Friendship {
+func IDGT(id pulid.ID) predicate.Friendship {
 	return predicate.Friendship(func(s *sql.Selector) {
 		s.Where(sql.GT(s.C(FieldID), id))
 	})
 }
 
 // IDGTE applies the GTE predicate on the ID field.
-func IDGTE(id int) predicate.Friendship {
+func IDGTE(id pulid.ID) predicate.Friendship {
 	return predicate.Friendship(func(s *sql.Selector) {
 		s.Where(sql.GTE(s.C(FieldID), id))
 	})
 }
 
 // IDLT applies the LT predicate on the ID field.
-func IDLT(id int) predicate.Friendship {
+func IDLT(id pulid.ID) predicate.Friendship {
 	return predicate.Friendship(func(s *sql.Selector) {
 		s.Where(sql.LT(s.C(FieldID), id))
 	})
 }
 
 // IDLTE applies the LTE predicate on the ID field.
-func IDLTE(id int) predicate.Friendship {
+func IDLTE(id pulid.ID) predicate.Friendship {
 	return predicate.Friendship(func(s *sql.Selector) {
 		s.Where(sql.LTE(s.C(FieldID), id))
 	})
@@ -22,6 +22,7 @@ import (
 	"fmt"
 
 	"entgo.io/contrib/entgql/internal/todopulid/ent/category"
+	"entgo.io/contrib/entgql/internal/todopulid/ent/friendship"
 	"entgo.io/contrib/entgql/internal/todopulid/ent/group"
 	"entgo.io/contrib/entgql/internal/todopulid/ent/schema/pulid"
 	"entgo.io/contrib/entgql/internal/todopulid/ent/todo"
@@ -30,7 +31,8 @@ import (
 	"github.com/99designs/gqlgen/graphql"
 )
 
-// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context,
+// and to select only the columns of the requested fields.
 func (c *CategoryQuery) CollectFields(ctx context.Context, satisfies ...string) (*CategoryQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
 	if fc == nil {
@@ -44,9 +46,15 @@ func (c *CategoryQuery) CollectFields(ctx context.Context, satisfies ...string)
 
 func (c *CategoryQuery) collectField(ctx context.Context, op *graphql.OperationContext, field graphql.CollectedField, path []string, satisfies ...string) error {
 	path = append([]string(nil), path...)
+	var (
+		collected      bool
+		fieldSeen      = make(map[string]struct{}, len(category.Columns))
+		selectedFields = []string{category.FieldID, category.FieldText, category.FieldDuration}
+	)
 	for _, field := range graphql.CollectFields(op, field.Selections, satisfies) {
 		switch field.Name {
 		case "todos":
+			collected = true
 			var (
 				path  = append(path, field.Name)
 				query = &TodoQuery{config: c.config}
@@ -59,7 +67,7 @@ func (c *CategoryQuery) collectField(ctx context.Context, op *graphql.OperationC
 			if err != nil {
 				return fmt.Errorf("create new pager in path %q: %w", path, err)
 			}
-			if query, err = pager.applyFilter(query); err != nil {
+			if query, err = pager.applyFilter(ctx, query); err != nil {
 				return err
 			}
 			if !hasCollectedField(ctx, append(path, edgesField)...) || args.first != nil && *args.first == 0 || args.last != nil && *args.last == 0 {
@@ -130,6 +138,7 @@ func (c *CategoryQuery) collectField(ctx context.Context, op *graphql.OperationC
 				})
 			}
 			query = pager.applyCursors(query, args.after, args.before)
+			query = pager.applyOrderValues(query)
 			if limit := paginateLimit(args.first, args.last); limit > 0 {
 				modify := limitRows(category.TodosColumn, limit, pager.orderExpr(args.last != nil))
 				query.modifiers = append(query.modifiers, modify)
@@ -143,8 +152,49 @@ func (c *CategoryQuery) collectField(ctx context.Context, op *graphql.OperationC
 				}
 			}
 			c.withTodos = query
+		case "status":
+			if _, ok := fieldSeen[category.FieldStatus]; !ok {
+				selectedFields = append(selectedFields, category.FieldStatus)
+				fieldSeen[category.FieldStatus] = struct{}{}
+			}
+			collected = true
+		case "config":
+			if _, ok := fieldSeen[category.FieldConfig]; !ok {
+				selectedFields = append(selectedFields, category.FieldConfig)
+				fieldSeen[category.FieldConfig] = struct{}{}
+			}
+			collected = true
+		case "count":
+			if _, ok := fieldSeen[category.FieldCount]; !ok {
+				selectedFields = append(selectedFields, category.FieldCount)
+				fieldSeen[category.FieldCount] = struct{}{}
+			}
+			collected = true
+		case "strings":
+			if _, ok := fieldSeen[category.FieldStrings]; !ok {
+				selectedFields = append(selectedFields, category.FieldStrings)
+				fieldSeen[category.FieldStrings] = struct{}{}
+			}
+			collected = true
+		case "types":
+			if _, ok := fieldSeen[category.FieldTypes]; !ok {
+				selectedFields = append(selectedFields, category.FieldTypes)
+				fieldSeen[category.FieldTypes] = struct{}{}
+			}
+			collected = true
+		case "slug":
+			if _, ok := fieldSeen[category.FieldSlug]; !ok {
+				selectedFields = append(selectedFields, category.FieldSlug)
+				fieldSeen[category.FieldSlug] = struct{}{}
+			}
+			collected = true
+		case "id":
+			collected = true
 		}
 	}
+	if collected {
+		c.Select(selectedFields...)
+	}
 	return nil
 }
 
@@ -174,17 +224,7 @@ func newCategoryPaginateArgs(rv map[string]interface{}) *categoryPaginateArgs {
 	if v, ok := rv[orderByField]; ok {
 		switch v := v.(type) {
 		case map[string]interface{}:
-			var (
-				err1, err2 error
-				order      = &CategoryOrder{Field: &CategoryOrderField{}}
-			)
-			if d, ok := v[directionField]; ok {
-				err1 = order.Direction.UnmarshalGQL(d)
-			}
-			if f, ok := v[fieldField]; ok {
-				err2 = order.Field.UnmarshalGQL(f)
-			}
-			if err1 == nil && err2 == nil {
+			if order, ok := unmarshalCategoryOrder(v); ok {
 				args.opts = append(args.opts, WithCategoryOrder(order))
 			}
 		case *CategoryOrder:
@@ -199,7 +239,128 @@ func newCategoryPaginateArgs(rv map[string]interface{}) *categoryPaginateArgs {
 	return args
 }
 
-// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func unmarshalCategoryOrder(v map[string]interface{}) (*CategoryOrder, bool) {
+	var (
+		err1, err2 error
+		order      = &CategoryOrder{Direction: OrderDirectionAsc, Field: &CategoryOrderField{}}
+	)
+	if d, ok := v[directionField]; ok {
+		err1 = order.Direction.UnmarshalGQL(d)
+	}
+	if f, ok := v[fieldField]; ok {
+		err2 = order.Field.UnmarshalGQL(f)
+	}
+	return order, err1 == nil && err2 == nil
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context,
+// and to select only the columns of the requested fields.
+func (f *FriendshipQuery) CollectFields(ctx context.Context, satisfies ...string) (*FriendshipQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return f, nil
+	}
+	if err := f.collectField(ctx, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *FriendshipQuery) collectField(ctx context.Context, op *graphql.OperationContext, field graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		collected      bool
+		selectedFields = []string{friendship.FieldID, friendship.FieldCreatedAt, friendship.FieldUserID, friendship.FieldFriendID}
+	)
+	for _, field := range graphql.CollectFields(op, field.Selections, satisfies) {
+		switch field.Name {
+		case "user":
+			collected = true
+			var (
+				path  = append(path, field.Name)
+				query = &UserQuery{config: f.config}
+			)
+			if err := query.collectField(ctx, op, field, path, satisfies...); err != nil {
+				return err
+			}
+			f.withUser = query
+		case "friend":
+			collected = true
+			var (
+				path  = append(path, field.Name)
+				query = &UserQuery{config: f.config}
+			)
+			if err := query.collectField(ctx, op, field, path, satisfies...); err != nil {
+				return err
+			}
+			f.withFriend = query
+		case "id":
+			collected = true
+		}
+	}
+	if collected {
+		f.Select(selectedFields...)
+	}
+	return nil
+}
+
+type friendshipPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []FriendshipPaginateOption
+}
+
+func newFriendshipPaginateArgs(rv map[string]interface{}) *friendshipPaginateArgs {
+	args := &friendshipPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			if order, ok := unmarshalFriendshipOrder(v); ok {
+				args.opts = append(args.opts, WithFriendshipOrder(order))
+			}
+		case *FriendshipOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithFriendshipOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*FriendshipWhereInput); ok {
+		args.opts = append(args.opts, WithFriendshipFilter(v.Filter))
+	}
+	return args
+}
+
+func unmarshalFriendshipOrder(v map[string]interface{}) (*FriendshipOrder, bool) {
+	var (
+		err1, err2 error
+		order      = &FriendshipOrder{Direction: OrderDirectionAsc, Field: &FriendshipOrderField{}}
+	)
+	if d, ok := v[directionField]; ok {
+		err1 = order.Direction.UnmarshalGQL(d)
+	}
+	if f, ok := v[fieldField]; ok {
+		err2 = order.Field.UnmarshalGQL(f)
+	}
+	return order, err1 == nil && err2 == nil
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context,
+// and to select only the columns of the requested fields.
 func (gr *GroupQuery) CollectFields(ctx context.Context, satisfies ...string) (*GroupQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
 	if fc == nil {
@@ -213,9 +374,15 @@ func (gr *GroupQuery) CollectFields(ctx context.Context, satisfies ...string) (*
 
 func (gr *GroupQuery) collectField(ctx context.Context, op *graphql.OperationContext, field graphql.CollectedField, path []string, satisfies ...string) error {
 	path = append([]string(nil), path...)
+	var (
+		collected      bool
+		fieldSeen      = make(map[string]struct{}, len(group.Columns))
+		selectedFields = []string{group.FieldID}
+	)
 	for _, field := range graphql.CollectFields(op, field.Selections, satisfies) {
 		switch field.Name {
 		case "users":
+			collected = true
 			var (
 				path  = append(path, field.Name)
 				query = &UserQuery{config: gr.config}
@@ -228,7 +395,7 @@ func (gr *GroupQuery) collectField(ctx context.Context, op *graphql.OperationCon
 			if err != nil {
 				return fmt.Errorf("create new pager in path %q: %w", path, err)
 			}
-			if query, err = pager.applyFilter(query); err != nil {
+			if query, err = pager.applyFilter(ctx, query); err != nil {
 				return err
 			}
 			if !hasCollectedField(ctx, append(path, edgesField)...) || args.first != nil && *args.first == 0 || args.last != nil && *args.last == 0 {
@@ -320,8 +487,19 @@ func (gr *GroupQuery) collectField(ctx context.Context, op *graphql.OperationCon
 				}
 			}
 			gr.withUsers = query
+		case "name":
+			if _, ok := fieldSeen[group.FieldName]; !ok {
+				selectedFields = append(selectedFields, group.FieldName)
+				fieldSeen[group.FieldName] = struct{}{}
+			}
+			collected = true
+		case "id":
+			collected = true
 		}
 	}
+	if collected {
+		gr.Select(selectedFields...)
+	}
 	return nil
 }
 
@@ -354,7 +532,8 @@ func newGroupPaginateArgs(rv map[string]interface{}) *groupPaginateArgs {
 	return args
 }
 
-// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context,
+// and to select only the columns of the requested fields.
 func (t *TodoQuery) CollectFields(ctx context.Context, satisfies ...string) (*TodoQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
 	if fc == nil {
@@ -368,18 +547,26 @@ func (t *TodoQuery) CollectFields(ctx context.Context, satisfies ...string) (*To
 
 func (t *TodoQuery) collectField(ctx context.Context, op *graphql.OperationContext, field graphql.CollectedField, path []string, satisfies ...string) error {
 	path = append([]string(nil), path...)
+	var (
+		collected      bool
+		fieldSeen      = make(map[string]struct{}, len(todo.Columns))
+		selectedFields = []string{todo.FieldID, todo.FieldCreatedAt, todo.FieldStatus, todo.FieldPriority, todo.FieldText, todo.FieldCategoryID}
+	)
 	for _, field := range graphql.CollectFields(op, field.Selections, satisfies) {
 		switch field.Name {
 		case "parent":
+			collected = true
 			var (
 				path  = append(path, field.Name)
 				query = &TodoQuery{config: t.config}
 			)
+			query = query.excludeDeleted(ctx)
 			if err := query.collectField(ctx, op, field, path, satisfies...); err != nil {
 				return err
 			}
 			t.withParent = query
 		case "children":
+			collected = true
 			var (
 				path  = append(path, field.Name)
 				query = &TodoQuery{config: t.config}
@@ -392,7 +579,7 @@ func (t *TodoQuery) collectField(ctx context.Context, op *graphql.OperationConte
 			if err != nil {
 				return fmt.Errorf("create new pager in path %q: %w", path, err)
 			}
-			if query, err = pager.applyFilter(query); err != nil {
+			if query, err = pager.applyFilter(ctx, query); err != nil {
 				return err
 			}
 			if !hasCollectedField(ctx, append(path, edgesField)...) || args.first != nil && *args.first == 0 || args.last != nil && *args.last == 0 {
@@ -463,6 +650,7 @@ func (t *TodoQuery) collectField(ctx context.Context, op *graphql.OperationConte
 				})
 			}
 			query = pager.applyCursors(query, args.after, args.before)
+			query = pager.applyOrderValues(query)
 			if limit := paginateLimit(args.first, args.last); limit > 0 {
 				modify := limitRows(todo.ChildrenColumn, limit, pager.orderExpr(args.last != nil))
 				query.modifiers = append(query.modifiers, modify)
@@ -477,6 +665,7 @@ func (t *TodoQuery) collectField(ctx context.Context, op *graphql.OperationConte
 			}
 			t.withChildren = query
 		case "category":
+			collected = true
 			var (
 				path  = append(path, field.Name)
 				query = &CategoryQuery{config: t.config}
@@ -485,8 +674,37 @@ func (t *TodoQuery) collectField(ctx context.Context, op *graphql.OperationConte
 				return err
 			}
 			t.withCategory = query
+		case "blob":
+			if _, ok := fieldSeen[todo.FieldBlob]; !ok {
+				selectedFields = append(selectedFields, todo.FieldBlob)
+				fieldSeen[todo.FieldBlob] = struct{}{}
+			}
+			collected = true
+		case "deletedAt", "deleted_at":
+			if _, ok := fieldSeen[todo.FieldDeletedAt]; !ok {
+				selectedFields = append(selectedFields, todo.FieldDeletedAt)
+				fieldSeen[todo.FieldDeletedAt] = struct{}{}
+			}
+			collected = true
+		case "version":
+			if _, ok := fieldSeen[todo.FieldVersion]; !ok {
+				selectedFields = append(selectedFields, todo.FieldVersion)
+				fieldSeen[todo.FieldVersion] = struct{}{}
+			}
+			collected = true
+		case "label":
+			if _, ok := fieldSeen[todo.FieldLabel]; !ok {
+				selectedFields = append(selectedFields, todo.FieldLabel)
+				fieldSeen[todo.FieldLabel] = struct{}{}
+			}
+			collected = true
+		case "id":
+			collected = true
 		}
 	}
+	if collected {
+		t.Select(selectedFields...)
+	}
 	return nil
 }
 
@@ -513,26 +731,29 @@ func newTodoPaginateArgs(rv map[string]interface{}) *todoPaginateArgs {
 	if v := rv[beforeField]; v != nil {
 		args.before = v.(*Cursor)
 	}
+	if v, ok := rv[includeDeletedField].(*bool); ok {
+		args.opts = append(args.opts, WithTodoIncludeDeleted(v))
+	}
 	if v, ok := rv[orderByField]; ok {
+		var orders []*TodoOrder
 		switch v := v.(type) {
 		case map[string]interface{}:
-			var (
-				err1, err2 error
-				order      = &TodoOrder{Field: &TodoOrderField{}}
-			)
-			if d, ok := v[directionField]; ok {
-				err1 = order.Direction.UnmarshalGQL(d)
-			}
-			if f, ok := v[fieldField]; ok {
-				err2 = order.Field.UnmarshalGQL(f)
+			if order, ok := unmarshalTodoOrder(v); ok {
+				orders = append(orders, order)
 			}
-			if err1 == nil && err2 == nil {
-				args.opts = append(args.opts, WithTodoOrder(order))
-			}
-		case *TodoOrder:
-			if v != nil {
-				args.opts = append(args.opts, WithTodoOrder(v))
+		case []interface{}:
+			for i := range v {
+				if mv, ok := v[i].(map[string]interface{}); ok {
+					if order, ok := unmarshalTodoOrder(mv); ok {
+						orders = append(orders, order)
+					}
+				}
 			}
+		case []*TodoOrder:
+			orders = v
+		}
+		if len(orders) > 0 {
+			args.opts = append(args.opts, WithTodoOrder(orders))
 		}
 	}
 	if v, ok := rv[whereField].(*TodoWhereInput); ok {
@@ -541,7 +762,22 @@ func newTodoPaginateArgs(rv map[string]interface{}) *todoPaginateArgs {
 	return args
 }
 
-// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func unmarshalTodoOrder(v map[string]interface{}) (*TodoOrder, bool) {
+	var (
+		err1, err2 error
+		order      = &TodoOrder{Direction: OrderDirectionAsc, Field: &TodoOrderField{}}
+	)
+	if d, ok := v[directionField]; ok {
+		err1 = order.Direction.UnmarshalGQL(d)
+	}
+	if f, ok := v[fieldField]; ok {
+		err2 = order.Field.UnmarshalGQL(f)
+	}
+	return order, err1 == nil && err2 == nil
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context,
+// and to select only the columns of the requested fields.
 func (u *UserQuery) CollectFields(ctx context.Context, satisfies ...string) (*UserQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
 	if fc == nil {
@@ -555,9 +791,15 @@ func (u *UserQuery) CollectFields(ctx context.Context, satisfies ...string) (*Us
 
 func (u *UserQuery) collectField(ctx context.Context, op *graphql.OperationContext, field graphql.CollectedField, path []string, satisfies ...string) error {
 	path = append([]string(nil), path...)
+	var (
+		collected      bool
+		fieldSeen      = make(map[string]struct{}, len(user.Columns))
+		selectedFields = []string{user.FieldID}
+	)
 	for _, field := range graphql.CollectFields(op, field.Selections, satisfies) {
 		switch field.Name {
 		case "groups":
+			collected = true
 			var (
 				path  = append(path, field.Name)
 				query = &GroupQuery{config: u.config}
@@ -570,7 +812,7 @@ func (u *UserQuery) collectField(ctx context.Context, op *graphql.OperationConte
 			if err != nil {
 				return fmt.Errorf("create new pager in path %q: %w", path, err)
 			}
-			if query, err = pager.applyFilter(query); err != nil {
+			if query, err = pager.applyFilter(ctx, query); err != nil {
 				return err
 			}
 			if !hasCollectedField(ctx, append(path, edgesField)...) || args.first != nil && *args.first == 0 || args.last != nil && *args.last == 0 {
@@ -663,22 +905,235 @@ func (u *UserQuery) collectField(ctx context.Context, op *graphql.OperationConte
 			}
 			u.withGroups = query
 		case "friends":
+			collected = true
 			var (
 				path  = append(path, field.Name)
 				query = &UserQuery{config: u.config}
 			)
-			if err := query.collectField(ctx, op, field, path, satisfies...); err != nil {
+			args := newUserPaginateArgs(fieldArgs(ctx, new(UserWhereInput), path...))
+			if err := validateOffsetLimit(args.offset, args.limit); err != nil {
+				return fmt.Errorf("validate offset and limit in path %q: %w", path, err)
+			}
+			pager, err := newUserPager(args.opts)
+			if err != nil {
+				return fmt.Errorf("create new pager in path %q: %w", path, err)
+			}
+			if query, err = pager.applyFilter(ctx, query); err != nil {
 				return err
 			}
+			if !hasCollectedField(ctx, append(path, nodesField)...) && !hasCollectedField(ctx, append(path, hasNextPageField)...) || args.limit != nil && *args.limit == 0 {
+				if hasCollectedField(ctx, append(path, totalCountField)...) {
+					query := query.Clone()
+					u.loadTotal = append(u.loadTotal, func(ctx context.Context, nodes []*User) error {
+						ids := make([]driver.Value, len(nodes))
+						for i := range nodes {
+							ids[i] = nodes[i].ID
+						}
+						var v []struct {
+							NodeID pulid.ID `sql:"user_id"`
+							Count  int      `sql:"count"`
+						}
+						query.Where(func(s *sql.Selector) {
+							joinT := sql.Table(user.FriendsTable)
+							s.Join(joinT).On(s.C(user.FieldID), joinT.C(user.FriendsPrimaryKey[1]))
+							s.Where(sql.InValues(joinT.C(user.FriendsPrimaryKey[0]), ids...))
+							s.Select(joinT.C(user.FriendsPrimaryKey[0]), sql.Count("*"))
+							s.GroupBy(joinT.C(user.FriendsPrimaryKey[0]))
+						})
+						if err := query.Select().Scan(ctx, &v); err != nil {
+							return err
+						}
+						m := make(map[pulid.ID]int, len(v))
+						for i := range v {
+							m[v[i].NodeID] = v[i].Count
+						}
+						for i := range nodes {
+							n := m[nodes[i].ID]
+							nodes[i].Edges.totalCount[1] = &n
+						}
+						return nil
+					})
+				}
+				continue
+			}
+			if (args.offset != nil || args.limit != nil) && hasCollectedField(ctx, append(path, totalCountField)...) {
+				query := query.Clone()
+				u.loadTotal = append(u.loadTotal, func(ctx context.Context, nodes []*User) error {
+					ids := make([]driver.Value, len(nodes))
+					for i := range nodes {
+						ids[i] = nodes[i].ID
+					}
+					var v []struct {
+						NodeID pulid.ID `sql:"user_id"`
+						Count  int      `sql:"count"`
+					}
+					query.Where(func(s *sql.Selector) {
+						joinT := sql.Table(user.FriendsTable)
+						s.Join(joinT).On(s.C(user.FieldID), joinT.C(user.FriendsPrimaryKey[1]))
+						s.Where(sql.InValues(joinT.C(user.FriendsPrimaryKey[0]), ids...))
+						s.Select(joinT.C(user.FriendsPrimaryKey[0]), sql.Count("*"))
+						s.GroupBy(joinT.C(user.FriendsPrimaryKey[0]))
+					})
+					if err := query.Select().Scan(ctx, &v); err != nil {
+						return err
+					}
+					m := make(map[pulid.ID]int, len(v))
+					for i := range v {
+						m[v[i].NodeID] = v[i].Count
+					}
+					for i := range nodes {
+						n := m[nodes[i].ID]
+						nodes[i].Edges.totalCount[1] = &n
+					}
+					return nil
+				})
+			} else {
+				u.loadTotal = append(u.loadTotal, func(_ context.Context, nodes []*User) error {
+					for i := range nodes {
+						n := len(nodes[i].Edges.Friends)
+						nodes[i].Edges.totalCount[1] = &n
+					}
+					return nil
+				})
+			}
+			if args.offset != nil || args.limit != nil {
+				var offset, limit int
+				if args.offset != nil {
+					offset = *args.offset
+				}
+				if args.limit != nil {
+					limit = *args.limit + 1
+				}
+				modify := offsetRows(user.FriendsPrimaryKey[0], offset, limit, pager.orderExpr(false))
+				query.modifiers = append(query.modifiers, modify)
+			} else {
+				query = pager.applyOrder(query, false)
+			}
+			path = append(path, nodesField)
+			if field := collectedField(ctx, path...); field != nil {
+				if err := query.collectField(ctx, op, *field, path, satisfies...); err != nil {
+					return err
+				}
+			}
 			u.withFriends = query
+		case "friendships":
+			collected = true
+			var (
+				path  = append(path, field.Name)
+				query = &FriendshipQuery{config: u.config}
+			)
+			args := newFriendshipPaginateArgs(fieldArgs(ctx, new(FriendshipWhereInput), path...))
+			if err := validateFirstLast(args.first, args.last); err != nil {
+				return fmt.Errorf("validate first and last in path %q: %w", path, err)
+			}
+			pager, err := newFriendshipPager(args.opts)
+			if err != nil {
+				return fmt.Errorf("create new pager in path %q: %w", path, err)
+			}
+			if query, err = pager.applyFilter(ctx, query); err != nil {
+				return err
+			}
+			if !hasCollectedField(ctx, append(path, edgesField)...) || args.first != nil && *args.first == 0 || args.last != nil && *args.last == 0 {
+				if hasCollectedField(ctx, append(path, totalCountField)...) || hasCollectedField(ctx, append(path, pageInfoField)...) {
+					query := query.Clone()
+					u.loadTotal = append(u.loadTotal, func(ctx context.Context, nodes []*User) error {
+						ids := make([]driver.Value, len(nodes))
+						for i := range nodes {
+							ids[i] = nodes[i].ID
+						}
+						var v []struct {
+							NodeID pulid.ID `sql:"user_id"`
+							Count  int      `sql:"count"`
+						}
+						query.Where(func(s *sql.Selector) {
+							s.Where(sql.InValues(user.FriendshipsColumn, ids...))
+						})
+						if err := query.GroupBy(user.FriendshipsColumn).Aggregate(Count()).Scan(ctx, &v); err != nil {
+							return err
+						}
+						m := make(map[pulid.ID]int, len(v))
+						for i := range v {
+							m[v[i].NodeID] = v[i].Count
+						}
+						for i := range nodes {
+							n := m[nodes[i].ID]
+							nodes[i].Edges.totalCount[2] = &n
+						}
+						return nil
+					})
+				}
+				continue
+			}
+			if (args.after != nil || args.first != nil || args.before != nil || args.last != nil) && hasCollectedField(ctx, append(path, totalCountField)...) {
+				query := query.Clone()
+				u.loadTotal = append(u.loadTotal, func(ctx context.Context, nodes []*User) error {
+					ids := make([]driver.Value, len(nodes))
+					for i := range nodes {
+						ids[i] = nodes[i].ID
+					}
+					var v []struct {
+						NodeID pulid.ID `sql:"user_id"`
+						Count  int      `sql:"count"`
+					}
+					query.Where(func(s *sql.Selector) {
+						s.Where(sql.InValues(user.FriendshipsColumn, ids...))
+					})
+					if err := query.GroupBy(user.FriendshipsColumn).Aggregate(Count()).Scan(ctx, &v); err != nil {
+						return err
+					}
+					m := make(map[pulid.ID]int, len(v))
+					for i := range v {
+						m[v[i].NodeID] = v[i].Count
+					}
+					for i := range nodes {
+						n := m[nodes[i].ID]
+						nodes[i].Edges.totalCount[2] = &n
+					}
+					return nil
+				})
+			} else {
+				u.loadTotal = append(u.loadTotal, func(_ context.Context, nodes []*User) error {
+					for i := range nodes {
+						n := len(nodes[i].Edges.Friendships)
+						nodes[i].Edges.totalCount[2] = &n
+					}
+					return nil
+				})
+			}
+			query = pager.applyCursors(query, args.after, args.before)
+			if limit := paginateLimit(args.first, args.last); limit > 0 {
+				modify := limitRows(user.FriendshipsColumn, limit, pager.orderExpr(args.last != nil))
+				query.modifiers = append(query.modifiers, modify)
+			} else {
+				query = pager.applyOrder(query, args.last != nil)
+			}
+			path = append(path, edgesField, nodeField)
+			if field := collectedField(ctx, path...); field != nil {
+				if err := query.collectField(ctx, op, *field, path, satisfies...); err != nil {
+					return err
+				}
+			}
+			u.withFriendships = query
+		case "name":
+			if _, ok := fieldSeen[user.FieldName]; !ok {
+				selectedFields = append(selectedFields, user.FieldName)
+				fieldSeen[user.FieldName] = struct{}{}
+			}
+			collected = true
+		case "id":
+			collected = true
 		}
 	}
+	if collected {
+		u.Select(selectedFields...)
+	}
 	return nil
 }
 
 type userPaginateArgs struct {
 	first, last   *int
 	after, before *Cursor
+	offset, limit *int
 	opts          []UserPaginateOption
 }
 
@@ -699,6 +1154,12 @@ func newUserPaginateArgs(rv map[string]interface{}) *userPaginateArgs {
 	if v := rv[beforeField]; v != nil {
 		args.before = v.(*Cursor)
 	}
+	if v := rv[offsetField]; v != nil {
+		args.offset = v.(*int)
+	}
+	if v := rv[limitField]; v != nil {
+		args.limit = v.(*int)
+	}
 	if v, ok := rv[whereField].(*UserWhereInput); ok {
 		args.opts = append(args.opts, WithUserFilter(v.Filter))
 	}
@@ -706,14 +1167,17 @@ func newUserPaginateArgs(rv map[string]interface{}) *userPaginateArgs {
 }
 
 const (
-	afterField     = "after"
-	firstField     = "first"
-	beforeField    = "before"
-	lastField      = "last"
-	orderByField   = "orderBy"
-	directionField = "direction"
-	fieldField     = "field"
-	whereField     = "where"
+	afterField          = "after"
+	firstField          = "first"
+	beforeField         = "before"
+	lastField           = "last"
+	offsetField         = "offset"
+	limitField          = "limit"
+	orderByField        = "orderBy"
+	directionField      = "direction"
+	fieldField          = "field"
+	whereField          = "where"
+	includeDeletedField = "includeDeleted"
 )
 
 func fieldArgs(ctx context.Context, whereInput interface{}, path ...string) map[string]interface{} {
@@ -745,7 +1209,7 @@ func fieldArgs(ctx context.Context, whereInput interface{}, path ...string) map[
 
 // unmarshalArgs allows extracting the field arguments from their raw representation.
 func unmarshalArgs(ctx context.Context, whereInput interface{}, args map[string]interface{}) map[string]interface{} {
-	for _, k := range []string{firstField, lastField} {
+	for _, k := range []string{firstField, lastField, offsetField, limitField} {
 		v, ok := args[k]
 		if !ok {
 			continue
@@ -765,6 +1229,12 @@ func unmarshalArgs(ctx context.Context, whereInput interface{}, args map[string]
 			args[k] = &c
 		}
 	}
+	if v, ok := args[includeDeletedField]; ok {
+		b, err := graphql.UnmarshalBoolean(v)
+		if err == nil {
+			args[includeDeletedField] = &b
+		}
+	}
 	if v, ok := args[whereField]; ok && whereInput != nil {
 		if err := graphql.UnmarshalInputFromContext(ctx, v, whereInput); err == nil {
 			args[whereField] = whereInput
@@ -774,25 +1244,34 @@ func unmarshalArgs(ctx context.Context, whereInput interface{}, args map[string]
 	return args
 }
 
-func limitRows(partitionBy string, limit int, orderBy ...sql.Querier) func(s *sql.Selector) {
+func limitRows(partitionBy string, limit int, orderBy func(*sql.Selector) sql.Querier) func(s *sql.Selector) {
+	return offsetRows(partitionBy, 0, limit, orderBy)
+}
+
+// offsetRows returns a modifier that skips the first offset rows of each partition, and
+// limits the rest to the given limit. Zero limit means the rows are not limited.
+func offsetRows(partitionBy string, offset, limit int, orderBy func(*sql.Selector) sql.Querier) func(s *sql.Selector) {
 	return func(s *sql.Selector) {
 		d := sql.Dialect(s.Dialect())
 		s.SetDistinct(false)
+		src := d.Select("*").From(d.Table("src_query"))
 		with := d.With("src_query").
 			As(s.Clone()).
 			With("limited_query").
 			As(
-				d.Select("*").
-					AppendSelectExprAs(
-						sql.RowNumber().PartitionBy(partitionBy).OrderExpr(orderBy...),
-						"row_number",
-					).
-					From(d.Table("src_query")),
+				src.AppendSelectExprAs(
+					sql.RowNumber().PartitionBy(partitionBy).OrderExpr(orderBy(src)),
+					"row_number",
+				),
 			)
 		t := d.Table("limited_query").As(s.TableName())
+		p := sql.GT(t.C("row_number"), offset)
+		if limit > 0 {
+			p = sql.And(p, sql.LTE(t.C("row_number"), offset+limit))
+		}
 		*s = *d.Select(s.UnqualifiedColumns()...).
 			From(t).
-			Where(sql.LTE(t.C("row_number"), limit)).
+			Where(p).
 			Prefix(with)
 	}
 }
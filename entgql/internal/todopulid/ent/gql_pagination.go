@@ -18,6 +18,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"encoding/base64"
 	"errors"
 	"fmt"
@@ -25,7 +26,9 @@ import (
 	"strconv"
 	"strings"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/contrib/entgql/internal/todopulid/ent/category"
+	"entgo.io/contrib/entgql/internal/todopulid/ent/friendship"
 	"entgo.io/contrib/entgql/internal/todopulid/ent/group"
 	"entgo.io/contrib/entgql/internal/todopulid/ent/schema/pulid"
 	"entgo.io/contrib/entgql/internal/todopulid/ent/todo"
@@ -82,74 +85,110 @@ func (o OrderDirection) reverse() OrderDirection {
 	return OrderDirectionDesc
 }
 
-func (o OrderDirection) orderFunc(field string) OrderFunc {
+func (o OrderDirection) orderFunc(column func(*sql.Selector) string) OrderFunc {
+	return func(s *sql.Selector) {
+		if o == OrderDirectionDesc {
+			s.OrderBy(sql.Desc(column(s)))
+		} else {
+			s.OrderBy(sql.Asc(column(s)))
+		}
+	}
+}
+
+// predicate returns the predicate of the values that come after a value in this direction.
+func (o OrderDirection) predicate() func(string, interface{}) *sql.Predicate {
 	if o == OrderDirectionDesc {
-		return Desc(field)
+		return sql.LT
 	}
-	return Asc(field)
+	return sql.GT
 }
 
-func cursorsToPredicates(direction OrderDirection, after, before *Cursor, field, idField string) []func(s *sql.Selector) {
+// cursorsToPredicates returns the keyset predicates of the given cursors. The cursor
+// values are matched with the given columns and directions, and the idField is used
+// as a tie-breaker that follows the last direction.
+func cursorsToPredicates(directions []OrderDirection, after, before *Cursor, columns []func(*sql.Selector) string, idField string) []func(s *sql.Selector) {
 	var predicates []func(s *sql.Selector)
 	if after != nil {
-		if after.Value != nil {
-			var predicate func([]string, ...interface{}) *sql.Predicate
-			if direction == OrderDirectionAsc {
-				predicate = sql.CompositeGT
-			} else {
-				predicate = sql.CompositeLT
-			}
-			predicates = append(predicates, func(s *sql.Selector) {
-				s.Where(predicate(
-					s.Columns(field, idField),
-					after.Value, after.ID,
-				))
-			})
-		} else {
-			var predicate func(string, interface{}) *sql.Predicate
-			if direction == OrderDirectionAsc {
-				predicate = sql.GT
-			} else {
-				predicate = sql.LT
-			}
-			predicates = append(predicates, func(s *sql.Selector) {
-				s.Where(predicate(
-					s.C(idField),
-					after.ID,
-				))
-			})
-		}
+		predicates = append(predicates, cursorPredicate(directions, after, columns, idField, false))
 	}
 	if before != nil {
-		if before.Value != nil {
-			var predicate func([]string, ...interface{}) *sql.Predicate
-			if direction == OrderDirectionAsc {
-				predicate = sql.CompositeLT
+		predicates = append(predicates, cursorPredicate(directions, before, columns, idField, true))
+	}
+	return predicates
+}
+
+// cursorPredicate returns the predicate of the rows that come after the cursor
+// in the given order, or before it in case reverse is true.
+func cursorPredicate(directions []OrderDirection, c *Cursor, columns []func(*sql.Selector) string, idField string, reverse bool) func(s *sql.Selector) {
+	var (
+		same = true
+		ds   = make([]OrderDirection, len(directions))
+	)
+	for i, d := range directions {
+		if reverse {
+			d = d.reverse()
+		}
+		ds[i] = d
+		same = same && d == ds[0]
+	}
+	last := ds[len(ds)-1]
+	values := cursorValues(c, len(columns))
+	return func(s *sql.Selector) {
+		fields := make([]string, len(columns))
+		for i := range columns {
+			fields[i] = columns[i](s)
+		}
+		switch {
+		case values == nil:
+			s.Where(last.predicate()(s.C(idField), c.ID))
+		case same:
+			args := append(values[:len(values):len(values)], c.ID)
+			if last == OrderDirectionAsc {
+				s.Where(sql.CompositeGT(append(fields, s.C(idField)), args...))
 			} else {
-				predicate = sql.CompositeGT
+				s.Where(sql.CompositeLT(append(fields, s.C(idField)), args...))
 			}
-			predicates = append(predicates, func(s *sql.Selector) {
-				s.Where(predicate(
-					s.Columns(field, idField),
-					before.Value, before.ID,
-				))
-			})
-		} else {
-			var predicate func(string, interface{}) *sql.Predicate
-			if direction == OrderDirectionAsc {
-				predicate = sql.LT
-			} else {
-				predicate = sql.GT
+		default:
+			// Mixed directions are expanded to the following form:
+			// (f1 > v1) OR (f1 = v1 AND f2 < v2) OR ... OR (f1 = v1 AND ... AND fn = vn AND id > ID).
+			ors := make([]*sql.Predicate, 0, len(fields)+1)
+			for i := 0; i <= len(fields); i++ {
+				ands := make([]*sql.Predicate, 0, i+1)
+				for j := 0; j < i; j++ {
+					ands = append(ands, sql.EQ(fields[j], values[j]))
+				}
+				if i < len(fields) {
+					ands = append(ands, ds[i].predicate()(fields[i], values[i]))
+				} else {
+					ands = append(ands, last.predicate()(s.C(idField), c.ID))
+				}
+				ors = append(ors, sql.And(ands...))
 			}
-			predicates = append(predicates, func(s *sql.Selector) {
-				s.Where(predicate(
-					s.C(idField),
-					before.ID,
-				))
-			})
+			s.Where(sql.Or(ors...))
 		}
 	}
-	return predicates
+}
+
+// cursorValues returns the ordering values of the cursor. A cursor of a single
+// field holds its value as is, and a cursor of multiple fields holds a list.
+func cursorValues(c *Cursor, n int) []interface{} {
+	switch {
+	case c.Value == nil || n == 0:
+		return nil
+	case n == 1:
+		return []interface{}{c.Value}
+	}
+	values, ok := c.Value.([]interface{})
+	if !ok || len(values) != n {
+		return nil
+	}
+	return values
+}
+
+// subqueryExpr returns the SQL expression of the given scalar subquery.
+func subqueryExpr(subquery *sql.Selector) string {
+	query, _ := subquery.Query()
+	return "(" + query + ")"
 }
 
 // PageInfo of a connection type.
@@ -243,12 +282,30 @@ func hasCollectedField(ctx context.Context, path ...string) bool {
 }
 
 const (
-	edgesField      = "edges"
-	nodeField       = "node"
-	pageInfoField   = "pageInfo"
-	totalCountField = "totalCount"
+	edgesField       = "edges"
+	nodeField        = "node"
+	nodesField       = "nodes"
+	pageInfoField    = "pageInfo"
+	hasNextPageField = "hasNextPage"
+	totalCountField  = "totalCount"
 )
 
+func validateOffsetLimit(offset, limit *int) (err *gqlerror.Error) {
+	switch {
+	case offset != nil && *offset < 0:
+		err = &gqlerror.Error{
+			Message: "`offset` on a page cannot be less than zero.",
+		}
+		errcode.Set(err, errInvalidPagination)
+	case limit != nil && *limit < 0:
+		err = &gqlerror.Error{
+			Message: "`limit` on a page cannot be less than zero.",
+		}
+		errcode.Set(err, errInvalidPagination)
+	}
+	return err
+}
+
 func paginateLimit(first, last *int) int {
 	var limit int
 	if first != nil {
@@ -360,7 +417,7 @@ func newCategoryPager(opts []CategoryPaginateOption) (*categoryPager, error) {
 	return pager, nil
 }
 
-func (p *categoryPager) applyFilter(query *CategoryQuery) (*CategoryQuery, error) {
+func (p *categoryPager) applyFilter(ctx context.Context, query *CategoryQuery) (*CategoryQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
@@ -373,8 +430,8 @@ func (p *categoryPager) toCursor(c *Category) Cursor {
 
 func (p *categoryPager) applyCursors(query *CategoryQuery, after, before *Cursor) *CategoryQuery {
 	for _, predicate := range cursorsToPredicates(
-		p.order.Direction, after, before,
-		p.order.Field.field, DefaultCategoryOrder.Field.field,
+		[]OrderDirection{p.order.Direction}, after, before,
+		[]func(*sql.Selector) string{p.order.Field.column}, DefaultCategoryOrder.Field.field,
 	) {
 		query = query.Where(predicate)
 	}
@@ -386,24 +443,26 @@ func (p *categoryPager) applyOrder(query *CategoryQuery, reverse bool) *Category
 	if reverse {
 		direction = direction.reverse()
 	}
-	query = query.Order(direction.orderFunc(p.order.Field.field))
+	query = query.Order(direction.orderFunc(p.order.Field.column))
 	if p.order.Field != DefaultCategoryOrder.Field {
-		query = query.Order(direction.orderFunc(DefaultCategoryOrder.Field.field))
+		query = query.Order(direction.orderFunc(DefaultCategoryOrder.Field.column))
 	}
 	return query
 }
 
-func (p *categoryPager) orderExpr(reverse bool) sql.Querier {
+func (p *categoryPager) orderExpr(reverse bool) func(*sql.Selector) sql.Querier {
 	direction := p.order.Direction
 	if reverse {
 		direction = direction.reverse()
 	}
-	return sql.ExprFunc(func(b *sql.Builder) {
-		b.Ident(p.order.Field.field).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultCategoryOrder.Field {
-			b.Comma().Ident(DefaultCategoryOrder.Field.field).Pad().WriteString(string(direction))
-		}
-	})
+	return func(s *sql.Selector) sql.Querier {
+		return sql.ExprFunc(func(b *sql.Builder) {
+			b.Ident(p.order.Field.column(s)).Pad().WriteString(string(direction))
+			if p.order.Field != DefaultCategoryOrder.Field {
+				b.Comma().Ident(DefaultCategoryOrder.Field.column(s)).Pad().WriteString(string(direction))
+			}
+		})
+	}
 }
 
 // Paginate executes the query and returns a relay based cursor connection to Category.
@@ -418,7 +477,7 @@ func (c *CategoryQuery) Paginate(
 	if err != nil {
 		return nil, err
 	}
-	if c, err = pager.applyFilter(c); err != nil {
+	if c, err = pager.applyFilter(ctx, c); err != nil {
 		return nil, err
 	}
 	conn := &CategoryConnection{Edges: []*CategoryEdge{}}
@@ -523,6 +582,11 @@ type CategoryOrderField struct {
 	toCursor func(*Category) Cursor
 }
 
+// column returns the ordering column (or expression) of the field in the given selector.
+func (f *CategoryOrderField) column(s *sql.Selector) string {
+	return s.C(f.field)
+}
+
 // CategoryOrder defines the ordering of Category.
 type CategoryOrder struct {
 	Direction OrderDirection      `json:"direction"`
@@ -551,6 +615,291 @@ func (c *Category) ToEdge(order *CategoryOrder) *CategoryEdge {
 	}
 }
 
+// FriendshipEdge is the edge representation of Friendship.
+type FriendshipEdge struct {
+	Node   *Friendship `json:"node"`
+	Cursor Cursor      `json:"cursor"`
+}
+
+// FriendshipConnection is the connection containing edges to Friendship.
+type FriendshipConnection struct {
+	Edges      []*FriendshipEdge `json:"edges"`
+	PageInfo   PageInfo          `json:"pageInfo"`
+	TotalCount int               `json:"totalCount"`
+}
+
+func (c *FriendshipConnection) build(nodes []*Friendship, pager *friendshipPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *Friendship
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *Friendship {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *Friendship {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*FriendshipEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &FriendshipEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// FriendshipPaginateOption enables pagination customization.
+type FriendshipPaginateOption func(*friendshipPager) error
+
+// WithFriendshipOrder configures pagination ordering.
+func WithFriendshipOrder(order *FriendshipOrder) FriendshipPaginateOption {
+	if order == nil {
+		order = DefaultFriendshipOrder
+	}
+	o := *order
+	return func(pager *friendshipPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultFriendshipOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithFriendshipFilter configures pagination filter.
+func WithFriendshipFilter(filter func(*FriendshipQuery) (*FriendshipQuery, error)) FriendshipPaginateOption {
+	return func(pager *friendshipPager) error {
+		if filter == nil {
+			return errors.New("FriendshipQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type friendshipPager struct {
+	order  *FriendshipOrder
+	filter func(*FriendshipQuery) (*FriendshipQuery, error)
+}
+
+func newFriendshipPager(opts []FriendshipPaginateOption) (*friendshipPager, error) {
+	pager := &friendshipPager{}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultFriendshipOrder
+	}
+	return pager, nil
+}
+
+func (p *friendshipPager) applyFilter(ctx context.Context, query *FriendshipQuery) (*FriendshipQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *friendshipPager) toCursor(f *Friendship) Cursor {
+	return p.order.Field.toCursor(f)
+}
+
+func (p *friendshipPager) applyCursors(query *FriendshipQuery, after, before *Cursor) *FriendshipQuery {
+	for _, predicate := range cursorsToPredicates(
+		[]OrderDirection{p.order.Direction}, after, before,
+		[]func(*sql.Selector) string{p.order.Field.column}, DefaultFriendshipOrder.Field.field,
+	) {
+		query = query.Where(predicate)
+	}
+	return query
+}
+
+func (p *friendshipPager) applyOrder(query *FriendshipQuery, reverse bool) *FriendshipQuery {
+	direction := p.order.Direction
+	if reverse {
+		direction = direction.reverse()
+	}
+	query = query.Order(direction.orderFunc(p.order.Field.column))
+	if p.order.Field != DefaultFriendshipOrder.Field {
+		query = query.Order(direction.orderFunc(DefaultFriendshipOrder.Field.column))
+	}
+	return query
+}
+
+func (p *friendshipPager) orderExpr(reverse bool) func(*sql.Selector) sql.Querier {
+	direction := p.order.Direction
+	if reverse {
+		direction = direction.reverse()
+	}
+	return func(s *sql.Selector) sql.Querier {
+		return sql.ExprFunc(func(b *sql.Builder) {
+			b.Ident(p.order.Field.column(s)).Pad().WriteString(string(direction))
+			if p.order.Field != DefaultFriendshipOrder.Field {
+				b.Comma().Ident(DefaultFriendshipOrder.Field.column(s)).Pad().WriteString(string(direction))
+			}
+		})
+	}
+}
+
+// Paginate executes the query and returns a relay based cursor connection to Friendship.
+func (f *FriendshipQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...FriendshipPaginateOption,
+) (*FriendshipConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newFriendshipPager(opts)
+	if err != nil {
+		return nil, err
+	}
+	if f, err = pager.applyFilter(ctx, f); err != nil {
+		return nil, err
+	}
+	conn := &FriendshipConnection{Edges: []*FriendshipEdge{}}
+	if !hasCollectedField(ctx, edgesField) || first != nil && *first == 0 || last != nil && *last == 0 {
+		if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+			if conn.TotalCount, err = f.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+		return conn, nil
+	}
+
+	if (after != nil || first != nil || before != nil || last != nil) && hasCollectedField(ctx, totalCountField) {
+		count, err := f.Clone().Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+		conn.TotalCount = count
+	}
+
+	f = pager.applyCursors(f, after, before)
+	f = pager.applyOrder(f, last != nil)
+	if limit := paginateLimit(first, last); limit != 0 {
+		f.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := f.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+
+	nodes, err := f.All(ctx)
+	if err != nil || len(nodes) == 0 {
+		return conn, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// FriendshipOrderFieldCreatedAt orders Friendship by created_at.
+	FriendshipOrderFieldCreatedAt = &FriendshipOrderField{
+		field: friendship.FieldCreatedAt,
+		toCursor: func(f *Friendship) Cursor {
+			return Cursor{
+				ID:    f.ID,
+				Value: f.CreatedAt,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f FriendshipOrderField) String() string {
+	var str string
+	switch f.field {
+	case friendship.FieldCreatedAt:
+		str = "CREATED_AT"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f FriendshipOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *FriendshipOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("FriendshipOrderField %T must be a string", v)
+	}
+	switch str {
+	case "CREATED_AT":
+		*f = *FriendshipOrderFieldCreatedAt
+	default:
+		return fmt.Errorf("%s is not a valid FriendshipOrderField", str)
+	}
+	return nil
+}
+
+// FriendshipOrderField defines the ordering field of Friendship.
+type FriendshipOrderField struct {
+	field    string
+	toCursor func(*Friendship) Cursor
+}
+
+// column returns the ordering column (or expression) of the field in the given selector.
+func (f *FriendshipOrderField) column(s *sql.Selector) string {
+	return s.C(f.field)
+}
+
+// FriendshipOrder defines the ordering of Friendship.
+type FriendshipOrder struct {
+	Direction OrderDirection        `json:"direction"`
+	Field     *FriendshipOrderField `json:"field"`
+}
+
+// DefaultFriendshipOrder is the default ordering of Friendship.
+var DefaultFriendshipOrder = &FriendshipOrder{
+	Direction: OrderDirectionAsc,
+	Field: &FriendshipOrderField{
+		field: friendship.FieldID,
+		toCursor: func(f *Friendship) Cursor {
+			return Cursor{ID: f.ID}
+		},
+	},
+}
+
+// ToEdge converts Friendship into FriendshipEdge.
+func (f *Friendship) ToEdge(order *FriendshipOrder) *FriendshipEdge {
+	if order == nil {
+		order = DefaultFriendshipOrder
+	}
+	return &FriendshipEdge{
+		Node:   f,
+		Cursor: order.Field.toCursor(f),
+	}
+}
+
 // GroupEdge is the edge representation of Group.
 type GroupEdge struct {
 	Node   *Group `json:"node"`
@@ -652,7 +1001,7 @@ func newGroupPager(opts []GroupPaginateOption) (*groupPager, error) {
 	return pager, nil
 }
 
-func (p *groupPager) applyFilter(query *GroupQuery) (*GroupQuery, error) {
+func (p *groupPager) applyFilter(ctx context.Context, query *GroupQuery) (*GroupQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
@@ -665,8 +1014,8 @@ func (p *groupPager) toCursor(gr *Group) Cursor {
 
 func (p *groupPager) applyCursors(query *GroupQuery, after, before *Cursor) *GroupQuery {
 	for _, predicate := range cursorsToPredicates(
-		p.order.Direction, after, before,
-		p.order.Field.field, DefaultGroupOrder.Field.field,
+		[]OrderDirection{p.order.Direction}, after, before,
+		[]func(*sql.Selector) string{p.order.Field.column}, DefaultGroupOrder.Field.field,
 	) {
 		query = query.Where(predicate)
 	}
@@ -678,24 +1027,26 @@ func (p *groupPager) applyOrder(query *GroupQuery, reverse bool) *GroupQuery {
 	if reverse {
 		direction = direction.reverse()
 	}
-	query = query.Order(direction.orderFunc(p.order.Field.field))
+	query = query.Order(direction.orderFunc(p.order.Field.column))
 	if p.order.Field != DefaultGroupOrder.Field {
-		query = query.Order(direction.orderFunc(DefaultGroupOrder.Field.field))
+		query = query.Order(direction.orderFunc(DefaultGroupOrder.Field.column))
 	}
 	return query
 }
 
-func (p *groupPager) orderExpr(reverse bool) sql.Querier {
+func (p *groupPager) orderExpr(reverse bool) func(*sql.Selector) sql.Querier {
 	direction := p.order.Direction
 	if reverse {
 		direction = direction.reverse()
 	}
-	return sql.ExprFunc(func(b *sql.Builder) {
-		b.Ident(p.order.Field.field).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultGroupOrder.Field {
-			b.Comma().Ident(DefaultGroupOrder.Field.field).Pad().WriteString(string(direction))
-		}
-	})
+	return func(s *sql.Selector) sql.Querier {
+		return sql.ExprFunc(func(b *sql.Builder) {
+			b.Ident(p.order.Field.column(s)).Pad().WriteString(string(direction))
+			if p.order.Field != DefaultGroupOrder.Field {
+				b.Comma().Ident(DefaultGroupOrder.Field.column(s)).Pad().WriteString(string(direction))
+			}
+		})
+	}
 }
 
 // Paginate executes the query and returns a relay based cursor connection to Group.
@@ -710,7 +1061,7 @@ func (gr *GroupQuery) Paginate(
 	if err != nil {
 		return nil, err
 	}
-	if gr, err = pager.applyFilter(gr); err != nil {
+	if gr, err = pager.applyFilter(ctx, gr); err != nil {
 		return nil, err
 	}
 	conn := &GroupConnection{Edges: []*GroupEdge{}}
@@ -758,6 +1109,11 @@ type GroupOrderField struct {
 	toCursor func(*Group) Cursor
 }
 
+// column returns the ordering column (or expression) of the field in the given selector.
+func (f *GroupOrderField) column(s *sql.Selector) string {
+	return s.C(f.field)
+}
+
 // GroupOrder defines the ordering of Group.
 type GroupOrder struct {
 	Direction OrderDirection   `json:"direction"`
@@ -840,20 +1196,23 @@ func (c *TodoConnection) build(nodes []*Todo, pager *todoPager, after *Cursor, f
 // TodoPaginateOption enables pagination customization.
 type TodoPaginateOption func(*todoPager) error
 
-// WithTodoOrder configures pagination ordering.
-func WithTodoOrder(order *TodoOrder) TodoPaginateOption {
-	if order == nil {
-		order = DefaultTodoOrder
-	}
-	o := *order
+// WithTodoOrder configures pagination ordering. The given orders are applied
+// in the given sequence, and the id field is used as a tie-breaker.
+func WithTodoOrder(order []*TodoOrder) TodoPaginateOption {
 	return func(pager *todoPager) error {
-		if err := o.Direction.Validate(); err != nil {
-			return err
-		}
-		if o.Field == nil {
-			o.Field = DefaultTodoOrder.Field
+		for _, o := range order {
+			if o == nil {
+				continue
+			}
+			if err := o.Direction.Validate(); err != nil {
+				return err
+			}
+			o := *o
+			if o.Field == nil {
+				o.Field = DefaultTodoOrder.Field
+			}
+			pager.order = append(pager.order, &o)
 		}
-		pager.order = &o
 		return nil
 	}
 }
@@ -869,9 +1228,28 @@ func WithTodoFilter(filter func(*TodoQuery) (*TodoQuery, error)) TodoPaginateOpt
 	}
 }
 
+// WithTodoIncludeDeleted configures the pagination to include the deleted Todos
+// if the given value is true. It is used for the "includeDeleted" argument.
+func WithTodoIncludeDeleted(include *bool) TodoPaginateOption {
+	return func(pager *todoPager) error {
+		pager.includeDeleted = include != nil && *include
+		return nil
+	}
+}
+
+// excludeDeleted excludes the soft-deleted Todos from the query, unless
+// the context was created by entgql.NewIncludeDeletedContext.
+func (t *TodoQuery) excludeDeleted(ctx context.Context) *TodoQuery {
+	if entgql.IncludeDeletedFromContext(ctx) {
+		return t
+	}
+	return t.Where(todo.DeletedAtIsNil())
+}
+
 type todoPager struct {
-	order  *TodoOrder
-	filter func(*TodoQuery) (*TodoQuery, error)
+	order          []*TodoOrder
+	filter         func(*TodoQuery) (*TodoQuery, error)
+	includeDeleted bool
 }
 
 func newTodoPager(opts []TodoPaginateOption) (*todoPager, error) {
@@ -881,13 +1259,16 @@ func newTodoPager(opts []TodoPaginateOption) (*todoPager, error) {
 			return nil, err
 		}
 	}
-	if pager.order == nil {
-		pager.order = DefaultTodoOrder
+	if len(pager.order) == 0 {
+		pager.order = []*TodoOrder{DefaultTodoOrder}
 	}
 	return pager, nil
 }
 
-func (p *todoPager) applyFilter(query *TodoQuery) (*TodoQuery, error) {
+func (p *todoPager) applyFilter(ctx context.Context, query *TodoQuery) (*TodoQuery, error) {
+	if !p.includeDeleted {
+		query = query.excludeDeleted(ctx)
+	}
 	if p.filter != nil {
 		return p.filter(query)
 	}
@@ -895,42 +1276,147 @@ func (p *todoPager) applyFilter(query *TodoQuery) (*TodoQuery, error) {
 }
 
 func (p *todoPager) toCursor(t *Todo) Cursor {
-	return p.order.Field.toCursor(t)
+	if len(p.order) == 1 {
+		return p.order[0].Field.toCursor(t)
+	}
+	var (
+		cursor Cursor
+		values = make([]interface{}, 0, len(p.order))
+	)
+	for _, o := range p.order {
+		c := o.Field.toCursor(t)
+		if cursor.ID = c.ID; o.Field.field == DefaultTodoOrder.Field.field {
+			values = append(values, c.ID)
+		} else {
+			values = append(values, c.Value)
+		}
+	}
+	cursor.Value = values
+	return cursor
 }
 
 func (p *todoPager) applyCursors(query *TodoQuery, after, before *Cursor) *TodoQuery {
+	var (
+		columns    = make([]func(*sql.Selector) string, 0, len(p.order))
+		directions = make([]OrderDirection, 0, len(p.order))
+	)
+	for _, o := range p.order {
+		columns = append(columns, o.Field.column)
+		directions = append(directions, o.Direction)
+	}
 	for _, predicate := range cursorsToPredicates(
-		p.order.Direction, after, before,
-		p.order.Field.field, DefaultTodoOrder.Field.field,
+		directions, after, before,
+		columns, DefaultTodoOrder.Field.field,
 	) {
 		query = query.Where(predicate)
 	}
 	return query
 }
 
-func (p *todoPager) applyOrder(query *TodoQuery, reverse bool) *TodoQuery {
-	direction := p.order.Direction
-	if reverse {
-		direction = direction.reverse()
+// orderTerms returns the ordering columns and their directions, followed by the id column.
+func (p *todoPager) orderTerms(reverse bool) ([]func(*sql.Selector) string, []OrderDirection) {
+	var (
+		hasID      bool
+		columns    = make([]func(*sql.Selector) string, 0, len(p.order)+1)
+		directions = make([]OrderDirection, 0, len(p.order)+1)
+	)
+	for _, o := range p.order {
+		direction := o.Direction
+		if reverse {
+			direction = direction.reverse()
+		}
+		columns = append(columns, o.Field.column)
+		directions = append(directions, direction)
+		hasID = hasID || o.Field.field == DefaultTodoOrder.Field.field
 	}
-	query = query.Order(direction.orderFunc(p.order.Field.field))
-	if p.order.Field != DefaultTodoOrder.Field {
-		query = query.Order(direction.orderFunc(DefaultTodoOrder.Field.field))
+	if !hasID {
+		columns = append(columns, DefaultTodoOrder.Field.column)
+		directions = append(directions, directions[len(directions)-1])
+	}
+	return columns, directions
+}
+
+func (p *todoPager) applyOrder(query *TodoQuery, reverse bool) *TodoQuery {
+	columns, directions := p.orderTerms(reverse)
+	for i := range columns {
+		query = query.Order(directions[i].orderFunc(columns[i]))
 	}
 	return query
 }
 
-func (p *todoPager) orderExpr(reverse bool) sql.Querier {
-	direction := p.order.Direction
-	if reverse {
-		direction = direction.reverse()
+func (p *todoPager) orderExpr(reverse bool) func(*sql.Selector) sql.Querier {
+	columns, directions := p.orderTerms(reverse)
+	return func(s *sql.Selector) sql.Querier {
+		return sql.ExprFunc(func(b *sql.Builder) {
+			for i := range columns {
+				if i > 0 {
+					b.Comma()
+				}
+				b.Ident(columns[i](s)).Pad().WriteString(string(directions[i]))
+			}
+		})
+	}
+}
+
+// applyOrderValues registers a loader for the values of the computed ordering
+// fields (e.g. edges count) of the returned nodes. These values are used by
+// the cursors of the nodes.
+func (p *todoPager) applyOrderValues(query *TodoQuery) *TodoQuery {
+	var fields []*TodoOrderField
+	for _, o := range p.order {
+		if o.Field.expr != nil {
+			fields = append(fields, o.Field)
+		}
 	}
-	return sql.ExprFunc(func(b *sql.Builder) {
-		b.Ident(p.order.Field.field).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultTodoOrder.Field {
-			b.Comma().Ident(DefaultTodoOrder.Field.field).Pad().WriteString(string(direction))
+	if len(fields) == 0 {
+		return query
+	}
+	query.loadOrderValues = append(query.loadOrderValues, func(ctx context.Context, nodes []*Todo) error {
+		ids := make([]driver.Value, len(nodes))
+		for i := range nodes {
+			ids[i] = nodes[i].ID
+		}
+		d := sql.Dialect(query.driver.Dialect())
+		t := d.Table(todo.Table)
+		s := d.Select(t.C(todo.FieldID)).From(t)
+		for _, f := range fields {
+			s.AppendSelect(f.expr(s))
+		}
+		s.Where(sql.InValues(t.C(todo.FieldID), ids...))
+		rows := &sql.Rows{}
+		q, args := s.Query()
+		if err := query.driver.Query(ctx, q, args, rows); err != nil {
+			return err
+		}
+		defer rows.Close()
+		values := make(map[pulid.ID]map[string]Value, len(nodes))
+		for rows.Next() {
+			var (
+				id   pulid.ID
+				vs   = make([]interface{}, len(fields))
+				dest = []interface{}{&id}
+			)
+			for i := range vs {
+				dest = append(dest, &vs[i])
+			}
+			if err := rows.Scan(dest...); err != nil {
+				return err
+			}
+			m := make(map[string]Value, len(fields))
+			for i, f := range fields {
+				m[f.field] = vs[i]
+			}
+			values[id] = m
+		}
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			n.Edges.orderValues = values[n.ID]
 		}
+		return nil
 	})
+	return query
 }
 
 // Paginate executes the query and returns a relay based cursor connection to Todo.
@@ -945,7 +1431,7 @@ func (t *TodoQuery) Paginate(
 	if err != nil {
 		return nil, err
 	}
-	if t, err = pager.applyFilter(t); err != nil {
+	if t, err = pager.applyFilter(ctx, t); err != nil {
 		return nil, err
 	}
 	conn := &TodoConnection{Edges: []*TodoEdge{}}
@@ -970,6 +1456,7 @@ func (t *TodoQuery) Paginate(
 
 	t = pager.applyCursors(t, after, before)
 	t = pager.applyOrder(t, last != nil)
+	t = pager.applyOrderValues(t)
 	if limit := paginateLimit(first, last); limit != 0 {
 		t.Limit(limit)
 	}
@@ -1028,6 +1515,38 @@ var (
 			}
 		},
 	}
+	// TodoOrderFieldChildrenCount orders Todo by the count of its children.
+	TodoOrderFieldChildrenCount = &TodoOrderField{
+		field: "CHILDREN_COUNT",
+		expr: func(s *sql.Selector) string {
+			d := sql.Dialect(s.Dialect())
+			t := d.Table(todo.ChildrenTable).As("order_children")
+			c := t.C(todo.ChildrenColumn)
+			return subqueryExpr(d.Select(sql.Count("*")).From(t).Where(sql.ColumnsEQ(c, s.C(todo.FieldID))))
+		},
+		toCursor: func(t *Todo) Cursor {
+			return Cursor{
+				ID:    t.ID,
+				Value: t.Edges.orderValues["CHILDREN_COUNT"],
+			}
+		},
+	}
+	// TodoOrderFieldCategoryText orders Todo by the text of its category.
+	TodoOrderFieldCategoryText = &TodoOrderField{
+		field: "CATEGORY_TEXT",
+		expr: func(s *sql.Selector) string {
+			d := sql.Dialect(s.Dialect())
+			t := d.Table(category.Table).As("order_category")
+			p := sql.ColumnsEQ(t.C(category.FieldID), s.C(todo.CategoryColumn))
+			return subqueryExpr(d.Select(t.C(category.FieldText)).From(t).Where(p))
+		},
+		toCursor: func(t *Todo) Cursor {
+			return Cursor{
+				ID:    t.ID,
+				Value: t.Edges.orderValues["CATEGORY_TEXT"],
+			}
+		},
+	}
 )
 
 // String implement fmt.Stringer interface.
@@ -1042,6 +1561,10 @@ func (f TodoOrderField) String() string {
 		str = "PRIORITY"
 	case todo.FieldText:
 		str = "TEXT"
+	case "CHILDREN_COUNT":
+		str = "CHILDREN_COUNT"
+	case "CATEGORY_TEXT":
+		str = "CATEGORY_TEXT"
 	}
 	return str
 }
@@ -1066,6 +1589,10 @@ func (f *TodoOrderField) UnmarshalGQL(v interface{}) error {
 		*f = *TodoOrderFieldPriority
 	case "TEXT":
 		*f = *TodoOrderFieldText
+	case "CHILDREN_COUNT":
+		*f = *TodoOrderFieldChildrenCount
+	case "CATEGORY_TEXT":
+		*f = *TodoOrderFieldCategoryText
 	default:
 		return fmt.Errorf("%s is not a valid TodoOrderField", str)
 	}
@@ -1074,10 +1601,20 @@ func (f *TodoOrderField) UnmarshalGQL(v interface{}) error {
 
 // TodoOrderField defines the ordering field of Todo.
 type TodoOrderField struct {
-	field    string
+	field string
+	// expr returns the SQL expression of computed ordering fields.
+	expr     func(*sql.Selector) string
 	toCursor func(*Todo) Cursor
 }
 
+// column returns the ordering column (or expression) of the field in the given selector.
+func (f *TodoOrderField) column(s *sql.Selector) string {
+	if f.expr != nil {
+		return f.expr(s)
+	}
+	return s.C(f.field)
+}
+
 // TodoOrder defines the ordering of Todo.
 type TodoOrder struct {
 	Direction OrderDirection  `json:"direction"`
@@ -1096,13 +1633,14 @@ var DefaultTodoOrder = &TodoOrder{
 }
 
 // ToEdge converts Todo into TodoEdge.
-func (t *Todo) ToEdge(order *TodoOrder) *TodoEdge {
-	if order == nil {
-		order = DefaultTodoOrder
+func (t *Todo) ToEdge(order []*TodoOrder) *TodoEdge {
+	pager := &todoPager{order: order}
+	if len(pager.order) == 0 {
+		pager.order = []*TodoOrder{DefaultTodoOrder}
 	}
 	return &TodoEdge{
 		Node:   t,
-		Cursor: order.Field.toCursor(t),
+		Cursor: pager.toCursor(t),
 	}
 }
 
@@ -1207,7 +1745,7 @@ func newUserPager(opts []UserPaginateOption) (*userPager, error) {
 	return pager, nil
 }
 
-func (p *userPager) applyFilter(query *UserQuery) (*UserQuery, error) {
+func (p *userPager) applyFilter(ctx context.Context, query *UserQuery) (*UserQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
@@ -1220,8 +1758,8 @@ func (p *userPager) toCursor(u *User) Cursor {
 
 func (p *userPager) applyCursors(query *UserQuery, after, before *Cursor) *UserQuery {
 	for _, predicate := range cursorsToPredicates(
-		p.order.Direction, after, before,
-		p.order.Field.field, DefaultUserOrder.Field.field,
+		[]OrderDirection{p.order.Direction}, after, before,
+		[]func(*sql.Selector) string{p.order.Field.column}, DefaultUserOrder.Field.field,
 	) {
 		query = query.Where(predicate)
 	}
@@ -1233,24 +1771,26 @@ func (p *userPager) applyOrder(query *UserQuery, reverse bool) *UserQuery {
 	if reverse {
 		direction = direction.reverse()
 	}
-	query = query.Order(direction.orderFunc(p.order.Field.field))
+	query = query.Order(direction.orderFunc(p.order.Field.column))
 	if p.order.Field != DefaultUserOrder.Field {
-		query = query.Order(direction.orderFunc(DefaultUserOrder.Field.field))
+		query = query.Order(direction.orderFunc(DefaultUserOrder.Field.column))
 	}
 	return query
 }
 
-func (p *userPager) orderExpr(reverse bool) sql.Querier {
+func (p *userPager) orderExpr(reverse bool) func(*sql.Selector) sql.Querier {
 	direction := p.order.Direction
 	if reverse {
 		direction = direction.reverse()
 	}
-	return sql.ExprFunc(func(b *sql.Builder) {
-		b.Ident(p.order.Field.field).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultUserOrder.Field {
-			b.Comma().Ident(DefaultUserOrder.Field.field).Pad().WriteString(string(direction))
-		}
-	})
+	return func(s *sql.Selector) sql.Querier {
+		return sql.ExprFunc(func(b *sql.Builder) {
+			b.Ident(p.order.Field.column(s)).Pad().WriteString(string(direction))
+			if p.order.Field != DefaultUserOrder.Field {
+				b.Comma().Ident(DefaultUserOrder.Field.column(s)).Pad().WriteString(string(direction))
+			}
+		})
+	}
 }
 
 // Paginate executes the query and returns a relay based cursor connection to User.
@@ -1265,7 +1805,7 @@ func (u *UserQuery) Paginate(
 	if err != nil {
 		return nil, err
 	}
-	if u, err = pager.applyFilter(u); err != nil {
+	if u, err = pager.applyFilter(ctx, u); err != nil {
 		return nil, err
 	}
 	conn := &UserConnection{Edges: []*UserEdge{}}
@@ -1307,12 +1847,86 @@ func (u *UserQuery) Paginate(
 	return conn, nil
 }
 
+// UserPage is a page of User returned by offset-based pagination.
+type UserPage struct {
+	Nodes           []*User `json:"nodes"`
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	TotalCount      int     `json:"totalCount"`
+}
+
+// build sets the nodes of the page. The nodes are expected to start at the
+// given offset, and to include an extra node in case there is a next page.
+func (p *UserPage) build(nodes []*User, offset, limit *int) {
+	p.HasPreviousPage = offset != nil && *offset > 0
+	if limit != nil && *limit < len(nodes) {
+		p.HasNextPage = true
+		nodes = nodes[:*limit]
+	}
+	if nodes == nil {
+		nodes = []*User{}
+	}
+	p.Nodes = nodes
+	if p.TotalCount == 0 && !p.HasNextPage && !p.HasPreviousPage {
+		p.TotalCount = len(nodes)
+	}
+}
+
+// PaginateOffset executes the query and returns the page of User at the given offset.
+func (u *UserQuery) PaginateOffset(
+	ctx context.Context, offset, limit *int, opts ...UserPaginateOption,
+) (*UserPage, error) {
+	if err := validateOffsetLimit(offset, limit); err != nil {
+		return nil, err
+	}
+	pager, err := newUserPager(opts)
+	if err != nil {
+		return nil, err
+	}
+	if u, err = pager.applyFilter(ctx, u); err != nil {
+		return nil, err
+	}
+	page := &UserPage{Nodes: []*User{}}
+	if hasCollectedField(ctx, totalCountField) {
+		if page.TotalCount, err = u.Clone().Count(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if !hasCollectedField(ctx, nodesField) && !hasCollectedField(ctx, hasNextPageField) || limit != nil && *limit == 0 {
+		page.HasPreviousPage = offset != nil && *offset > 0
+		return page, nil
+	}
+	u = pager.applyOrder(u, false)
+	if offset != nil {
+		u.Offset(*offset)
+	}
+	if limit != nil {
+		u.Limit(*limit + 1)
+	}
+	if field := collectedField(ctx, nodesField); field != nil {
+		if err := u.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{nodesField}); err != nil {
+			return nil, err
+		}
+	}
+	nodes, err := u.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	page.build(nodes, offset, limit)
+	return page, nil
+}
+
 // UserOrderField defines the ordering field of User.
 type UserOrderField struct {
 	field    string
 	toCursor func(*User) Cursor
 }
 
+// column returns the ordering column (or expression) of the field in the given selector.
+func (f *UserOrderField) column(s *sql.Selector) string {
+	return s.C(f.field)
+}
+
 // UserOrder defines the ordering of User.
 type UserOrder struct {
 	Direction OrderDirection  `json:"direction"`
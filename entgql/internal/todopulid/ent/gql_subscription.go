@@ -0,0 +1,262 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"sync"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/contrib/entgql/internal/todopulid/ent/schema/pulid"
+	"entgo.io/contrib/entgql/internal/todopulid/ent/todo"
+	"entgo.io/ent/dialect"
+)
+
+// SubscriptionHook returns a hook that publishes the ids of the created, updated
+// and deleted nodes of the types annotated with entgql.Subscriptions to the given
+// pub/sub. Mutations executed in a transaction publish their events after the
+// transaction is committed, and rolled back transactions do not publish them.
+//
+//	client.Use(ent.SubscriptionHook(pubsub))
+func SubscriptionHook(ps entgql.PubSub) Hook {
+	return func(next Mutator) Mutator {
+		return MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			var (
+				v      Value
+				err    error
+				drv    dialect.Driver
+				events []subscriptionEvent
+			)
+			switch m := m.(type) {
+			case *TodoMutation:
+				drv = m.driver
+				v, events, err = todoSubscriptionEvents(ctx, next, m)
+			default:
+				return next.Mutate(ctx, m)
+			}
+			if err != nil {
+				return nil, err
+			}
+			if err := publishSubscriptionEvents(ctx, ps, drv, events); err != nil {
+				return nil, err
+			}
+			return v, nil
+		})
+	}
+}
+
+type subscriptionEvent struct {
+	topic string
+	id    interface{}
+}
+
+// publishSubscriptionEvents publishes the given events, or defers their publishing
+// to the commit of the transaction if the mutation was executed by a transactional
+// driver. Note that the *Tx returned by the Mutation.Tx method cannot be used for
+// this, as it is not the one that is committed by the user.
+func publishSubscriptionEvents(ctx context.Context, ps entgql.PubSub, drv dialect.Driver, events []subscriptionEvent) error {
+	publish := func() error {
+		for _, e := range events {
+			if err := ps.Publish(ctx, e.topic, e.id); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if len(events) == 0 {
+		return nil
+	}
+	txd, ok := drv.(*txDriver)
+	if !ok {
+		return publish()
+	}
+	tx, ok := txd.tx.(*subscriptionTx)
+	if !ok {
+		tx = &subscriptionTx{Tx: txd.tx}
+		txd.tx = tx
+	}
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+	tx.publish = append(tx.publish, publish)
+	return nil
+}
+
+// subscriptionTx wraps the underlying transaction of a transactional driver
+// and publishes the events of its mutations after it was committed. Events
+// of rolled back transactions are dropped.
+type subscriptionTx struct {
+	dialect.Tx
+	mu      sync.Mutex
+	publish []func() error
+}
+
+// Commit commits the underlying transaction and publishes the events.
+func (tx *subscriptionTx) Commit() error {
+	if err := tx.Tx.Commit(); err != nil {
+		return err
+	}
+	tx.mu.Lock()
+	publish := tx.publish
+	tx.publish = nil
+	tx.mu.Unlock()
+	for _, fn := range publish {
+		if err := fn(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SubscriptionResolver implements the resolvers of the subscription
+// fields generated by the entgql.Subscriptions annotation.
+type SubscriptionResolver struct {
+	client *Client
+	pubsub entgql.PubSub
+}
+
+// SubscriptionResolver returns a resolver for the generated subscription fields.
+// The resolver receives the ids published by the SubscriptionHook to the given
+// pub/sub, and loads their nodes using c.
+func (c *Client) SubscriptionResolver(ps entgql.PubSub) *SubscriptionResolver {
+	return &SubscriptionResolver{client: c, pubsub: ps}
+}
+
+// todoSubscriptionEvents executes the mutation and returns the events of the changed todos.
+func todoSubscriptionEvents(ctx context.Context, next Mutator, m *TodoMutation) (Value, []subscriptionEvent, error) {
+	var ids []pulid.ID
+	if m.Op().Is(OpUpdate | OpDelete | OpDeleteOne) {
+		var err error
+		if ids, err = m.IDs(ctx); err != nil {
+			return nil, nil, err
+		}
+	}
+	v, err := next.Mutate(ctx, m)
+	if err != nil {
+		return nil, nil, err
+	}
+	var event entgql.SubscriptionEvent
+	switch op := m.Op(); {
+	case op.Is(OpCreate):
+		event = entgql.SubscriptionCreated
+		if n, ok := v.(*Todo); ok {
+			ids = append(ids, n.ID)
+		}
+	case op.Is(OpUpdateOne):
+		event = entgql.SubscriptionUpdated
+		if id, ok := m.ID(); ok {
+			ids = append(ids, id)
+		}
+	case op.Is(OpUpdate):
+		event = entgql.SubscriptionUpdated
+	default:
+		event = entgql.SubscriptionDeleted
+	}
+	events := make([]subscriptionEvent, len(ids))
+	for i := range ids {
+		events[i] = subscriptionEvent{
+			topic: entgql.SubscriptionTopic("Todo", event),
+			id:    ids[i],
+		}
+	}
+	return v, events, nil
+}
+
+// TodoCreated resolves the "todoCreated" subscription field.
+func (r *SubscriptionResolver) TodoCreated(ctx context.Context) (<-chan *Todo, error) {
+	ids, err := r.pubsub.Subscribe(ctx, entgql.SubscriptionTopic("Todo", entgql.SubscriptionCreated))
+	if err != nil {
+		return nil, err
+	}
+	ch := make(chan *Todo)
+	go func() {
+		defer close(ch)
+		for v := range ids {
+			nid, ok := v.(pulid.ID)
+			if !ok {
+				continue
+			}
+			n, err := r.client.Todo.Query().
+				Where(todo.ID(nid)).
+				excludeDeleted(ctx).
+				Only(ctx)
+			if err != nil {
+				continue
+			}
+			select {
+			case ch <- n:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// TodoUpdated resolves the "todoUpdated" subscription field.
+func (r *SubscriptionResolver) TodoUpdated(ctx context.Context, id *pulid.ID) (<-chan *Todo, error) {
+	ids, err := r.pubsub.Subscribe(ctx, entgql.SubscriptionTopic("Todo", entgql.SubscriptionUpdated))
+	if err != nil {
+		return nil, err
+	}
+	ch := make(chan *Todo)
+	go func() {
+		defer close(ch)
+		for v := range ids {
+			nid, ok := v.(pulid.ID)
+			if !ok || id != nil && *id != nid {
+				continue
+			}
+			n, err := r.client.Todo.Query().
+				Where(todo.ID(nid)).
+				excludeDeleted(ctx).
+				Only(ctx)
+			if err != nil {
+				continue
+			}
+			select {
+			case ch <- n:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// TodoDeleted resolves the "todoDeleted" subscription field.
+func (r *SubscriptionResolver) TodoDeleted(ctx context.Context) (<-chan pulid.ID, error) {
+	ids, err := r.pubsub.Subscribe(ctx, entgql.SubscriptionTopic("Todo", entgql.SubscriptionDeleted))
+	if err != nil {
+		return nil, err
+	}
+	ch := make(chan pulid.ID)
+	go func() {
+		defer close(ch)
+		for v := range ids {
+			id, ok := v.(pulid.ID)
+			if !ok {
+				continue
+			}
+			select {
+			case ch <- id:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
@@ -17,8 +17,11 @@ package schema
 import (
 	"time"
 
+	"entgo.io/contrib/entgql"
+	todoschema "entgo.io/contrib/entgql/internal/todo/ent/schema"
 	"entgo.io/contrib/entgql/internal/todopulid/ent/schema/pulid"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -28,11 +31,22 @@ type Friendship struct {
 	ent.Schema
 }
 
+// Mixin returns friendship mixed-in schema.
+func (Friendship) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		pulid.MixinWithPrefix("FS"),
+		todoschema.TimestampedMixin{},
+	}
+}
+
 // Fields of the Friendship.
 func (Friendship) Fields() []ent.Field {
 	return []ent.Field{
 		field.Time("created_at").
-			Default(time.Now),
+			Default(time.Now).
+			Annotations(
+				entgql.OrderField("CREATED_AT"),
+			),
 		field.String("user_id").GoType(pulid.ID("")),
 		field.String("friend_id").GoType(pulid.ID("")),
 	}
@@ -51,3 +65,10 @@ func (Friendship) Edges() []ent.Edge {
 			Field("friend_id"),
 	}
 }
+
+// Annotations returns Friendship annotations.
+func (Friendship) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.RelayConnection(),
+	}
+}
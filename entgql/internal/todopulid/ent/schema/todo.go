@@ -33,6 +33,7 @@ func (Todo) Mixin() []ent.Mixin {
 		pulid.MixinWithPrefix("TD"),
 		// Reuse the fields and edges from base example.
 		todoschema.FilterFields(todoschema.Todo{}, "category_id"),
+		todoschema.TimestampedMixin{},
 	}
 }
 
@@ -15,6 +15,7 @@
 package schema
 
 import (
+	"entgo.io/contrib/entgql"
 	"entgo.io/contrib/entgql/internal/todo/ent/schema"
 	"entgo.io/contrib/entgql/internal/todopulid/ent/schema/pulid"
 	"entgo.io/ent"
@@ -38,6 +39,7 @@ func (User) Mixin() []ent.Mixin {
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("friends", User.Type).
-			Through("friendships", Friendship.Type),
+			Through("friendships", Friendship.Type).
+			Annotations(entgql.OffsetPagination()),
 	}
 }
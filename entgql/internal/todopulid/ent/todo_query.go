@@ -42,13 +42,14 @@ type TodoQuery struct {
 	fields     []string
 	predicates []predicate.Todo
 	// eager-loading edges.
-	withParent   *TodoQuery
-	withChildren *TodoQuery
-	withCategory *CategoryQuery
-	withSecret   *VerySecretQuery
-	withFKs      bool
-	modifiers    []func(*sql.Selector)
-	loadTotal    []func(context.Context, []*Todo) error
+	withParent      *TodoQuery
+	withChildren    *TodoQuery
+	withCategory    *CategoryQuery
+	withSecret      *VerySecretQuery
+	withFKs         bool
+	modifiers       []func(*sql.Selector)
+	loadOrderValues []func(context.Context, []*Todo) error
+	loadTotal       []func(context.Context, []*Todo) error
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -628,6 +629,11 @@ func (tq *TodoQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Todo, e
 		}
 	}
 
+	for i := range tq.loadOrderValues {
+		if err := tq.loadOrderValues[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
 	for i := range tq.loadTotal {
 		if err := tq.loadTotal[i](ctx, nodes); err != nil {
 			return nil, err
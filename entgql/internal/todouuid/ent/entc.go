@@ -46,7 +46,7 @@ func main() {
 			//
 			// Code generated by entc, DO NOT EDIT.
 		`,
-	}, entc.Extensions(ex))
+	}, entc.Extensions(ex), entc.FeatureNames("sql/upsert"))
 	if err != nil {
 		log.Fatalf("running ent codegen: %v", err)
 	}
@@ -17,19 +17,169 @@
 package ent
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/contrib/entgql/internal/todo/ent/schema/schematype"
+	"entgo.io/contrib/entgql/internal/todouuid/ent/category"
 	"entgo.io/contrib/entgql/internal/todouuid/ent/todo"
+	"entgo.io/ent/dialect/sql"
+	"github.com/99designs/gqlgen/graphql/errcode"
 	"github.com/google/uuid"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
+// CreateCategoryInput represents a mutation input for creating categories.
+type CreateCategoryInput struct {
+	Text     string
+	Status   category.Status
+	Config   *schematype.CategoryConfig
+	Duration *time.Duration
+	Count    *uint64
+	Strings  *[]string
+	Types    *schematype.CategoryTypes
+	Slug     *string
+	TodoIDs  []uuid.UUID
+}
+
+// Mutate applies the CreateCategoryInput on the CategoryMutation builder.
+func (i *CreateCategoryInput) Mutate(m *CategoryMutation) {
+	m.SetText(i.Text)
+	m.SetStatus(i.Status)
+	m.SetConfig(i.Config)
+	if v := i.Duration; v != nil {
+		m.SetDuration(*v)
+	}
+	if v := i.Count; v != nil {
+		m.SetCount(*v)
+	}
+	if v := i.Strings; v != nil {
+		m.SetStrings(*v)
+	}
+	m.SetTypes(i.Types)
+	if v := i.Slug; v != nil {
+		m.SetSlug(*v)
+	}
+	if v := i.TodoIDs; len(v) > 0 {
+		m.AddTodoIDs(v...)
+	}
+}
+
+// Validate checks the fields of the CreateCategoryInput (and its nested inputs) using the validators
+// of the Category schema, and returns a gqlerror.List with an entgql.ErrInvalidInput
+// error for each invalid field. The paths of the errors are relative to the "input" argument.
+func (i *CreateCategoryInput) Validate() error {
+	if errs := i.validate(ast.Path{ast.PathName("input")}); len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validate checks the fields of the CreateCategoryInput at the given input path.
+func (i *CreateCategoryInput) validate(path ast.Path) gqlerror.List {
+	var errs gqlerror.List
+	if err := category.TextValidator(i.Text); err != nil {
+		errs = append(errs, entgql.ErrInvalidInput(inputPath(path, ast.PathName("text")), err))
+	}
+	if err := category.StatusValidator(i.Status); err != nil {
+		errs = append(errs, entgql.ErrInvalidInput(inputPath(path, ast.PathName("status")), err))
+	}
+	return errs
+}
+
+// SetInput applies the change-set in the CreateCategoryInput on the CategoryCreate builder.
+func (c *CategoryCreate) SetInput(i CreateCategoryInput) *CategoryCreate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// UpsertCategoryInput represents a mutation input for creating categories.
+type UpsertCategoryInput struct {
+	Text     string
+	Status   category.Status
+	Config   *schematype.CategoryConfig
+	Duration *time.Duration
+	Count    *uint64
+	Strings  *[]string
+	Types    *schematype.CategoryTypes
+	Slug     string
+}
+
+// Mutate applies the UpsertCategoryInput on the CategoryMutation builder.
+func (i *UpsertCategoryInput) Mutate(m *CategoryMutation) {
+	m.SetText(i.Text)
+	m.SetStatus(i.Status)
+	m.SetConfig(i.Config)
+	if v := i.Duration; v != nil {
+		m.SetDuration(*v)
+	}
+	if v := i.Count; v != nil {
+		m.SetCount(*v)
+	}
+	if v := i.Strings; v != nil {
+		m.SetStrings(*v)
+	}
+	m.SetTypes(i.Types)
+	m.SetSlug(i.Slug)
+}
+
+// Validate checks the fields of the UpsertCategoryInput (and its nested inputs) using the validators
+// of the Category schema, and returns a gqlerror.List with an entgql.ErrInvalidInput
+// error for each invalid field. The paths of the errors are relative to the "input" argument.
+func (i *UpsertCategoryInput) Validate() error {
+	if errs := i.validate(ast.Path{ast.PathName("input")}); len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validate checks the fields of the UpsertCategoryInput at the given input path.
+func (i *UpsertCategoryInput) validate(path ast.Path) gqlerror.List {
+	var errs gqlerror.List
+	if err := category.TextValidator(i.Text); err != nil {
+		errs = append(errs, entgql.ErrInvalidInput(inputPath(path, ast.PathName("text")), err))
+	}
+	if err := category.StatusValidator(i.Status); err != nil {
+		errs = append(errs, entgql.ErrInvalidInput(inputPath(path, ast.PathName("status")), err))
+	}
+	return errs
+}
+
+// UpsertInput creates a new Category from the UpsertCategoryInput, or updates the fields of the existing
+// Category that conflicts with it on the slug field, and returns the resulting node.
+func (c *CategoryClient) UpsertInput(ctx context.Context, i UpsertCategoryInput) (*Category, error) {
+	create := c.Create()
+	i.Mutate(create.Mutation())
+	err := create.
+		OnConflict(sql.ConflictColumns(category.FieldSlug)).
+		UpdateNewValues().
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// The node is queried by its conflict fields, as the
+	// database may not report the ID of an updated row.
+	return c.Query().
+		Where(category.SlugEQ(i.Slug)).
+		Only(ctx)
+}
+
 // CreateTodoInput represents a mutation input for creating todos.
 type CreateTodoInput struct {
-	Status     todo.Status
-	Priority   *int
-	Text       string
-	ParentID   *uuid.UUID
-	ChildIDs   []uuid.UUID
-	CategoryID *uuid.UUID
-	SecretID   *uuid.UUID
+	Status         todo.Status
+	Priority       *int
+	Text           string
+	Label          *string
+	ParentID       *uuid.UUID
+	ChildIDs       []uuid.UUID
+	CategoryID     *uuid.UUID
+	SecretID       *uuid.UUID
+	CreateChildren []*CreateTodoInput
+	CreateCategory *CreateCategoryInput
 }
 
 // Mutate applies the CreateTodoInput on the TodoMutation builder.
@@ -39,6 +189,9 @@ func (i *CreateTodoInput) Mutate(m *TodoMutation) {
 		m.SetPriority(*v)
 	}
 	m.SetText(i.Text)
+	if v := i.Label; v != nil {
+		m.SetLabel(*v)
+	}
 	if v := i.ParentID; v != nil {
 		m.SetParentID(*v)
 	}
@@ -53,8 +206,278 @@ func (i *CreateTodoInput) Mutate(m *TodoMutation) {
 	}
 }
 
+// Validate checks the fields of the CreateTodoInput (and its nested inputs) using the validators
+// of the Todo schema, and returns a gqlerror.List with an entgql.ErrInvalidInput
+// error for each invalid field. The paths of the errors are relative to the "input" argument.
+func (i *CreateTodoInput) Validate() error {
+	if errs := i.validate(ast.Path{ast.PathName("input")}); len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validate checks the fields of the CreateTodoInput at the given input path.
+func (i *CreateTodoInput) validate(path ast.Path) gqlerror.List {
+	var errs gqlerror.List
+	if err := todo.StatusValidator(i.Status); err != nil {
+		errs = append(errs, entgql.ErrInvalidInput(inputPath(path, ast.PathName("status")), err))
+	}
+	if err := todo.TextValidator(i.Text); err != nil {
+		errs = append(errs, entgql.ErrInvalidInput(inputPath(path, ast.PathName("text")), err))
+	}
+	for j, v := range i.CreateChildren {
+		errs = append(errs, v.validate(inputPath(path, ast.PathName("createChildren"), ast.PathIndex(j)))...)
+	}
+	if v := i.CreateCategory; v != nil {
+		errs = append(errs, v.validate(inputPath(path, ast.PathName("createCategory")))...)
+	}
+	return errs
+}
+
+// createEdges returns a hook that creates the nested edge neighbors of the CreateTodoInput
+// using the client of the mutation, and adds them to the mutation before it is executed.
+func (i *CreateTodoInput) createEdges(next Mutator) Mutator {
+	return MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+		mutation, ok := m.(*TodoMutation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+		client := mutation.Client()
+		if v := i.CreateChildren; len(v) > 0 {
+			builders := make([]*TodoCreate, len(v))
+			for j := range v {
+				builders[j] = client.Todo.Create().SetInput(*v[j])
+			}
+			nodes, err := client.Todo.CreateBulk(builders...).Save(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("ent: creating children of CreateTodoInput: %w", err)
+			}
+			for _, n := range nodes {
+				mutation.AddChildIDs(n.ID)
+			}
+		}
+		if v := i.CreateCategory; v != nil {
+			if _, exists := mutation.CategoryID(); exists {
+				return nil, errors.New("ent: categoryID and createCategory cannot be set together in CreateTodoInput")
+			}
+			n, err := client.Category.Create().SetInput(*v).Save(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("ent: creating category of CreateTodoInput: %w", err)
+			}
+			mutation.SetCategoryID(n.ID)
+		}
+		return next.Mutate(ctx, m)
+	})
+}
+
 // SetInput applies the change-set in the CreateTodoInput on the TodoCreate builder.
+// The nested edge neighbors of the input are created when the builder is saved.
 func (c *TodoCreate) SetInput(i CreateTodoInput) *TodoCreate {
 	i.Mutate(c.Mutation())
+	c.hooks = append(c.hooks, i.createEdges)
+	return c
+}
+
+// UpdateTodoInput represents a mutation input for updating todos.
+type UpdateTodoInput struct {
+	Status         *todo.Status
+	Priority       *int
+	Text           *string
+	Version        *int
+	ClearLabel     bool
+	Label          *string
+	ClearParent    bool
+	ParentID       *uuid.UUID
+	AddChildIDs    []uuid.UUID
+	RemoveChildIDs []uuid.UUID
+	ClearCategory  bool
+	CategoryID     *uuid.UUID
+	ClearSecret    bool
+	SecretID       *uuid.UUID
+	CreateChildren []*CreateTodoInput
+	CreateCategory *CreateCategoryInput
+}
+
+// Mutate applies the UpdateTodoInput on the TodoMutation builder.
+func (i *UpdateTodoInput) Mutate(m *TodoMutation) {
+	if v := i.Status; v != nil {
+		m.SetStatus(*v)
+	}
+	if v := i.Priority; v != nil {
+		m.SetPriority(*v)
+	}
+	if v := i.Text; v != nil {
+		m.SetText(*v)
+	}
+	m.AddVersion(1)
+	if i.ClearLabel {
+		m.ClearLabel()
+	}
+	if v := i.Label; v != nil {
+		m.SetLabel(*v)
+	}
+	if i.ClearParent {
+		m.ClearParent()
+	}
+	if v := i.ParentID; v != nil {
+		m.SetParentID(*v)
+	}
+	if v := i.AddChildIDs; len(v) > 0 {
+		m.AddChildIDs(v...)
+	}
+	if v := i.RemoveChildIDs; len(v) > 0 {
+		m.RemoveChildIDs(v...)
+	}
+	if i.ClearCategory {
+		m.ClearCategory()
+	}
+	if v := i.CategoryID; v != nil {
+		m.SetCategoryID(*v)
+	}
+	if i.ClearSecret {
+		m.ClearSecret()
+	}
+	if v := i.SecretID; v != nil {
+		m.SetSecretID(*v)
+	}
+}
+
+// Validate checks the fields of the UpdateTodoInput (and its nested inputs) using the validators
+// of the Todo schema, and returns a gqlerror.List with an entgql.ErrInvalidInput
+// error for each invalid field. The paths of the errors are relative to the "input" argument.
+func (i *UpdateTodoInput) Validate() error {
+	if errs := i.validate(ast.Path{ast.PathName("input")}); len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validate checks the fields of the UpdateTodoInput at the given input path.
+func (i *UpdateTodoInput) validate(path ast.Path) gqlerror.List {
+	var errs gqlerror.List
+	if v := i.Status; v != nil {
+		if err := todo.StatusValidator(*v); err != nil {
+			errs = append(errs, entgql.ErrInvalidInput(inputPath(path, ast.PathName("status")), err))
+		}
+	}
+	if v := i.Text; v != nil {
+		if err := todo.TextValidator(*v); err != nil {
+			errs = append(errs, entgql.ErrInvalidInput(inputPath(path, ast.PathName("text")), err))
+		}
+	}
+	for j, v := range i.CreateChildren {
+		errs = append(errs, v.validate(inputPath(path, ast.PathName("createChildren"), ast.PathIndex(j)))...)
+	}
+	if v := i.CreateCategory; v != nil {
+		errs = append(errs, v.validate(inputPath(path, ast.PathName("createCategory")))...)
+	}
+	return errs
+}
+
+// createEdges returns a hook that creates the nested edge neighbors of the UpdateTodoInput
+// using the client of the mutation, and adds them to the mutation before it is executed.
+func (i *UpdateTodoInput) createEdges(next Mutator) Mutator {
+	return MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+		mutation, ok := m.(*TodoMutation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+		client := mutation.Client()
+		if v := i.CreateChildren; len(v) > 0 {
+			builders := make([]*TodoCreate, len(v))
+			for j := range v {
+				builders[j] = client.Todo.Create().SetInput(*v[j])
+			}
+			nodes, err := client.Todo.CreateBulk(builders...).Save(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("ent: creating children of UpdateTodoInput: %w", err)
+			}
+			for _, n := range nodes {
+				mutation.AddChildIDs(n.ID)
+			}
+		}
+		if v := i.CreateCategory; v != nil {
+			if _, exists := mutation.CategoryID(); exists {
+				return nil, errors.New("ent: categoryID and createCategory cannot be set together in UpdateTodoInput")
+			}
+			n, err := client.Category.Create().SetInput(*v).Save(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("ent: creating category of UpdateTodoInput: %w", err)
+			}
+			mutation.SetCategoryID(n.ID)
+		}
+		return next.Mutate(ctx, m)
+	})
+}
+
+// checkVersion returns a hook that executes the guarded update of the TodoUpdateOne
+// builder, and fails the mutation with a version conflict error if the node exists but
+// its version does not match the UpdateTodoInput.
+func (i *UpdateTodoInput) checkVersion(next Mutator) Mutator {
+	return MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+		mutation, ok := m.(*TodoMutation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+		id, ok := mutation.ID()
+		if !ok {
+			return next.Mutate(ctx, m)
+		}
+		if i.Version == nil {
+			return nil, entgql.ErrInvalidInput(
+				ast.Path{ast.PathName("input"), ast.PathName("version")},
+				fmt.Errorf("the version of the Todo is required"),
+			)
+		}
+		// TodoUpdateOne queries the node with the mutation predicates after it
+		// was updated. Hence, the update is executed by a TodoUpdate builder that
+		// reports the number of affected nodes, and the node is queried afterwards.
+		mutation.Where(todo.ID(id), todo.VersionEQ(*i.Version))
+		affected, err := (&TodoUpdate{config: mutation.config, mutation: mutation}).Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		client := mutation.Client()
+		if affected == 0 {
+			exists, err := client.Todo.Query().Where(todo.ID(id)).Exist(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if !exists {
+				return nil, &NotFoundError{todo.Label}
+			}
+			gqlErr := &gqlerror.Error{
+				Message: "The Todo was modified after the given version.",
+			}
+			errcode.Set(gqlErr, errVersionConflict)
+			return nil, gqlErr
+		}
+		return client.Todo.Get(ctx, id)
+	})
+}
+
+// SetInput applies the change-set in the UpdateTodoInput on the TodoUpdate builder.
+// The nested edge neighbors of the input are created when the builder is saved.
+func (c *TodoUpdate) SetInput(i UpdateTodoInput) *TodoUpdate {
+	i.Mutate(c.Mutation())
+	c.hooks = append(c.hooks, i.createEdges)
 	return c
 }
+
+// SetInput applies the change-set in the UpdateTodoInput on the TodoUpdateOne builder.
+// The nested edge neighbors of the input are created when the builder is saved.
+// A version conflict error is returned if the Todo was modified
+// after the expected version.
+func (c *TodoUpdateOne) SetInput(i UpdateTodoInput) *TodoUpdateOne {
+	i.Mutate(c.Mutation())
+	c.hooks = append(c.hooks, i.createEdges)
+	c.hooks = append(c.hooks, i.checkVersion)
+	return c
+}
+
+// inputPath returns a copy of the given input path with the given elements appended.
+func inputPath(path ast.Path, elems ...ast.PathElement) ast.Path {
+	return append(append(make(ast.Path, 0, len(path)+len(elems)), path...), elems...)
+}
+
+const errVersionConflict = "VERSION_CONFLICT"
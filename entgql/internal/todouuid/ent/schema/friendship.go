@@ -17,7 +17,10 @@ package schema
 import (
 	"time"
 
+	"entgo.io/contrib/entgql"
+	todoschema "entgo.io/contrib/entgql/internal/todo/ent/schema"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -28,11 +31,23 @@ type Friendship struct {
 	ent.Schema
 }
 
+// Mixin returns friendship mixed-in schema.
+func (Friendship) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		todoschema.TimestampedMixin{},
+	}
+}
+
 // Fields of the Friendship.
 func (Friendship) Fields() []ent.Field {
 	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
 		field.Time("created_at").
-			Default(time.Now),
+			Default(time.Now).
+			Annotations(
+				entgql.OrderField("CREATED_AT"),
+			),
 		field.UUID("user_id", uuid.UUID{}),
 		field.UUID("friend_id", uuid.UUID{}),
 	}
@@ -51,3 +66,10 @@ func (Friendship) Edges() []ent.Edge {
 			Field("friend_id"),
 	}
 }
+
+// Annotations returns Friendship annotations.
+func (Friendship) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.RelayConnection(),
+	}
+}
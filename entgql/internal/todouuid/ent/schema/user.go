@@ -15,6 +15,7 @@
 package schema
 
 import (
+	"entgo.io/contrib/entgql"
 	"entgo.io/contrib/entgql/internal/todo/ent/schema"
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
@@ -47,6 +48,7 @@ func (User) Fields() []ent.Field {
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("friends", User.Type).
-			Through("friendships", Friendship.Type),
+			Through("friendships", Friendship.Type).
+			Annotations(entgql.OffsetPagination()),
 	}
 }
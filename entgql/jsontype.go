@@ -0,0 +1,452 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"fmt"
+	goast "go/ast"
+	"go/parser"
+	"go/types"
+	"reflect"
+	"sort"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
+	"github.com/vektah/gqlparser/v2/ast"
+	"golang.org/x/tools/go/packages"
+)
+
+// jsonType holds the GraphQL types of a JSON field
+// that were derived from the Go type of the field.
+type jsonType struct {
+	// Output and Input are the GraphQL types of the field
+	// in the object type and in the input types.
+	Output, Input string
+	// Profiles holds the profiles of the field, or the profiles
+	// of its type if the field is not annotated with Profiles.
+	Profiles []string
+}
+
+// jsonTypes derives the GraphQL object and input types of the JSON fields
+// that are not mapped to GraphQL types (e.g. using the entgql.Type annotation
+// or the gqlgen.yml models), from the Go types of the fields. The Go types
+// are loaded using go/packages, as the gen.Field holds only their names.
+type jsonTypes struct {
+	fields map[*gen.Field]*jsonType
+	// defs holds the derived GraphQL types, and named holds
+	// the GraphQL names of their Go struct types.
+	defs  []*ast.Definition
+	named map[*types.TypeName]string
+	// pkgs holds the loaded packages by their paths.
+	pkgs map[string]*types.Package
+}
+
+// buildJSONTypes derives the GraphQL types of the JSON fields in the graph. The
+// mapping from the fields to the GraphQL types is used by mapScalar, and the
+// derived types are added to the schema by addJSONTypes.
+func (e *schemaGenerator) buildJSONTypes(g *gen.Graph) error {
+	e.jsonTypes = &jsonTypes{
+		fields: make(map[*gen.Field]*jsonType),
+		pkgs:   make(map[string]*types.Package),
+		named:  make(map[*types.TypeName]string),
+	}
+	for _, n := range g.Nodes {
+		nodeAnt, err := annotation(n.Annotations)
+		if err != nil {
+			return err
+		}
+		for _, f := range n.Fields {
+			if !f.IsJSON() || f.Type.RType == nil || !strings.ContainsRune(f.Type.Ident, '.') {
+				continue
+			}
+			ant, err := annotation(f.Annotations)
+			if err != nil {
+				return err
+			}
+			if ant.Type != "" || ant.Skip.Is(SkipAll) {
+				continue
+			}
+			if _, ok := e.hasMapping(f, nonInputObjectFilter); ok {
+				continue
+			}
+			t, err := e.jsonTypes.fieldType(f)
+			if err != nil {
+				return fmt.Errorf("entgql: JSON field %s.%s: %w", n.Name, f.Name, err)
+			}
+			t.Profiles = ant.Profiles
+			if len(t.Profiles) == 0 {
+				t.Profiles = nodeAnt.Profiles
+			}
+			e.jsonTypes.fields[f] = t
+		}
+	}
+	return nil
+}
+
+// addJSONTypes adds the GraphQL types that were derived by buildJSONTypes to the schema.
+func (e *schemaGenerator) addJSONTypes(s *ast.Schema) error {
+	if e.jsonTypes == nil {
+		return nil
+	}
+	for _, def := range e.jsonTypes.defs {
+		if s.Types[def.Name] != nil {
+			return fmt.Errorf("entgql: found the GQL type conflict for the JSON type %s, please use the entgql.Type() annotation to map the JSON field to another GQL type", def.Name)
+		}
+		s.AddTypes(def)
+	}
+	e.restrictJSONTypes()
+	return nil
+}
+
+// restrictJSONTypes limits the visibility of the derived types to the profiles
+// of the JSON fields that use them, directly or through other derived types.
+// Types that are used by a field without profiles are visible in all profiles.
+func (e *schemaGenerator) restrictJSONTypes() {
+	defs := make(map[string]*ast.Definition, len(e.jsonTypes.defs))
+	for _, def := range e.jsonTypes.defs {
+		defs[def.Name] = def
+	}
+	var (
+		public   = make(map[string]bool)
+		profiles = make(map[string]map[string]bool)
+	)
+	for _, t := range e.jsonTypes.fields {
+		for name := range reachableDefs(defs, t.Output, t.Input) {
+			if len(t.Profiles) == 0 {
+				public[name] = true
+				continue
+			}
+			if profiles[name] == nil {
+				profiles[name] = make(map[string]bool)
+			}
+			for _, p := range t.Profiles {
+				profiles[name][p] = true
+			}
+		}
+	}
+	for name, used := range profiles {
+		if public[name] {
+			continue
+		}
+		names := make([]string, 0, len(used))
+		for p := range used {
+			names = append(names, p)
+		}
+		sort.Strings(names)
+		e.restrict(names, defs[name])
+	}
+}
+
+// reachableDefs returns the names of the given definitions that are
+// reachable from the given GraphQL types, through the types of their fields.
+func reachableDefs(defs map[string]*ast.Definition, roots ...string) map[string]bool {
+	var (
+		seen  = make(map[string]bool)
+		queue = make([]string, 0, len(roots))
+	)
+	for _, t := range roots {
+		queue = append(queue, strings.Trim(t, "[]!"))
+	}
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		def, ok := defs[name]
+		if !ok || seen[name] {
+			continue
+		}
+		seen[name] = true
+		for _, f := range def.Fields {
+			queue = append(queue, f.Type.Name())
+		}
+	}
+	return seen
+}
+
+// fieldType returns the GraphQL types of the given JSON field.
+func (j *jsonTypes) fieldType(f *gen.Field) (*jsonType, error) {
+	expr, err := parser.ParseExpr(f.Type.Ident)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Go type %q: %w", f.Type.Ident, err)
+	}
+	typ, err := j.goType(expr, f.Type.PkgPath)
+	if err != nil {
+		return nil, err
+	}
+	output, err := j.typeOf(typ, false)
+	if err != nil {
+		return nil, err
+	}
+	input, err := j.typeOf(typ, true)
+	if err != nil {
+		return nil, err
+	}
+	return &jsonType{Output: output, Input: input}, nil
+}
+
+// goType returns the Go type of the given type expression, where its
+// qualified identifiers are looked up in the package with the given path.
+func (j *jsonTypes) goType(expr goast.Expr, pkgPath string) (types.Type, error) {
+	switch x := expr.(type) {
+	case *goast.StarExpr:
+		t, err := j.goType(x.X, pkgPath)
+		if err != nil {
+			return nil, err
+		}
+		return types.NewPointer(t), nil
+	case *goast.ArrayType:
+		t, err := j.goType(x.Elt, pkgPath)
+		if err != nil {
+			return nil, err
+		}
+		return types.NewSlice(t), nil
+	case *goast.MapType:
+		k, err := j.goType(x.Key, pkgPath)
+		if err != nil {
+			return nil, err
+		}
+		v, err := j.goType(x.Value, pkgPath)
+		if err != nil {
+			return nil, err
+		}
+		return types.NewMap(k, v), nil
+	case *goast.InterfaceType:
+		return types.NewInterfaceType(nil, nil), nil
+	case *goast.Ident:
+		if obj := types.Universe.Lookup(x.Name); obj != nil {
+			return obj.Type(), nil
+		}
+	case *goast.SelectorExpr:
+		if pkgPath == "" {
+			return nil, fmt.Errorf("package of type %s.%s is unknown", x.X, x.Sel.Name)
+		}
+		pkg, err := j.load(pkgPath)
+		if err != nil {
+			return nil, err
+		}
+		if obj := pkg.Scope().Lookup(x.Sel.Name); obj != nil {
+			return obj.Type(), nil
+		}
+		return nil, fmt.Errorf("type %s was not found in package %s", x.Sel.Name, pkgPath)
+	}
+	return nil, fmt.Errorf("unexpected Go type expression %T", expr)
+}
+
+// load loads the package with the given path.
+func (j *jsonTypes) load(path string) (*types.Package, error) {
+	if pkg, ok := j.pkgs[path]; ok {
+		return pkg, nil
+	}
+	pkg, err := loadPackage(path)
+	if err != nil {
+		return nil, err
+	}
+	j.pkgs[path] = pkg
+	return pkg, nil
+}
+
+// loadPackage loads the type information of the package with the
+// given path using go/packages. It is replaced in tests with fixtures.
+var loadPackage = func(path string) (*types.Package, error) {
+	pkgs, err := packages.Load(&packages.Config{
+		Mode: packages.NeedSyntax | packages.NeedTypes | packages.NeedTypesInfo | packages.NeedDeps,
+	}, path)
+	if err != nil {
+		return nil, fmt.Errorf("loading package %s: %w", path, err)
+	}
+	if len(pkgs) != 1 || pkgs[0].Types == nil {
+		return nil, fmt.Errorf("package %s was not found", path)
+	}
+	if errs := pkgs[0].Errors; len(errs) > 0 {
+		return nil, fmt.Errorf("loading package %s: %w", path, errs[0])
+	}
+	if pkgs[0].IllTyped {
+		return nil, fmt.Errorf("package %s is ill-typed", path)
+	}
+	return pkgs[0].Types, nil
+}
+
+// typeOf returns the GraphQL type of the given Go type in the object
+// type (or the input type), without its outer non-null modifier.
+func (j *jsonTypes) typeOf(t types.Type, input bool) (string, error) {
+	switch t := t.(type) {
+	case *types.Pointer:
+		return j.typeOf(t.Elem(), input)
+	case *types.Slice:
+		// Byte slices are encoded as base64 strings.
+		if b, ok := t.Elem().(*types.Basic); ok && b.Kind() == types.Byte {
+			break
+		}
+		return j.listOf(t.Elem(), input)
+	case *types.Array:
+		return j.listOf(t.Elem(), input)
+	case *types.Map:
+		if k, ok := t.Key().(*types.Basic); ok && k.Kind() == types.String && isEmptyInterface(t.Elem()) {
+			return "Map", nil
+		}
+	case *types.Interface:
+		if t.Empty() {
+			return "Any", nil
+		}
+	case *types.Basic:
+		switch info := t.Info(); {
+		case info&types.IsBoolean != 0:
+			return "Boolean", nil
+		case info&types.IsInteger != 0:
+			return "Int", nil
+		case info&types.IsFloat != 0:
+			return "Float", nil
+		case info&types.IsString != 0:
+			return "String", nil
+		}
+	case *types.Named:
+		obj := t.Obj()
+		if obj.Pkg() != nil && obj.Pkg().Path() == "time" && obj.Name() == "Time" {
+			return "Time", nil
+		}
+		st, ok := t.Underlying().(*types.Struct)
+		if !ok {
+			return j.typeOf(t.Underlying(), input)
+		}
+		name, err := j.object(obj, st)
+		if err != nil {
+			return "", err
+		}
+		if input {
+			return name + "Input", nil
+		}
+		return name, nil
+	}
+	return "", fmt.Errorf("Go type %s is not supported, use the entgql.Type annotation to map it to a GraphQL type", t)
+}
+
+// listOf returns the GraphQL list type of the given Go element type.
+func (j *jsonTypes) listOf(elem types.Type, input bool) (string, error) {
+	t, err := j.typeOf(elem, input)
+	if err != nil {
+		return "", err
+	}
+	if nullable(elem) {
+		return "[" + t + "]", nil
+	}
+	return "[" + t + "!]", nil
+}
+
+// object derives the GraphQL object and input types of the given Go
+// struct type (if they were not derived before), and returns the name
+// of the object type.
+func (j *jsonTypes) object(obj *types.TypeName, st *types.Struct) (string, error) {
+	if name, ok := j.named[obj]; ok {
+		return name, nil
+	}
+	name := obj.Name()
+	j.named[obj] = name
+	model := obj.Pkg().Path() + "." + obj.Name()
+	def := &ast.Definition{
+		Name:       name,
+		Kind:       ast.Object,
+		Directives: []*ast.Directive{goModel(model)},
+	}
+	inputDef := &ast.Definition{
+		Name:        name + "Input",
+		Kind:        ast.InputObject,
+		Description: fmt.Sprintf("%sInput is used for the %s JSON type.\nInput was generated by ent.", name, name),
+		Directives:  []*ast.Directive{goModel(model)},
+	}
+	j.defs = append(j.defs, def, inputDef)
+	if err := j.structFields(def, inputDef, st); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// structFields adds the fields of the given Go struct to the GraphQL object and input types.
+// The names of the fields and their nullability are derived from their "json" tags.
+func (j *jsonTypes) structFields(def, inputDef *ast.Definition, st *types.Struct) error {
+	for i := 0; i < st.NumFields(); i++ {
+		f := st.Field(i)
+		name, opts := f.Name(), ""
+		if tag, ok := reflect.StructTag(st.Tag(i)).Lookup("json"); ok {
+			if tag == "-" {
+				continue
+			}
+			if idx := strings.IndexByte(tag, ','); idx != -1 {
+				tag, opts = tag[:idx], tag[idx:]
+			}
+			if tag != "" {
+				name = tag
+			}
+		}
+		if f.Embedded() && name == f.Name() {
+			if st, ok := f.Type().Underlying().(*types.Struct); ok {
+				if err := j.structFields(def, inputDef, st); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if !f.Exported() {
+			continue
+		}
+		isNullable := nullable(f.Type()) || strings.Contains(opts, ",omitempty")
+		for _, d := range []*ast.Definition{def, inputDef} {
+			t, err := j.typeOf(f.Type(), d == inputDef)
+			if err != nil {
+				return fmt.Errorf("field %s of %s: %w", f.Name(), def.Name, err)
+			}
+			fd := &ast.FieldDefinition{
+				Name: name,
+				Type: namedType(t, isNullable),
+			}
+			if !strings.EqualFold(name, f.Name()) {
+				fd.Directives = append(fd.Directives, goField(f.Name()))
+			}
+			d.Fields = append(d.Fields, fd)
+		}
+	}
+	return nil
+}
+
+// nullable reports if the zero value of the given Go type is encoded as a JSON null.
+func nullable(t types.Type) bool {
+	switch t.Underlying().(type) {
+	case *types.Pointer, *types.Slice, *types.Map, *types.Interface:
+		return true
+	default:
+		return false
+	}
+}
+
+func isEmptyInterface(t types.Type) bool {
+	i, ok := t.Underlying().(*types.Interface)
+	return ok && i.Empty()
+}
+
+// jsonTypeOf returns the GraphQL type that was derived for the
+// given JSON field, or an empty string if there is no such type.
+func (e *schemaGenerator) jsonTypeOf(f *gen.Field, typeFilter func(string) bool) string {
+	if e.jsonTypes == nil || f.Type.Type != field.TypeJSON {
+		return ""
+	}
+	t, ok := e.jsonTypes.fields[f]
+	switch {
+	case !ok:
+		return ""
+	case typeFilter != nil && typeFilter(strings.Trim(t.Input, "[]!")):
+		return t.Input
+	default:
+		return t.Output
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"fmt"
+	"go/token"
+	"go/types"
+	"reflect"
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// schematypeFixture returns the type information of the schematype package
+// of the todo example, that is expected by the RType of the test fields.
+func schematypeFixture(path string) *types.Package {
+	pkg := types.NewPackage(path, "schematype")
+	newStruct := func(name string, fields []*types.Var, tags []string) *types.Named {
+		obj := types.NewTypeName(token.NoPos, pkg, name, nil)
+		named := types.NewNamed(obj, types.NewStruct(fields, tags), nil)
+		pkg.Scope().Insert(obj)
+		return named
+	}
+	newField := func(name string, typ types.Type) *types.Var {
+		return types.NewField(token.NoPos, pkg, name, typ, false)
+	}
+	str := types.Typ[types.String]
+	owner := newStruct("CategoryOwner",
+		[]*types.Var{newField("Name", str), newField("Email", str)},
+		[]string{`json:"name"`, `json:"email_address,omitempty"`},
+	)
+	newStruct("CategoryTypes",
+		[]*types.Var{
+			newField("Public", types.Typ[types.Bool]),
+			newField("Tags", types.NewSlice(str)),
+			newField("Owner", types.NewPointer(owner)),
+			newField("Secret", str),
+		},
+		[]string{`json:"public"`, `json:"tags,omitempty"`, `json:"owner,omitempty"`, `json:"-"`},
+	)
+	pkg.MarkComplete()
+	return pkg
+}
+
+func TestJSONTypes(t *testing.T) {
+	const pkg = "entgo.io/contrib/entgql/internal/todo/ent/schema/schematype"
+	time := types.NewPackage("time", "time")
+	duration := types.NewTypeName(token.NoPos, time, "Duration", nil)
+	types.NewNamed(duration, types.Typ[types.Int64], nil)
+	time.Scope().Insert(duration)
+	fixtures := map[string]*types.Package{pkg: schematypeFixture(pkg), "time": time}
+	load := loadPackage
+	defer func() { loadPackage = load }()
+	loadPackage = func(path string) (*types.Package, error) {
+		if p, ok := fixtures[path]; ok {
+			return p, nil
+		}
+		return nil, fmt.Errorf("package %s was not found", path)
+	}
+
+	types := &gen.Field{
+		Name: "types",
+		Type: &field.TypeInfo{
+			Type:    field.TypeJSON,
+			Ident:   "*schematype.CategoryTypes",
+			PkgPath: pkg,
+			RType:   &field.RType{Name: "CategoryTypes", Ident: "schematype.CategoryTypes", Kind: reflect.Ptr, PkgPath: pkg},
+		},
+		Optional: true,
+	}
+	owners := &gen.Field{
+		Name: "owners",
+		Type: &field.TypeInfo{
+			Type:    field.TypeJSON,
+			Ident:   "[]schematype.CategoryOwner",
+			PkgPath: pkg,
+			RType:   &field.RType{Ident: "[]schematype.CategoryOwner", Kind: reflect.Slice, PkgPath: pkg},
+		},
+	}
+	strs := &gen.Field{
+		Name: "strings",
+		Type: &field.TypeInfo{
+			Type:  field.TypeJSON,
+			Ident: "[]string",
+			RType: &field.RType{Ident: "[]string", Kind: reflect.Slice},
+		},
+	}
+	g := &gen.Graph{Nodes: []*gen.Type{{Name: "Category", Fields: []*gen.Field{types, owners, strs}}}}
+	e := newSchemaGenerator()
+	require.NoError(t, e.buildJSONTypes(g))
+	require.Equal(t, "CategoryTypes", e.mapScalar("Category", types, nil, nonInputObjectFilter))
+	require.Equal(t, "CategoryTypesInput", e.mapScalar("Category", types, nil, inputObjectFilter))
+	require.Equal(t, "[CategoryOwner!]", e.mapScalar("Category", owners, nil, nonInputObjectFilter))
+	require.Equal(t, "[CategoryOwnerInput!]", e.mapScalar("Category", owners, nil, inputObjectFilter))
+	require.Equal(t, "[String!]", e.mapScalar("Category", strs, nil, inputObjectFilter))
+
+	s := &ast.Schema{}
+	require.NoError(t, e.addJSONTypes(s))
+	require.Equal(t, `type CategoryOwner @goModel(model: "entgo.io/contrib/entgql/internal/todo/ent/schema/schematype.CategoryOwner") {
+  name: String!
+  email_address: String @goField(name: "Email", forceResolver: false)
+}
+"""
+CategoryOwnerInput is used for the CategoryOwner JSON type.
+Input was generated by ent.
+"""
+input CategoryOwnerInput @goModel(model: "entgo.io/contrib/entgql/internal/todo/ent/schema/schematype.CategoryOwner") {
+  name: String!
+  email_address: String @goField(name: "Email", forceResolver: false)
+}
+type CategoryTypes @goModel(model: "entgo.io/contrib/entgql/internal/todo/ent/schema/schematype.CategoryTypes") {
+  public: Boolean!
+  tags: [String!]
+  owner: CategoryOwner
+}
+"""
+CategoryTypesInput is used for the CategoryTypes JSON type.
+Input was generated by ent.
+"""
+input CategoryTypesInput @goModel(model: "entgo.io/contrib/entgql/internal/todo/ent/schema/schematype.CategoryTypes") {
+  public: Boolean!
+  tags: [String!]
+  owner: CategoryOwnerInput
+}
+`, printSchema(s))
+
+	s = &ast.Schema{Types: map[string]*ast.Definition{"CategoryOwner": {Name: "CategoryOwner", Kind: ast.Object}}}
+	require.EqualError(t, e.addJSONTypes(s), "entgql: found the GQL type conflict for the JSON type CategoryOwner, please use the entgql.Type() annotation to map the JSON field to another GQL type")
+
+	// Types that cannot be derived, and are not mapped to GraphQL types, are rejected.
+	counts := &gen.Field{
+		Name: "counts",
+		Type: &field.TypeInfo{
+			Type:    field.TypeJSON,
+			Ident:   "map[string]time.Duration",
+			PkgPath: "time",
+			RType:   &field.RType{Ident: "map[string]time.Duration", Kind: reflect.Map, PkgPath: "time"},
+		},
+	}
+	g.Nodes[0].Fields = []*gen.Field{counts}
+	err := e.buildJSONTypes(g)
+	require.EqualError(t, err, "entgql: JSON field Category.counts: Go type map[string]time.Duration is not supported, use the entgql.Type annotation to map it to a GraphQL type")
+	counts.Annotations = map[string]interface{}{annotationName: Type("Map")}
+	require.NoError(t, e.buildJSONTypes(g))
+}
@@ -0,0 +1,125 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+)
+
+// WhereOp describes an additional operator of a field in the <T>WhereInput types.
+// The operator is added to the generated Go type and to the GraphQL schema, and it
+// is applied by calling its predicate function with the column name of the field
+// and the value of the operator. For example:
+//
+//	entgql.WhereOp{
+//		Name:   "Like",
+//		Type:   "String",
+//		GoType: "string",
+//		Func:   "example.com/pkg/predicates.Like",
+//	}
+//
+// Adds the `textLike: String` field to the WhereInput, and generates a call to:
+//
+//	func Like(column string, pattern string) func(*sql.Selector)
+type WhereOp struct {
+	// Name of the operator. It is appended to the field name in the
+	// WhereInput (e.g. "ContainsFold" generates "textContainsFold").
+	Name string `json:"Name,omitempty"`
+	// Type is the GraphQL input type of the operator. Defaults to
+	// the GraphQL type of the field.
+	Type string `json:"Type,omitempty"`
+	// GoType is the Go type of the operator in the WhereInput. The type is either
+	// a builtin type or a qualified type name (e.g. "example.com/pkg.Range"), and
+	// it defaults to the Go type of the field. Slice types are passed as-is to the
+	// predicate function, while other types are passed by value.
+	GoType string `json:"GoType,omitempty"`
+	// Func is the qualified name of the predicate function (e.g. "example.com/pkg.Like").
+	// Note that the package name must be equal to the last element of its import path.
+	Func string `json:"Func,omitempty"`
+}
+
+const pkgPath = "entgo.io/contrib/entgql"
+
+// Operators provided by entgql.
+var (
+	// OpEqualFold adds the case-insensitive equality operator to string-like
+	// fields that ent does not generate it for (e.g. custom Go types).
+	OpEqualFold = WhereOp{Name: "EqualFold", Type: "String", GoType: "string", Func: pkgPath + ".EqualFold"}
+
+	// OpContainsFold adds the case-insensitive containment operator to string-like
+	// fields that ent does not generate it for (e.g. custom Go types).
+	OpContainsFold = WhereOp{Name: "ContainsFold", Type: "String", GoType: "string", Func: pkgPath + ".ContainsFold"}
+
+	// OpHasKey adds an operator to JSON fields for checking that the key in
+	// the given path (in dot notation, e.g. "a.b[2].c") exists and not null.
+	OpHasKey = WhereOp{Name: "HasKey", Type: "String", GoType: "string", Func: pkgPath + ".JSONHasKey"}
+
+	// OpValueEQ adds an operator to JSON fields for checking that the value
+	// in the given path is equal to the given value. See JSONPathValue.
+	OpValueEQ = WhereOp{Name: "ValueEQ", Type: "JSONPathValue", GoType: pkgPath + ".JSONPathValue", Func: pkgPath + ".JSONValueEQ"}
+)
+
+// EqualFold returns a predicate for checking that the
+// column is equal to the given string case-insensitively.
+func EqualFold(column, v string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(column), v))
+	}
+}
+
+// ContainsFold returns a predicate for checking that the
+// column contains the given substring case-insensitively.
+func ContainsFold(column, substr string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(column), substr))
+	}
+}
+
+// JSONHasKey returns a predicate for checking that the key in
+// the given path of the JSON column exists and not null.
+func JSONHasKey(column, path string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(column), sqljson.DotPath(path)))
+	}
+}
+
+// JSONPathValue is the input of the OpValueEQ operator.
+type JSONPathValue struct {
+	// Path of the value in dot notation (e.g. "a.b[2].c").
+	Path string `json:"path"`
+	// Value to compare with.
+	Value interface{} `json:"value"`
+}
+
+// JSONValueEQ returns a predicate for checking that the value in
+// the given path of the JSON column is equal to the given value.
+func JSONValueEQ(column string, v JSONPathValue) func(*sql.Selector) {
+	arg := v.Value
+	// Numbers are decoded by gqlgen as json.Number,
+	// and need to be compared as numeric values.
+	if n, ok := arg.(json.Number); ok {
+		if i, err := n.Int64(); err == nil {
+			arg = i
+		} else if f, err := n.Float64(); err == nil {
+			arg = f
+		}
+	}
+	return func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(column), arg, sqljson.DotPath(v.Path)))
+	}
+}
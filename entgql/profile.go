@@ -0,0 +1,216 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"fmt"
+	"sort"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// schemaProfile is a variant of the generated schema
+// that is written to its own path. See WithSchemaProfile.
+type schemaProfile struct {
+	name, path string
+}
+
+// restrict limits the visibility of the given schema element (a definition,
+// a field or an enum value) to the given profiles. Elements that are not
+// restricted are visible in all profiles.
+func (e *schemaGenerator) restrict(profiles []string, elem interface{}) {
+	if len(profiles) == 0 {
+		return
+	}
+	if e.visibility == nil {
+		e.visibility = make(map[interface{}][]string)
+	}
+	e.visibility[elem] = profiles
+}
+
+// restrictFields limits the visibility of the given fields to the given profiles.
+func (e *schemaGenerator) restrictFields(profiles []string, fields ...*ast.FieldDefinition) {
+	for _, f := range fields {
+		e.restrict(profiles, f)
+	}
+}
+
+// visible reports if the given schema element is visible in the profile.
+func (e *schemaGenerator) visible(elem interface{}, profile string) bool {
+	profiles, ok := e.visibility[elem]
+	if !ok {
+		return true
+	}
+	for _, p := range profiles {
+		if p == profile {
+			return true
+		}
+	}
+	return false
+}
+
+// profileNames returns the names of the profiles that are used by the
+// Profiles annotations of the schema that was built last.
+func (e *schemaGenerator) profileNames() []string {
+	seen := make(map[string]bool)
+	for _, profiles := range e.visibility {
+		for _, p := range profiles {
+			seen[p] = true
+		}
+	}
+	names := make([]string, 0, len(seen))
+	for p := range seen {
+		names = append(names, p)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// profileSchema returns a copy of the given schema that holds only the types,
+// fields and enum values that are visible in the given profile. Fields and
+// arguments that reference types that are hidden in the profile are removed
+// as well, and so are the types that are left without fields as a result.
+func (e *schemaGenerator) profileSchema(s *ast.Schema, profile string) *ast.Schema {
+	ps := &ast.Schema{
+		Directives: s.Directives,
+		Types:      make(map[string]*ast.Definition, len(s.Types)),
+	}
+	removed := make(map[string]bool)
+	for name, d := range s.Types {
+		if !e.visible(d, profile) {
+			removed[name] = true
+			continue
+		}
+		c := *d
+		c.Fields, c.EnumValues = nil, nil
+		for _, f := range d.Fields {
+			if e.visible(f, profile) {
+				fc := *f
+				c.Fields = append(c.Fields, &fc)
+			}
+		}
+		for _, v := range d.EnumValues {
+			if e.visible(v, profile) {
+				c.EnumValues = append(c.EnumValues, v)
+			}
+		}
+		ps.Types[name] = &c
+	}
+	for changed := true; changed; {
+		changed = false
+		for name, d := range ps.Types {
+			d.Fields = pruneFields(d.Fields, removed)
+			d.Interfaces = pruneNames(d.Interfaces, removed)
+			d.Types = pruneNames(d.Types, removed)
+			if isEmptyDef(d) {
+				delete(ps.Types, name)
+				removed[name] = true
+				changed = true
+			}
+		}
+	}
+	return ps
+}
+
+// pruneFields removes the fields that reference the removed types. Optional
+// arguments of such types are removed, but their fields are kept.
+func pruneFields(fields ast.FieldList, removed map[string]bool) ast.FieldList {
+	pruned := fields[:0]
+	for _, f := range fields {
+		if removed[f.Type.Name()] {
+			continue
+		}
+		var (
+			keep = true
+			args = make(ast.ArgumentDefinitionList, 0, len(f.Arguments))
+		)
+		for _, a := range f.Arguments {
+			switch {
+			case !removed[a.Type.Name()]:
+				args = append(args, a)
+			case a.Type.NonNull:
+				keep = false
+			}
+		}
+		if keep {
+			if len(args) < len(f.Arguments) {
+				f.Arguments = args
+			}
+			pruned = append(pruned, f)
+		}
+	}
+	return pruned
+}
+
+// pruneNames removes the names of the removed types from the given list.
+func pruneNames(names []string, removed map[string]bool) []string {
+	if len(names) == 0 {
+		return names
+	}
+	pruned := make([]string, 0, len(names))
+	for _, n := range names {
+		if !removed[n] {
+			pruned = append(pruned, n)
+		}
+	}
+	return pruned
+}
+
+// isEmptyDef reports if the definition was left without any members.
+func isEmptyDef(d *ast.Definition) bool {
+	switch d.Kind {
+	case ast.Object, ast.InputObject, ast.Interface:
+		return len(d.Fields) == 0
+	case ast.Enum:
+		return len(d.EnumValues) == 0
+	case ast.Union:
+		return len(d.Types) == 0
+	default:
+		return false
+	}
+}
+
+// edgeProfiles returns the profiles of the edge, or the profiles
+// of its neighbor type if the edge is not annotated with Profiles.
+func edgeProfiles(e *gen.Edge) ([]string, error) {
+	ant, err := annotation(e.Annotations)
+	if err != nil {
+		return nil, err
+	}
+	if len(ant.Profiles) > 0 {
+		return ant.Profiles, nil
+	}
+	ant, err = annotation(e.Type.Annotations)
+	if err != nil {
+		return nil, err
+	}
+	return ant.Profiles, nil
+}
+
+// checkProfiles ensures the Profiles annotations
+// reference only the configured schema profiles.
+func checkProfiles(used []string, profiles []*schemaProfile) error {
+	for _, name := range used {
+		found := false
+		for _, p := range profiles {
+			found = found || p.name == name
+		}
+		if !found {
+			return fmt.Errorf("entgql: schema profile %q is not defined, please use the entgql.WithSchemaProfile option to define it", name)
+		}
+	}
+	return nil
+}
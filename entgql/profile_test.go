@@ -0,0 +1,214 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestSchema_profiles(t *testing.T) {
+	plugin := newSchemaGenerator()
+	plugin.genSchema = true
+	plugin.genWhereInput = true
+	plugin.relaySpec = false
+	user := &gen.Type{
+		Name: "User",
+		ID:   &gen.Field{Name: "id", Type: &field.TypeInfo{Type: field.TypeInt}},
+		Fields: []*gen.Field{
+			{Name: "email", Type: &field.TypeInfo{Type: field.TypeString}},
+		},
+		Annotations: map[string]interface{}{
+			annotationName: QueryField().Merge(Profiles("admin")),
+		},
+	}
+	todo := &gen.Type{
+		Name: "Todo",
+		ID:   &gen.Field{Name: "id", Type: &field.TypeInfo{Type: field.TypeInt}},
+		Fields: []*gen.Field{
+			{
+				Name: "text",
+				Type: &field.TypeInfo{Type: field.TypeString},
+				Annotations: map[string]interface{}{
+					annotationName: OrderField("TEXT").Merge(GroupBy()),
+				},
+			},
+			{
+				Name: "secret",
+				Type: &field.TypeInfo{Type: field.TypeString},
+				Annotations: map[string]interface{}{
+					annotationName: Profiles("admin", "support").Merge(GroupBy()),
+				},
+			},
+			{
+				Name: "cost",
+				Type: &field.TypeInfo{Type: field.TypeInt},
+				Annotations: map[string]interface{}{
+					annotationName: Profiles("admin").Merge(GroupBy()),
+				},
+			},
+		},
+		Edges: []*gen.Edge{
+			{
+				Name: "owner", Type: user, Unique: true, Optional: true,
+				Annotations: map[string]interface{}{
+					annotationName: OrderEdgeFields("email"),
+				},
+			},
+		},
+		Annotations: map[string]interface{}{
+			annotationName: QueryField().Merge(Aggregate()),
+		},
+	}
+	s, err := plugin.BuildSchema(&gen.Graph{
+		Config: &gen.Config{Package: "example.com/ent"},
+		Nodes:  []*gen.Type{todo, user},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"admin", "support"}, plugin.profileNames())
+	require.Contains(t, printSchema(s), "secret: String!")
+	require.Contains(t, printSchema(s), "users: [User!]!")
+
+	public := plugin.profileSchema(s, "public")
+	require.Nil(t, public.Types["User"])
+	types := &ast.Schema{}
+	types.AddTypes(public.Types["Query"], public.Types["Todo"])
+	require.Equal(t, `type Query {
+  todos: [Todo!]!
+  todosAggregate(
+    """Filtering options for the aggregated Todos."""
+    where: TodoWhereInput
+
+    """The fields to group the aggregations by."""
+    groupBy: [TodoGroupField!]
+  ): [TodoAggregate!]!
+}
+type Todo {
+  id: ID!
+  text: String!
+}
+`, printSchema(types))
+	require.NotNil(t, public.Types["TodoWhereInput"].Fields.ForName("textContains"))
+	require.Nil(t, public.Types["TodoWhereInput"].Fields.ForName("secretContains"))
+	require.Nil(t, public.Types["TodoWhereInput"].Fields.ForName("hasOwnerWith"))
+	require.Equal(t, ast.EnumValueList{{Name: "TEXT"}}, public.Types["TodoOrderField"].EnumValues)
+	require.Equal(t, ast.EnumValueList{{Name: "TEXT"}}, public.Types["TodoGroupField"].EnumValues)
+	require.Nil(t, public.Types["TodoAggregate"].Fields.ForName("cost"))
+	require.Nil(t, public.Types["TodoAggregate"].Fields.ForName("sum"))
+	require.Nil(t, public.Types["TodoAggregateValues"])
+
+	support := plugin.profileSchema(s, "support")
+	require.NotNil(t, support.Types["Todo"].Fields.ForName("secret"))
+	require.NotNil(t, support.Types["TodoWhereInput"].Fields.ForName("secretContains"))
+	require.Nil(t, support.Types["Todo"].Fields.ForName("owner"))
+	require.Nil(t, support.Types["TodoWhereInput"].Fields.ForName("hasOwner"))
+	require.Nil(t, support.Types["User"])
+	require.Nil(t, support.Types["Query"].Fields.ForName("users"))
+	require.NotNil(t, support.Types["TodoGroupField"].EnumValues.ForName("SECRET"))
+	require.Nil(t, support.Types["TodoGroupField"].EnumValues.ForName("COST"))
+	require.NotNil(t, support.Types["TodoAggregate"].Fields.ForName("secret"))
+	require.Nil(t, support.Types["TodoAggregateValues"])
+
+	admin := plugin.profileSchema(s, "admin")
+	require.Equal(t, printSchema(s), printSchema(admin))
+	// Profile schemas are copies, and the full schema is not modified.
+	require.NotNil(t, s.Types["Todo"].Fields.ForName("owner"))
+	require.NotNil(t, s.Types["User"])
+	require.NotNil(t, s.Types["TodoOrderField"].EnumValues.ForName("OWNER_EMAIL"))
+	require.NotNil(t, s.Types["TodoAggregateValues"].Fields.ForName("cost"))
+
+	// Types that are emptied by the restricted fields and values are
+	// pruned, even if no type is restricted as a whole.
+	todo = &gen.Type{
+		Name: "Todo",
+		ID:   &gen.Field{Name: "id", Type: &field.TypeInfo{Type: field.TypeInt}},
+		Fields: []*gen.Field{
+			{Name: "text", Type: &field.TypeInfo{Type: field.TypeString}},
+			{
+				Name: "secret",
+				Type: &field.TypeInfo{Type: field.TypeString},
+				Annotations: map[string]interface{}{
+					annotationName: Profiles("admin").Merge(GroupBy()),
+				},
+			},
+		},
+		Annotations: map[string]interface{}{
+			annotationName: QueryField().Merge(Aggregate()),
+		},
+	}
+	s, err = plugin.BuildSchema(&gen.Graph{
+		Config: &gen.Config{Package: "example.com/ent"},
+		Nodes:  []*gen.Type{todo},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, s.Types["TodoGroupField"])
+	public = plugin.profileSchema(s, "public")
+	require.Nil(t, public.Types["TodoGroupField"])
+	require.Nil(t, public.Types["Query"].Fields.ForName("todosAggregate").Arguments.ForName("groupBy"))
+	require.NotNil(t, public.Types["Query"].Fields.ForName("todosAggregate").Arguments.ForName("where"))
+}
+
+func TestSchema_profilesJSONTypes(t *testing.T) {
+	plugin := newSchemaGenerator()
+	object := func(name string, fields ...*ast.FieldDefinition) *ast.Definition {
+		return &ast.Definition{Name: name, Kind: ast.Object, Fields: fields}
+	}
+	field := func(name, typ string) *ast.FieldDefinition {
+		return &ast.FieldDefinition{Name: name, Type: namedType(typ, true)}
+	}
+	plugin.jsonTypes = &jsonTypes{
+		defs: []*ast.Definition{
+			object("Address", field("street", "String"), field("geo", "Geo")),
+			object("AddressInput", field("street", "String"), field("geo", "GeoInput")),
+			object("Geo", field("lat", "Float")),
+			object("GeoInput", field("lat", "Float")),
+			object("Tag", field("name", "String")),
+			object("TagInput", field("name", "String")),
+		},
+		fields: map[*gen.Field]*jsonType{
+			{Name: "address"}:  {Output: "Address", Input: "AddressInput", Profiles: []string{"admin"}},
+			{Name: "location"}: {Output: "Geo", Input: "GeoInput", Profiles: []string{"support"}},
+			{Name: "tags"}:     {Output: "[Tag!]", Input: "[TagInput!]"},
+		},
+	}
+	s := &ast.Schema{}
+	require.NoError(t, plugin.addJSONTypes(s))
+	require.Equal(t, []string{"admin", "support"}, plugin.profileNames())
+
+	public := plugin.profileSchema(s, "public")
+	require.Nil(t, public.Types["Address"])
+	require.Nil(t, public.Types["GeoInput"])
+	require.NotNil(t, public.Types["Tag"])
+	require.NotNil(t, public.Types["TagInput"])
+
+	support := plugin.profileSchema(s, "support")
+	require.Nil(t, support.Types["AddressInput"])
+	require.NotNil(t, support.Types["Geo"])
+	require.NotNil(t, support.Types["GeoInput"])
+
+	admin := plugin.profileSchema(s, "admin")
+	require.Equal(t, printSchema(s), printSchema(admin))
+}
+
+func TestCheckProfiles(t *testing.T) {
+	profiles := []*schemaProfile{{name: "public", path: "public.graphql"}}
+	require.NoError(t, checkProfiles(nil, profiles))
+	require.NoError(t, checkProfiles([]string{"public"}, profiles))
+	require.EqualError(t, checkProfiles([]string{"public", "admin"}, profiles), `entgql: schema profile "admin" is not defined, please use the entgql.WithSchemaProfile option to define it`)
+}
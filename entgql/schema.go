@@ -17,7 +17,9 @@ package entgql
 import (
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
+	"strconv"
 	"strings"
 
 	"entgo.io/ent/entc/gen"
@@ -30,6 +32,10 @@ import (
 const (
 	// QueryType is the name of the root Query object.
 	QueryType = "Query"
+	// MutationType is the name of the root Mutation object.
+	MutationType = "Mutation"
+	// SubscriptionType is the name of the root Subscription object.
+	SubscriptionType = "Subscription"
 	// OrderDirection is the name of enum OrderDirection
 	OrderDirection = "OrderDirection"
 	// RelayCursor is the name of the cursor type
@@ -88,6 +94,58 @@ var (
 		},
 	}
 
+	// authorizeDirective is the definition of the directive
+	// generated by the Authorize annotation.
+	authorizeDirective = &ast.DirectiveDefinition{
+		Name:     "authorize",
+		Position: pos,
+		Arguments: ast.ArgumentDefinitionList{
+			{
+				Name: "rules",
+				Type: ast.NonNullListType(ast.NonNullNamedType("String", nil), nil),
+			},
+		},
+		Locations: []ast.DirectiveLocation{
+			ast.LocationObject,
+			ast.LocationFieldDefinition,
+			ast.LocationInputFieldDefinition,
+		},
+	}
+
+	// constraintDirective is the definition of the directive that declares
+	// the constraints of the fields in the generated mutation inputs.
+	constraintDirective = &ast.DirectiveDefinition{
+		Name:     "constraint",
+		Position: pos,
+		Arguments: ast.ArgumentDefinitionList{
+			{Name: "minLength", Type: ast.NamedType("Int", nil)},
+			{Name: "maxLength", Type: ast.NamedType("Int", nil)},
+			{Name: "min", Type: ast.NamedType("Float", nil)},
+			{Name: "max", Type: ast.NamedType("Float", nil)},
+			{Name: "pattern", Type: ast.NamedType("String", nil)},
+		},
+		Locations: []ast.DirectiveLocation{
+			ast.LocationInputFieldDefinition,
+			ast.LocationArgumentDefinition,
+		},
+	}
+
+	// entityResolverDirective is the definition of the gqlgen directive for
+	// resolving all representations of a federated entity in one call.
+	entityResolverDirective = &ast.DirectiveDefinition{
+		Name:     "entityResolver",
+		Position: pos,
+		Arguments: ast.ArgumentDefinitionList{
+			{
+				Name: "multi",
+				Type: ast.NamedType("Boolean", nil),
+			},
+		},
+		Locations: []ast.DirectiveLocation{
+			ast.LocationObject,
+		},
+	}
+
 	inputObjectFilter    = func(t string) bool { return strings.HasSuffix(t, "Input") }
 	nonInputObjectFilter = func(t string) bool { return !inputObjectFilter(t) }
 )
@@ -97,10 +155,15 @@ type schemaGenerator struct {
 	genSchema     bool
 	genWhereInput bool
 	genMutations  bool
+	federation    bool
 
 	cfg         *config.Config
 	scalarFunc  func(*gen.Field, gen.Op) string
 	schemaHooks []SchemaHook
+	jsonTypes   *jsonTypes
+	// visibility holds the profiles of the schema elements
+	// that are restricted by the Profiles annotation.
+	visibility map[interface{}][]string
 }
 
 func newSchemaGenerator() *schemaGenerator {
@@ -114,6 +177,7 @@ func (e *schemaGenerator) BuildSchema(g *gen.Graph) (s *ast.Schema, err error) {
 	s = &ast.Schema{
 		Directives: map[string]*ast.DirectiveDefinition{},
 	}
+	e.visibility = nil
 	if e.genSchema {
 		s.AddTypes(builtinTypes()...)
 		if e.relaySpec {
@@ -122,10 +186,41 @@ func (e *schemaGenerator) BuildSchema(g *gen.Graph) (s *ast.Schema, err error) {
 		for name, d := range directives {
 			s.Directives[name] = d
 		}
+		if e.federation {
+			s.Directives[entityResolverDirective.Name] = entityResolverDirective
+		}
+	}
+	if e.genSchema || e.genWhereInput {
+		ok, err := hasAuthorize(g.Nodes)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			s.Directives[authorizeDirective.Name] = authorizeDirective
+		}
+	}
+	if e.genSchema {
+		if err := e.buildJSONTypes(g); err != nil {
+			return nil, err
+		}
 	}
 	if err := e.buildTypes(g, s); err != nil {
 		return nil, err
 	}
+	if e.genSchema {
+		if err := e.buildInterfaces(g, s); err != nil {
+			return nil, err
+		}
+	}
+	if err := e.addJSONTypes(s); err != nil {
+		return nil, err
+	}
+	if usesDirective(s, constraintDirective.Name) {
+		s.Directives[constraintDirective.Name] = constraintDirective
+	}
+	if e.genSchema && e.genWhereInput && usesType(s, OpValueEQ.Type) {
+		s.AddTypes(jsonPathValueTypes()...)
+	}
 
 	for _, h := range e.schemaHooks {
 		if err = h(g, s); err != nil {
@@ -137,13 +232,20 @@ func (e *schemaGenerator) BuildSchema(g *gen.Graph) (s *ast.Schema, err error) {
 }
 
 func (e *schemaGenerator) buildTypes(g *gen.Graph, s *ast.Schema) error {
-	var queryFields ast.FieldList
+	var queryFields, mutationFields, subscriptionFields ast.FieldList
 	if e.relaySpec {
 		queryFields = relayBuiltinQueryFields()
 	}
 
 	for _, node := range g.Nodes {
-		if node.IsEdgeSchema() {
+		if node.HasCompositeID() {
+			ant, err := annotation(node.Annotations)
+			if err != nil {
+				return err
+			}
+			if !ant.Skip.Is(SkipType) {
+				return fmt.Errorf("entgql: edge schema %s with a composite id is not supported, and must be skipped with entgql.Skip(entgql.SkipType)", node.Name)
+			}
 			continue
 		}
 		gqlType, ant, err := gqlTypeFromNode(node)
@@ -151,6 +253,21 @@ func (e *schemaGenerator) buildTypes(g *gen.Graph, s *ast.Schema) error {
 			return err
 		}
 		names := paginationNames(gqlType)
+		names.MultiOrder = ant.MultiOrder
+		names.SoftDelete = ant.SoftDelete != ""
+
+		// The types and root fields that are added for the node
+		// share its visibility when it is limited to profiles.
+		var (
+			types      map[string]bool
+			nq, nm, ns = len(queryFields), len(mutationFields), len(subscriptionFields)
+		)
+		if len(ant.Profiles) > 0 {
+			types = make(map[string]bool, len(s.Types))
+			for name := range s.Types {
+				types[name] = true
+			}
+		}
 
 		if e.genSchema && !ant.Skip.Is(SkipType) {
 			def, err := e.buildType(node, ant, gqlType, g.Package)
@@ -231,6 +348,18 @@ func (e *schemaGenerator) buildTypes(g *gen.Graph, s *ast.Schema) error {
 			}
 		}
 
+		if e.genSchema && ant.OffsetPagination != nil && !ant.Skip.Is(SkipType) {
+			s.AddTypes(names.PageTypeDef())
+			name := ant.OffsetPagination.Name
+			if name == "" {
+				name = camel(plural(gqlType)) + "Page"
+			}
+			_, hasOrderBy := s.Types[names.Order]
+			def := names.PageField(name, hasOrderBy, e.genWhereInput && !ant.Skip.Is(SkipWhereInput))
+			def.Directives = e.buildDirectives(ant.OffsetPagination.Directives)
+			queryFields = append(queryFields, def)
+		}
+
 		if e.genWhereInput && !ant.Skip.Is(SkipWhereInput) {
 			def, err := e.buildWhereInput(node, gqlType, names.WhereInput)
 			if err != nil {
@@ -250,6 +379,39 @@ func (e *schemaGenerator) buildTypes(g *gen.Graph, s *ast.Schema) error {
 				s.AddTypes(defs...)
 			}
 		}
+
+		if e.genSchema && e.genMutations {
+			fields, err := e.buildMutationFields(node, gqlType)
+			if err != nil {
+				return err
+			}
+			mutationFields = append(mutationFields, fields...)
+		}
+
+		if e.genSchema && ant.Aggregate != nil && !ant.Skip.Is(SkipType) {
+			defs, err := e.buildAggregateTypes(node, ant, gqlType)
+			if err != nil {
+				return err
+			}
+			s.AddTypes(defs...)
+			_, hasGroupBy := s.Types[gqlType+"GroupField"]
+			queryFields = append(queryFields, e.buildAggregateField(ant, gqlType, names, hasGroupBy))
+		}
+
+		if e.genSchema && ant.Subscriptions && !ant.Skip.Is(SkipType) {
+			subscriptionFields = append(subscriptionFields, subscriptionFieldDefs(gqlType)...)
+		}
+
+		if len(ant.Profiles) > 0 {
+			for name, def := range s.Types {
+				if !types[name] {
+					e.restrict(ant.Profiles, def)
+				}
+			}
+			e.restrictFields(ant.Profiles, queryFields[nq:]...)
+			e.restrictFields(ant.Profiles, mutationFields[nm:]...)
+			e.restrictFields(ant.Profiles, subscriptionFields[ns:]...)
+		}
 	}
 
 	if e.genSchema && len(queryFields) > 0 {
@@ -259,19 +421,121 @@ func (e *schemaGenerator) buildTypes(g *gen.Graph, s *ast.Schema) error {
 			Fields: queryFields,
 		})
 	}
+	if e.genSchema && len(mutationFields) > 0 {
+		s.AddTypes(&ast.Definition{
+			Name:   MutationType,
+			Kind:   ast.Object,
+			Fields: mutationFields,
+		})
+	}
+	if e.genSchema && len(subscriptionFields) > 0 {
+		s.AddTypes(&ast.Definition{
+			Name:   SubscriptionType,
+			Kind:   ast.Object,
+			Fields: subscriptionFields,
+		})
+	}
 
 	return nil
 }
 
+// buildInterfaces adds the GraphQL interfaces that are defined by the Interface annotations
+// to the schema, and the connection fields of their implementations to the Query object.
+func (e *schemaGenerator) buildInterfaces(g *gen.Graph, s *ast.Schema) error {
+	list, err := interfaces(g.Nodes)
+	if err != nil {
+		return err
+	}
+	var queryFields ast.FieldList
+	for _, i := range list {
+		if s.Types[i.Name] != nil {
+			return fmt.Errorf("found the GQL type conflict for the interface %s", i.Name)
+		}
+		def := &ast.Definition{
+			Name: i.Name,
+			Kind: ast.Interface,
+		}
+		for _, n := range i.Nodes {
+			gqlType, _, err := gqlTypeFromNode(n)
+			if err != nil {
+				return err
+			}
+			fields, err := i.NodeFields(n)
+			if err != nil {
+				return err
+			}
+			for j, f := range fields {
+				ant, err := annotation(f.Annotations)
+				if err != nil {
+					return err
+				}
+				fd, err := e.fieldDefinition(gqlType, f, ant)
+				if err != nil {
+					return err
+				}
+				if len(def.Fields) < len(fields) {
+					def.Fields = append(def.Fields, &ast.FieldDefinition{
+						Name:        fd.Name,
+						Type:        fd.Type,
+						Description: fd.Description,
+					})
+				} else if def.Fields[j].Type.String() != fd.Type.String() {
+					return fmt.Errorf("entgql: field %s.%s of type %s does not match the type %s of the interface %s", n.Name, f.Name, fd.Type, def.Fields[j].Type, i.Name)
+				}
+			}
+			if t := s.Types[gqlType]; t != nil && !contains(t.Interfaces, i.Name) {
+				t.Interfaces = append(t.Interfaces, i.Name)
+			}
+		}
+		s.AddTypes(def)
+		if i.QueryField != nil {
+			if !e.relaySpec {
+				return ErrRelaySpecDisabled
+			}
+			names := paginationNames(i.Name)
+			s.AddTypes(names.TypeDefs()...)
+			field := names.ConnectionField(i.QueryField.fieldName(i.Name), false, false)
+			field.Directives = e.buildDirectives(i.QueryField.Directives)
+			rules, err := i.AuthorizeRules()
+			if err != nil {
+				return err
+			}
+			if len(rules) > 0 {
+				field.Directives = append(field.Directives, authorize(rules))
+			}
+			queryFields = append(queryFields, field)
+		}
+	}
+	if len(queryFields) > 0 {
+		if s.Types[QueryType] == nil {
+			s.AddTypes(&ast.Definition{
+				Name: QueryType,
+				Kind: ast.Object,
+			})
+		}
+		s.Types[QueryType].Fields = append(s.Types[QueryType].Fields, queryFields...)
+	}
+	return nil
+}
+
 func (e *schemaGenerator) buildType(t *gen.Type, ant *Annotation, gqlType, pkg string) (*ast.Definition, error) {
 	def := &ast.Definition{
 		Name:       gqlType,
 		Kind:       ast.Object,
 		Directives: e.buildDirectives(ant.Directives),
 	}
+	if len(ant.Authorize) > 0 {
+		def.Directives = append(def.Directives, authorize(ant.Authorize))
+	}
 	if t.Name != gqlType {
 		def.Directives = append(def.Directives, goModel(entGoType(t.Name, pkg)))
 	}
+	if e.federation {
+		if def.Directives.ForName("key") == nil {
+			def.Directives = append(def.Directives, key("id"))
+		}
+		def.Directives = append(def.Directives, entityResolver())
+	}
 	if e.relaySpec {
 		def.Interfaces = append(def.Interfaces, "Node")
 	}
@@ -294,6 +558,7 @@ func (e *schemaGenerator) buildType(t *gen.Type, ant *Annotation, gqlType, pkg s
 			return nil, err
 		}
 		if f != nil {
+			e.restrict(ant.Profiles, f)
 			def.Fields = append(def.Fields, f)
 		}
 	}
@@ -314,6 +579,11 @@ func (e *schemaGenerator) buildType(t *gen.Type, ant *Annotation, gqlType, pkg s
 		if err != nil {
 			return nil, err
 		}
+		profiles, err := edgeProfiles(edge)
+		if err != nil {
+			return nil, err
+		}
+		e.restrictFields(profiles, fields...)
 		if len(fields) > 0 {
 			def.Fields = append(def.Fields, fields...)
 		}
@@ -354,9 +624,26 @@ func (e *schemaGenerator) enumOrderByValues(t *gen.Type, gqlType string) (*ast.D
 			continue
 		}
 
-		enumValues = append(enumValues, &ast.EnumValueDefinition{
+		v := &ast.EnumValueDefinition{
 			Name: ant.OrderField,
-		})
+		}
+		e.restrict(ant.Profiles, v)
+		enumValues = append(enumValues, v)
+	}
+	edgeFields, err := edgeOrderFields(t)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range edgeFields {
+		profiles, err := edgeProfiles(f.Edge)
+		if err != nil {
+			return nil, err
+		}
+		v := &ast.EnumValueDefinition{
+			Name: f.Name,
+		}
+		e.restrict(profiles, v)
+		enumValues = append(enumValues, v)
 	}
 	if len(enumValues) == 0 {
 		return nil, nil
@@ -386,18 +673,25 @@ func (e *schemaGenerator) buildFieldEnum(f *gen.Field, gqlType, goType string) (
 }
 
 func (e *schemaGenerator) buildEdge(node *gen.Type, edge *gen.Edge, edgeAnt *Annotation) ([]*ast.FieldDefinition, error) {
-	if edge.Type.IsEdgeSchema() {
+	if edge.Type.HasCompositeID() {
 		return nil, nil
 	}
 	gqlType, ant, err := gqlTypeFromNode(edge.Type)
 	if err != nil || ant.Skip.Is(SkipType) {
 		return nil, err
 	}
-	orderFields, err := orderFields(edge.Type)
+	hasOrderBy, err := hasOrderFields(edge.Type)
 	if err != nil {
 		return nil, err
 	}
 
+	relayConn, err := isRelayConn(edge)
+	if err != nil {
+		return nil, err
+	}
+	if edgeAnt.OffsetPagination != nil && (edge.Unique || relayConn) {
+		return nil, fmt.Errorf("entgql: OffsetPagination cannot be defined on Unique edge or Relay Connection: %s.%s", node.Name, edge.Name)
+	}
 	var (
 		edgeField = camel(edge.Name)
 		mappings  = []string{edgeField}
@@ -412,7 +706,7 @@ func (e *schemaGenerator) buildEdge(node *gen.Type, edge *gen.Edge, edgeAnt *Ann
 		switch {
 		case edge.Unique:
 			fieldDef.Type = namedType(gqlType, edge.Optional)
-		case edgeAnt.RelayConnection:
+		case relayConn:
 			if !e.relaySpec {
 				return nil, ErrRelaySpecDisabled
 			}
@@ -420,15 +714,30 @@ func (e *schemaGenerator) buildEdge(node *gen.Type, edge *gen.Edge, edgeAnt *Ann
 				return nil, fmt.Errorf("entgql.RelayConnection() must be set on entity %q in order to define %q.%q as Relay Connection", edge.Type.Name, node.Name, edge.Name)
 			}
 
-			fieldDef = paginationNames(gqlType).
-				ConnectionField(name, len(orderFields) > 0,
-					e.genWhereInput && !edgeAnt.Skip.Is(SkipWhereInput) && !ant.Skip.Is(SkipWhereInput),
-				)
+			names := paginationNames(gqlType)
+			names.MultiOrder = ant.MultiOrder
+			names.SoftDelete = ant.SoftDelete != ""
+			fieldDef = names.ConnectionField(name, hasOrderBy,
+				e.genWhereInput && !edgeAnt.Skip.Is(SkipWhereInput) && !ant.Skip.Is(SkipWhereInput),
+			)
+		case edgeAnt.OffsetPagination != nil:
+			if ant.OffsetPagination == nil {
+				return nil, fmt.Errorf("entgql.OffsetPagination() must be set on entity %q in order to define %q.%q as offset-paginated field", edge.Type.Name, node.Name, edge.Name)
+			}
+			names := paginationNames(gqlType)
+			names.MultiOrder = ant.MultiOrder
+			names.SoftDelete = ant.SoftDelete != ""
+			fieldDef = names.PageField(name, hasOrderBy,
+				e.genWhereInput && !edgeAnt.Skip.Is(SkipWhereInput) && !ant.Skip.Is(SkipWhereInput),
+			)
 		default:
 			fieldDef.Type = listNamedType(gqlType, edge.Optional)
 		}
 
 		fieldDef.Directives = e.buildDirectives(edgeAnt.Directives)
+		if len(edgeAnt.Authorize) > 0 {
+			fieldDef.Directives = append(fieldDef.Directives, authorize(edgeAnt.Authorize))
+		}
 		if name != edgeField {
 			fieldDef.Directives = append(fieldDef.Directives, goField(edgeField))
 		}
@@ -465,39 +774,73 @@ func (e *schemaGenerator) buildWhereInput(t *gen.Type, nodeGQLType, gqlType stri
 		if err != nil {
 			return nil, err
 		}
-		if ant.Skip.Is(SkipWhereInput) || !f.Type.Comparable() {
+		if ant.Skip.Is(SkipWhereInput) {
 			continue
 		}
-		for i, op := range f.Ops() {
-			fd := e.fieldDefinitionOp(nodeGQLType, f, ant, op)
+		var fields ast.FieldList
+		if f.Type.Comparable() {
+			for _, op := range f.Ops() {
+				fields = append(fields, e.fieldDefinitionOp(nodeGQLType, f, ant, op))
+			}
+		}
+		ops, err := whereOps(f)
+		if err != nil {
+			return nil, err
+		}
+		for _, op := range ops {
+			typ := op.Type
+			if typ == "" {
+				typ = e.mapScalar(nodeGQLType, f, ant, inputObjectFilter)
+			}
+			fields = append(fields, &ast.FieldDefinition{
+				Name: op.JSONName,
+				Type: namedType(typ, true),
+			})
+		}
+		for i, fd := range fields {
 			if i == 0 {
 				fd.Description = f.Name + " field predicates"
 			}
-			def.Fields = append(def.Fields, fd)
+			if len(ant.Authorize) > 0 {
+				fd.Directives = ast.DirectiveList{authorize(ant.Authorize)}
+			}
 		}
+		e.restrictFields(ant.Profiles, fields...)
+		def.Fields = append(def.Fields, fields...)
 	}
 
 	edges, err := filterEdges(t.Edges, SkipWhereInput)
 	if err != nil {
 		return nil, err
 	}
-	for _, e := range edges {
-		names, err := nodePaginationNames(e.Type)
+	for _, edge := range edges {
+		names, err := nodePaginationNames(edge.Type)
 		if err != nil {
 			return nil, err
 		}
-
-		def.Fields = append(def.Fields,
-			&ast.FieldDefinition{
-				Name:        camel("has_" + e.Name),
-				Type:        namedType("Boolean", true),
-				Description: e.Name + " edge predicates",
-			},
-			&ast.FieldDefinition{
-				Name: camel("has_" + e.Name + "_with"),
-				Type: listNamedType(names.WhereInput, true),
-			},
-		)
+		ant, err := annotation(edge.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		profiles, err := edgeProfiles(edge)
+		if err != nil {
+			return nil, err
+		}
+		has := &ast.FieldDefinition{
+			Name:        camel("has_" + edge.Name),
+			Type:        namedType("Boolean", true),
+			Description: edge.Name + " edge predicates",
+		}
+		hasWith := &ast.FieldDefinition{
+			Name: camel("has_" + edge.Name + "_with"),
+			Type: listNamedType(names.WhereInput, true),
+		}
+		if len(ant.Authorize) > 0 {
+			has.Directives = ast.DirectiveList{authorize(ant.Authorize)}
+			hasWith.Directives = ast.DirectiveList{authorize(ant.Authorize)}
+		}
+		e.restrictFields(profiles, has, hasWith)
+		def.Fields = append(def.Fields, has, hasWith)
 	}
 	return def, nil
 }
@@ -506,11 +849,14 @@ func (e *schemaGenerator) buildMutationInputs(t *gen.Type, ant *Annotation, gqlT
 	var defs []*ast.Definition
 
 	for _, i := range ant.MutationInputs {
-		desc := MutationDescriptor{Type: t, IsCreate: i.IsCreate}
+		desc := MutationDescriptor{Type: t, IsCreate: i.IsCreate, IsUpsert: i.IsUpsert, ConflictFields: i.ConflictFields}
 		name, err := desc.Input()
 		if err != nil {
 			return nil, err
 		}
+		if _, err := desc.ConflictTarget(); err != nil {
+			return nil, err
+		}
 		fields, err := desc.InputFields()
 		if err != nil {
 			return nil, err
@@ -524,9 +870,12 @@ func (e *schemaGenerator) buildMutationInputs(t *gen.Type, ant *Annotation, gqlT
 			Name: name,
 			Kind: ast.InputObject,
 		}
-		if i.IsCreate {
+		switch {
+		case i.IsUpsert:
+			def.Description = fmt.Sprintf("%s is used for upsert %s object.\nInput was generated by ent.", name, gqlType)
+		case i.IsCreate:
 			def.Description = fmt.Sprintf("%s is used for create %s object.\nInput was generated by ent.", name, gqlType)
-		} else {
+		default:
 			def.Description = fmt.Sprintf("%s is used for update %s object.\nInput was generated by ent.", name, gqlType)
 		}
 
@@ -540,46 +889,74 @@ func (e *schemaGenerator) buildMutationInputs(t *gen.Type, ant *Annotation, gqlT
 				return nil, fmt.Errorf("%s is not supported as input for %s", f.Name, def.Name)
 			}
 			if f.ClearOp {
-				def.Fields = append(def.Fields, &ast.FieldDefinition{
+				clear := &ast.FieldDefinition{
 					Name: "clear" + f.StructField(),
 					Type: namedType("Boolean", true),
-				})
+				}
+				e.restrict(ant.Profiles, clear)
+				def.Fields = append(def.Fields, clear)
 			}
-			def.Fields = append(def.Fields, &ast.FieldDefinition{
+			fd := &ast.FieldDefinition{
 				Name:        camel(f.Name),
 				Type:        namedType(scalar, f.Nullable),
 				Description: f.Comment(),
-			})
+			}
+			if d := constraint(f.Field, ant); d != nil {
+				fd.Directives = append(fd.Directives, d)
+			}
+			e.restrict(ant.Profiles, fd)
+			def.Fields = append(def.Fields, fd)
 		}
 
-		for _, e := range edges {
-			if e.Unique {
+		for _, edge := range edges {
+			var fields ast.FieldList
+			if edge.Unique {
 				if !i.IsCreate {
-					def.Fields = append(def.Fields, &ast.FieldDefinition{
-						Name: camel(snake(e.MutationClear())),
+					fields = append(fields, &ast.FieldDefinition{
+						Name: camel(snake(edge.MutationClear())),
 						Type: namedType("Boolean", true),
 					})
 				}
-				def.Fields = append(def.Fields, &ast.FieldDefinition{
-					Name: camel(e.Name) + "ID",
-					Type: namedType("ID", !i.IsCreate || e.Optional),
+				fields = append(fields, &ast.FieldDefinition{
+					Name: camel(edge.Name) + "ID",
+					Type: namedType("ID", !i.IsCreate || edge.Optional || desc.IsNested(edge)),
 				})
 			} else {
 				if i.IsCreate {
-					def.Fields = append(def.Fields, &ast.FieldDefinition{
-						Name: camel(singular(e.Name)) + "IDs",
-						Type: namedType("[ID!]", e.Optional),
+					fields = append(fields, &ast.FieldDefinition{
+						Name: camel(singular(edge.Name)) + "IDs",
+						Type: namedType("[ID!]", edge.Optional || desc.IsNested(edge)),
 					})
 				} else {
-					def.Fields = append(def.Fields, &ast.FieldDefinition{
-						Name: "add" + pascal(singular(e.Name)) + "IDs",
+					fields = append(fields, &ast.FieldDefinition{
+						Name: "add" + pascal(singular(edge.Name)) + "IDs",
 						Type: namedType("[ID!]", true),
 					}, &ast.FieldDefinition{
-						Name: "remove" + pascal(singular(e.Name)) + "IDs",
+						Name: "remove" + pascal(singular(edge.Name)) + "IDs",
 						Type: namedType("[ID!]", true),
 					})
 				}
 			}
+			profiles, err := edgeProfiles(edge)
+			if err != nil {
+				return nil, err
+			}
+			e.restrictFields(profiles, fields...)
+			def.Fields = append(def.Fields, fields...)
+		}
+		nested, err := desc.NestedEdges()
+		if err != nil {
+			return nil, err
+		}
+		for _, edge := range nested {
+			typ := namedType(edge.Input, true)
+			if !edge.Unique {
+				typ = listNamedType(edge.Input, true)
+			}
+			def.Fields = append(def.Fields, &ast.FieldDefinition{
+				Name: edge.FieldName(),
+				Type: typ,
+			})
 		}
 		defs = append(defs, def)
 	}
@@ -587,18 +964,221 @@ func (e *schemaGenerator) buildMutationInputs(t *gen.Type, ant *Annotation, gqlT
 	return defs, nil
 }
 
+// buildMutationFields returns the create<T>, update<T> and delete<T> fields, and their
+// bulk variants, of the given schema type to be added to the Mutation object.
+func (e *schemaGenerator) buildMutationFields(t *gen.Type, gqlType string) (ast.FieldList, error) {
+	descs, err := nodeMutationFields(t)
+	if err != nil {
+		return nil, err
+	}
+	fields := make(ast.FieldList, 0, len(descs))
+	for _, d := range descs {
+		def := &ast.FieldDefinition{
+			Name:       d.Name,
+			Directives: e.buildDirectives(d.Directives),
+		}
+		input, err := d.Input()
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case d.Bulk && d.IsCreate():
+			def.Arguments = ast.ArgumentDefinitionList{
+				{Name: "inputs", Type: ast.NonNullListType(ast.NonNullNamedType(input, nil), nil)},
+			}
+			def.Type = ast.NonNullListType(ast.NonNullNamedType(gqlType, nil), nil)
+		case d.Bulk:
+			if !e.genWhereInput {
+				continue
+			}
+			def.Arguments = ast.ArgumentDefinitionList{
+				{Name: "where", Type: ast.NonNullNamedType(paginationNames(gqlType).WhereInput, nil)},
+			}
+			if d.IsUpdate() {
+				def.Arguments = append(def.Arguments, &ast.ArgumentDefinition{Name: "input", Type: ast.NonNullNamedType(input, nil)})
+			}
+			def.Type = ast.NonNullNamedType("Int", nil)
+		case d.IsCreate(), d.IsUpsert():
+			def.Arguments = ast.ArgumentDefinitionList{
+				{Name: "input", Type: ast.NonNullNamedType(input, nil)},
+			}
+			def.Type = ast.NonNullNamedType(gqlType, nil)
+		case d.IsUpdate():
+			def.Arguments = ast.ArgumentDefinitionList{
+				{Name: "id", Type: ast.NonNullNamedType("ID", nil)},
+				{Name: "input", Type: ast.NonNullNamedType(input, nil)},
+			}
+			def.Type = ast.NonNullNamedType(gqlType, nil)
+		default:
+			def.Arguments = ast.ArgumentDefinitionList{
+				{Name: "id", Type: ast.NonNullNamedType("ID", nil)},
+			}
+			def.Type = ast.NonNullNamedType("ID", nil)
+		}
+		fields = append(fields, def)
+	}
+	return fields, nil
+}
+
+// subscriptionFieldDefs returns the <t>Created, <t>Updated and <t>Deleted
+// fields of the given type to be added to the Subscription object.
+func subscriptionFieldDefs(gqlType string) ast.FieldList {
+	return ast.FieldList{
+		{
+			Name:        camel(gqlType) + "Created",
+			Type:        ast.NonNullNamedType(gqlType, nil),
+			Description: fmt.Sprintf("Emits the %s objects that were created.", gqlType),
+		},
+		{
+			Name:        camel(gqlType) + "Updated",
+			Type:        ast.NonNullNamedType(gqlType, nil),
+			Description: fmt.Sprintf("Emits the %s objects that were updated, optionally filtered by their id.", gqlType),
+			Arguments: ast.ArgumentDefinitionList{
+				{
+					Name: "id",
+					Type: ast.NamedType("ID", nil),
+				},
+			},
+		},
+		{
+			Name:        camel(gqlType) + "Deleted",
+			Type:        ast.NonNullNamedType("ID", nil),
+			Description: fmt.Sprintf("Emits the ids of the %s objects that were deleted.", gqlType),
+		},
+	}
+}
+
+// buildAggregateTypes returns the <T>GroupField enum and the <T>Aggregate
+// and <T>AggregateValues types of the given node. The <T>Aggregate type is
+// authorized by the same rules as the node type.
+func (e *schemaGenerator) buildAggregateTypes(t *gen.Type, ant *Annotation, gqlType string) ([]*ast.Definition, error) {
+	fields, err := aggregateFields(t)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		defs []*ast.Definition
+		agg  = &ast.Definition{
+			Name:        gqlType + "Aggregate",
+			Kind:        ast.Object,
+			Description: fmt.Sprintf("Aggregated values of a group of %s nodes.", gqlType),
+			Fields: ast.FieldList{
+				{
+					Name:        "count",
+					Type:        namedType("Int", false),
+					Description: "The number of nodes in the group.",
+				},
+			},
+		}
+	)
+	if len(ant.Authorize) > 0 {
+		agg.Directives = append(agg.Directives, authorize(ant.Authorize))
+	}
+	if len(fields.Group) > 0 {
+		group := &ast.Definition{
+			Name:        gqlType + "GroupField",
+			Kind:        ast.Enum,
+			Description: fmt.Sprintf("Properties by which %s aggregations can be grouped.", gqlType),
+		}
+		for _, f := range fields.Group {
+			ant, err := annotation(f.Annotations)
+			if err != nil {
+				return nil, err
+			}
+			v := &ast.EnumValueDefinition{
+				Name: f.Value,
+			}
+			e.restrict(ant.Profiles, v)
+			group.EnumValues = append(group.EnumValues, v)
+			ft, err := e.typeFromField(gqlType, f.Field, ant)
+			if err != nil {
+				return nil, fmt.Errorf("field(%s): %w", f.Name, err)
+			}
+			ft.NonNull = false
+			fd := &ast.FieldDefinition{
+				Name:        camel(f.Name),
+				Type:        ft,
+				Description: fmt.Sprintf("The %s of the group, or null if the aggregations are not grouped by it.", camel(f.Name)),
+			}
+			e.restrict(ant.Profiles, fd)
+			agg.Fields = append(agg.Fields, fd)
+		}
+		defs = append(defs, group)
+	}
+	defs = append(defs, agg)
+	if len(fields.Numeric) > 0 {
+		values := &ast.Definition{
+			Name:        gqlType + "AggregateValues",
+			Kind:        ast.Object,
+			Description: fmt.Sprintf("Results of an aggregation function applied on the numeric fields of %s.", gqlType),
+		}
+		for _, f := range fields.Numeric {
+			ant, err := annotation(f.Annotations)
+			if err != nil {
+				return nil, err
+			}
+			fd := &ast.FieldDefinition{
+				Name: camel(f.Name),
+				Type: namedType("Float", true),
+			}
+			e.restrict(ant.Profiles, fd)
+			values.Fields = append(values.Fields, fd)
+		}
+		for _, fn := range []string{"sum", "avg", "min", "max"} {
+			agg.Fields = append(agg.Fields, &ast.FieldDefinition{
+				Name: fn,
+				Type: namedType(values.Name, false),
+			})
+		}
+		defs = append(defs, values)
+	}
+	return defs, nil
+}
+
+// buildAggregateField returns the Query field of the <T>Aggregate type.
+func (e *schemaGenerator) buildAggregateField(ant *Annotation, gqlType string, names *PaginationNames, hasGroupBy bool) *ast.FieldDefinition {
+	name := ant.Aggregate.Name
+	if name == "" {
+		name = camel(plural(gqlType)) + "Aggregate"
+	}
+	def := &ast.FieldDefinition{
+		Name:       name,
+		Type:       listNamedType(gqlType+"Aggregate", false),
+		Directives: e.buildDirectives(ant.Aggregate.Directives),
+	}
+	if e.genWhereInput && !ant.Skip.Is(SkipWhereInput) {
+		def.Arguments = append(def.Arguments, &ast.ArgumentDefinition{
+			Name:        "where",
+			Type:        namedType(names.WhereInput, true),
+			Description: fmt.Sprintf("Filtering options for the aggregated %s.", plural(gqlType)),
+		})
+	}
+	if hasGroupBy {
+		def.Arguments = append(def.Arguments, &ast.ArgumentDefinition{
+			Name:        "groupBy",
+			Type:        listNamedType(gqlType+"GroupField", true),
+			Description: "The fields to group the aggregations by.",
+		})
+	}
+	return def
+}
+
 func (e *schemaGenerator) fieldDefinition(gqlType string, f *gen.Field, ant *Annotation) (*ast.FieldDefinition, error) {
 	ft, err := e.typeFromField(gqlType, f, ant)
 	if err != nil {
 		return nil, fmt.Errorf("field(%s): %w", f.Name, err)
 	}
 
-	return &ast.FieldDefinition{
+	def := &ast.FieldDefinition{
 		Name:        camel(f.Name),
 		Type:        ft,
 		Description: f.Comment(),
 		Directives:  e.buildDirectives(ant.Directives),
-	}, nil
+	}
+	if len(ant.Authorize) > 0 {
+		def.Directives = append(def.Directives, authorize(ant.Authorize))
+	}
+	return def, nil
 }
 
 func (e *schemaGenerator) fieldDefinitionOp(gqlType string, f *gen.Field, ant *Annotation, op gen.Op) *ast.FieldDefinition {
@@ -647,6 +1227,9 @@ func (e *schemaGenerator) mapScalar(gqlType string, f *gen.Field, ant *Annotatio
 	if ant != nil && ant.Type != "" {
 		return ant.Type
 	}
+	if t := e.jsonTypeOf(f, typeFilter); t != "" {
+		return t
+	}
 	scalar := f.Type.String()
 	switch t := f.Type.Type; {
 	case f.Name == "id":
@@ -776,6 +1359,59 @@ func builtinTypes() []*ast.Definition {
 	}
 }
 
+// jsonPathValueTypes returns the types used by the OpValueEQ operator.
+func jsonPathValueTypes() []*ast.Definition {
+	return []*ast.Definition{
+		{
+			Name: "Any",
+			Kind: ast.Scalar,
+		},
+		{
+			Name:        OpValueEQ.Type,
+			Kind:        ast.InputObject,
+			Description: "JSONPathValue is used for filtering JSON fields by the value in a path.",
+			Fields: ast.FieldList{
+				{
+					Name:        "path",
+					Type:        ast.NonNullNamedType("String", nil),
+					Description: "The path of the value in dot notation (e.g. \"a.b[2].c\").",
+				},
+				{
+					Name: "value",
+					Type: ast.NonNullNamedType("Any", nil),
+				},
+			},
+			Directives: ast.DirectiveList{goModel(OpValueEQ.GoType)},
+		},
+	}
+}
+
+// usesType reports if the given type is used by the fields of the input objects in the schema.
+func usesType(s *ast.Schema, name string) bool {
+	for _, d := range s.Types {
+		if d.Kind != ast.InputObject {
+			continue
+		}
+		for _, f := range d.Fields {
+			if f.Type.Name() == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func usesDirective(s *ast.Schema, name string) bool {
+	for _, d := range s.Types {
+		for _, f := range d.Fields {
+			if f.Directives.ForName(name) != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func relayBuiltinQueryFields() ast.FieldList {
 	var (
 		idType  = ast.NonNullNamedType("ID", nil)
@@ -918,6 +1554,115 @@ func goField(name string) *ast.Directive {
 	}
 }
 
+func authorize(rules []string) *ast.Directive {
+	values := make(ast.ChildValueList, len(rules))
+	for i, r := range rules {
+		values[i] = &ast.ChildValue{
+			Value: &ast.Value{
+				Kind: ast.StringValue,
+				Raw:  r,
+			},
+		}
+	}
+	return &ast.Directive{
+		Name: authorizeDirective.Name,
+		Arguments: ast.ArgumentList{
+			{
+				Name: "rules",
+				Value: &ast.Value{
+					Kind:     ast.ListValue,
+					Children: values,
+				},
+			},
+		},
+	}
+}
+
+// constraint returns the @constraint directive of the given field in the mutation
+// inputs, or nil if the field has no declared constraints. The maximum length of
+// string fields is derived from their size, that is set by the MaxLen validator.
+func constraint(f *gen.Field, ant *Annotation) *ast.Directive {
+	c := ConstraintConfig{}
+	if ant.Constraint != nil {
+		c = *ant.Constraint
+	}
+	if size := fieldMaxLen(f); c.MaxLength == nil && size > 0 {
+		c.MaxLength = &size
+	}
+	var args ast.ArgumentList
+	arg := func(name string, kind ast.ValueKind, raw string) {
+		args = append(args, &ast.Argument{
+			Name:  name,
+			Value: &ast.Value{Kind: kind, Raw: raw},
+		})
+	}
+	if c.MinLength != nil {
+		arg("minLength", ast.IntValue, strconv.Itoa(*c.MinLength))
+	}
+	if c.MaxLength != nil {
+		arg("maxLength", ast.IntValue, strconv.Itoa(*c.MaxLength))
+	}
+	if c.Min != nil {
+		arg("min", ast.FloatValue, strconv.FormatFloat(*c.Min, 'f', -1, 64))
+	}
+	if c.Max != nil {
+		arg("max", ast.FloatValue, strconv.FormatFloat(*c.Max, 'f', -1, 64))
+	}
+	if c.Pattern != nil {
+		arg("pattern", ast.StringValue, *c.Pattern)
+	}
+	if len(args) == 0 {
+		return nil
+	}
+	return &ast.Directive{Name: constraintDirective.Name, Arguments: args}
+}
+
+// fieldMaxLen returns the maximum length of a string field with
+// validators, or 0 if the field size was not set by the MaxLen validator.
+func fieldMaxLen(f *gen.Field) int {
+	if f.Type.Type != field.TypeString || f.Validators == 0 {
+		return 0
+	}
+	if ant := f.EntSQL(); ant != nil && ant.Size != 0 {
+		return 0
+	}
+	// Text fields have the maximum size by default.
+	if size := f.Column().Size; size > 0 && size < math.MaxInt32 {
+		return int(size)
+	}
+	return 0
+}
+
+func key(fields string) *ast.Directive {
+	return &ast.Directive{
+		Name: "key",
+		Arguments: ast.ArgumentList{
+			{
+				Name: "fields",
+				Value: &ast.Value{
+					Kind: ast.StringValue,
+					Raw:  fields,
+				},
+			},
+		},
+	}
+}
+
+func entityResolver() *ast.Directive {
+	return &ast.Directive{
+		Name: entityResolverDirective.Name,
+		Arguments: ast.ArgumentList{
+			{
+				Name: "multi",
+				Value: &ast.Value{
+					Kind: ast.BooleanValue,
+					Raw:  "true",
+				},
+			},
+		},
+	}
+}
+
 func goModel(ident string) *ast.Directive {
 	return &ast.Directive{
 		Name:     "goModel",
@@ -20,6 +20,7 @@ import (
 
 	"entgo.io/ent/entc"
 	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
 	"github.com/stretchr/testify/require"
 	"github.com/vektah/gqlparser/v2/ast"
 )
@@ -29,7 +30,8 @@ func TestEntGQL_buildTypes(t *testing.T) {
 	require.NoError(t, err)
 
 	graph, err := entc.LoadGraph("./internal/todo/ent/schema", &gen.Config{
-		Storage: s,
+		Storage:  s,
+		Features: []gen.Feature{gen.FeatureUpsert},
 	})
 	require.NoError(t, err)
 	disableRelayConnection(graph)
@@ -49,8 +51,10 @@ func TestEntGQL_buildTypes(t *testing.T) {
   status: CategoryStatus!
   config: CategoryConfig
   duration: Duration
-  count: Uint64
+  count: Uint64 @authorize(rules: ["admin"])
   strings: [String!]
+  types: CategoryTypes
+  slug: String
   todos: [Todo!]
 }
 """Ordering options for Category connections"""
@@ -71,27 +75,92 @@ enum CategoryStatus @goModel(model: "entgo.io/contrib/entgql/internal/todo/ent/c
   DISABLED
 }
 """
+CreateCategoryInput is used for create Category object.
+Input was generated by ent.
+"""
+input CreateCategoryInput {
+  text: String!
+  status: CategoryStatus!
+  config: CategoryConfig
+  duration: Duration
+  count: Uint64
+  strings: [String!]
+  types: CategoryTypes
+  slug: String
+  todoIDs: [ID!]
+}
+"""
 CreateTodoInput is used for create Todo object.
 Input was generated by ent.
 """
 input CreateTodoInput {
   status: TodoStatus!
   priority: Int
-  text: String!
+  text: String! @constraint(minLength: 1)
+  label: String
   parentID: ID
   childIDs: [ID!]
   categoryID: ID
   secretID: ID
+  createChildren: [CreateTodoInput!]
+  createCategory: CreateCategoryInput
+}
+type Friendship {
+  id: ID!
+  createdAt: Time!
+  userID: ID!
+  friendID: ID!
+  user: User!
+  friend: User!
+}
+"""Ordering options for Friendship connections"""
+input FriendshipOrder {
+  """The ordering direction."""
+  direction: OrderDirection! = ASC
+  """The field by which to order Friendships."""
+  field: FriendshipOrderField!
+}
+"""Properties by which Friendship connections can be ordered."""
+enum FriendshipOrderField {
+  CREATED_AT
 }
 type Group {
   id: ID!
   name: String!
   users: [User!]
 }
+type Mutation {
+  createCategory(input: CreateCategoryInput!): Category!
+  deleteCategory(id: ID!): ID!
+  upsertCategory(input: UpsertCategoryInput!): Category!
+  createTodo(input: CreateTodoInput!): Todo!
+  updateTodo(id: ID!, input: UpdateTodoInput!): Todo!
+  deleteTodo(id: ID!): ID!
+  createTodos(inputs: [CreateTodoInput!]!): [Todo!]!
+}
 type Query {
   groups: [Group!]!
   todos: [Todo!]!
+  todosAggregate(
+    """The fields to group the aggregations by."""
+    groupBy: [TodoGroupField!]
+  ): [TodoAggregate!]!
   users: [User!]!
+  usersPage(
+    """Skips the given number of elements."""
+    offset: Int
+
+    """Returns up to _n_ elements."""
+    limit: Int
+  ): UserPage!
+}
+type Subscription {
+  """Emits the Todo objects that were created."""
+  todoCreated: Todo!
+  """Emits the Todo objects that were updated, optionally filtered by their id."""
+  todoUpdated(id: ID): Todo!
+  """Emits the ids of the Todo objects that were deleted."""
+  todoDeleted: ID!
 }
 type Todo {
   id: ID!
@@ -100,10 +169,39 @@ type Todo {
   priority: Int!
   text: String!
   categoryID: ID
+  deletedAt: Time
+  version: Int!
+  label: String
   parent: Todo
   children: [Todo!]
   category: Category
 }
+"""Aggregated values of a group of Todo nodes."""
+type TodoAggregate {
+  """The number of nodes in the group."""
+  count: Int!
+  """The status of the group, or null if the aggregations are not grouped by it."""
+  status: TodoStatus
+  """The priority of the group, or null if the aggregations are not grouped by it."""
+  priority: Int
+  """The label of the group, or null if the aggregations are not grouped by it."""
+  label: String
+  sum: TodoAggregateValues!
+  avg: TodoAggregateValues!
+  min: TodoAggregateValues!
+  max: TodoAggregateValues!
+}
+"""Results of an aggregation function applied on the numeric fields of Todo."""
+type TodoAggregateValues {
+  priority: Float
+  version: Float
+}
+"""Properties by which Todo aggregations can be grouped."""
+enum TodoGroupField {
+  STATUS
+  PRIORITY
+  LABEL
+}
 """Ordering options for Todo connections"""
 input TodoOrder {
   """The ordering direction."""
@@ -117,17 +215,73 @@ enum TodoOrderField {
   STATUS
   PRIORITY
   TEXT
+  CHILDREN_COUNT
+  CATEGORY_TEXT
 }
 """TodoStatus is enum for the field status"""
 enum TodoStatus @goModel(model: "entgo.io/contrib/entgql/internal/todo/ent/todo.Status") {
   IN_PROGRESS
   COMPLETED
 }
+"""
+UpdateTodoInput is used for update Todo object.
+Input was generated by ent.
+"""
+input UpdateTodoInput {
+  status: TodoStatus
+  priority: Int
+  text: String @constraint(minLength: 1)
+  version: Int
+  clearLabel: Boolean
+  label: String
+  clearParent: Boolean
+  parentID: ID
+  addChildIDs: [ID!]
+  removeChildIDs: [ID!]
+  clearCategory: Boolean
+  categoryID: ID
+  clearSecret: Boolean
+  secretID: ID
+  createChildren: [CreateTodoInput!]
+  createCategory: CreateCategoryInput
+}
+"""
+UpsertCategoryInput is used for upsert Category object.
+Input was generated by ent.
+"""
+input UpsertCategoryInput {
+  text: String!
+  status: CategoryStatus!
+  config: CategoryConfig
+  duration: Duration
+  count: Uint64
+  strings: [String!]
+  types: CategoryTypes
+  slug: String!
+}
 type User {
   id: ID!
   name: String!
   groups: [Group!]
-  friends: [User!]
+  friends(
+    """Skips the given number of elements."""
+    offset: Int
+
+    """Returns up to _n_ elements."""
+    limit: Int
+  ): UserPage!
+  friendships: [Friendship!]
+}
+"""A page of Users returned by offset-based pagination."""
+type UserPage {
+  """The items of the page."""
+  nodes: [User!]!
+  """When paginating forwards, are there more items?"""
+  hasNextPage: Boolean!
+  """When paginating backwards, are there more items?"""
+  hasPreviousPage: Boolean!
+  """Identifies the total count of items."""
+  totalCount: Int!
 }
 `, printSchema(schema))
 }
@@ -137,7 +291,8 @@ func TestEntGQL_buildTypes_todoplugin_relay(t *testing.T) {
 	require.NoError(t, err)
 
 	graph, err := entc.LoadGraph("./internal/todo/ent/schema", &gen.Config{
-		Storage: s,
+		Storage:  s,
+		Features: []gen.Feature{gen.FeatureUpsert},
 	})
 	require.NoError(t, err)
 	plugin := newSchemaGenerator()
@@ -155,8 +310,10 @@ func TestEntGQL_buildTypes_todoplugin_relay(t *testing.T) {
   status: CategoryStatus!
   config: CategoryConfig
   duration: Duration
-  count: Uint64
+  count: Uint64 @authorize(rules: ["admin"])
   strings: [String!]
+  types: CategoryTypes
+  slug: String
   todos(
     """Returns the elements in the list that come after the specified cursor."""
     after: Cursor
@@ -171,10 +328,13 @@ func TestEntGQL_buildTypes_todoplugin_relay(t *testing.T) {
     last: Int
 
     """Ordering options for Todos returned from the connection."""
-    orderBy: TodoOrder
+    orderBy: [TodoOrder!]
 
     """Filtering options for Todos returned from the connection."""
     where: TodoWhereInput
+
+    """Includes the deleted Todos in the result."""
+    includeDeleted: Boolean
   ): TodoConnection!
 }
 """Ordering options for Category connections"""
@@ -241,6 +401,8 @@ input CategoryWhereInput {
   configLTE: CategoryConfig
   configIsNil: Boolean
   configNotNil: Boolean
+  configHasKey: String
+  configValueEQ: JSONPathValue
   """duration field predicates"""
   duration: Duration
   durationNEQ: Duration
@@ -253,32 +415,146 @@ input CategoryWhereInput {
   durationIsNil: Boolean
   durationNotNil: Boolean
   """count field predicates"""
-  count: Uint64
-  countNEQ: Uint64
-  countIn: [Uint64!]
-  countNotIn: [Uint64!]
-  countGT: Uint64
-  countGTE: Uint64
-  countLT: Uint64
-  countLTE: Uint64
-  countIsNil: Boolean
-  countNotNil: Boolean
+  count: Uint64 @authorize(rules: ["admin"])
+  countNEQ: Uint64 @authorize(rules: ["admin"])
+  countIn: [Uint64!] @authorize(rules: ["admin"])
+  countNotIn: [Uint64!] @authorize(rules: ["admin"])
+  countGT: Uint64 @authorize(rules: ["admin"])
+  countGTE: Uint64 @authorize(rules: ["admin"])
+  countLT: Uint64 @authorize(rules: ["admin"])
+  countLTE: Uint64 @authorize(rules: ["admin"])
+  countIsNil: Boolean @authorize(rules: ["admin"])
+  countNotNil: Boolean @authorize(rules: ["admin"])
+  """strings field predicates"""
+  stringsValueEQ: JSONPathValue
+  """slug field predicates"""
+  slug: String
+  slugNEQ: String
+  slugIn: [String!]
+  slugNotIn: [String!]
+  slugGT: String
+  slugGTE: String
+  slugLT: String
+  slugLTE: String
+  slugContains: String
+  slugHasPrefix: String
+  slugHasSuffix: String
+  slugIsNil: Boolean
+  slugNotNil: Boolean
+  slugEqualFold: String
+  slugContainsFold: String
   """todos edge predicates"""
   hasTodos: Boolean
   hasTodosWith: [TodoWhereInput!]
 }
 """
+CreateCategoryInput is used for create Category object.
+Input was generated by ent.
+"""
+input CreateCategoryInput {
+  text: String!
+  status: CategoryStatus!
+  config: CategoryConfig
+  duration: Duration
+  count: Uint64
+  strings: [String!]
+  types: CategoryTypes
+  slug: String
+  todoIDs: [ID!]
+}
+"""
 CreateTodoInput is used for create Todo object.
 Input was generated by ent.
 """
 input CreateTodoInput {
   status: TodoStatus!
   priority: Int
-  text: String!
+  text: String! @constraint(minLength: 1)
+  label: String
   parentID: ID
   childIDs: [ID!]
   categoryID: ID
   secretID: ID
+  createChildren: [CreateTodoInput!]
+  createCategory: CreateCategoryInput
+}
+type Friendship implements Node {
+  id: ID!
+  createdAt: Time!
+  userID: ID!
+  friendID: ID!
+  user: User!
+  friend: User!
+}
+"""A connection to a list of items."""
+type FriendshipConnection {
+  """A list of edges."""
+  edges: [FriendshipEdge]
+  """Information to aid in pagination."""
+  pageInfo: PageInfo!
+  """Identifies the total count of items in the connection."""
+  totalCount: Int!
+}
+"""An edge in a connection."""
+type FriendshipEdge {
+  """The item at the end of the edge."""
+  node: Friendship
+  """A cursor for use in pagination."""
+  cursor: Cursor!
+}
+"""Ordering options for Friendship connections"""
+input FriendshipOrder {
+  """The ordering direction."""
+  direction: OrderDirection! = ASC
+  """The field by which to order Friendships."""
+  field: FriendshipOrderField!
+}
+"""Properties by which Friendship connections can be ordered."""
+enum FriendshipOrderField {
+  CREATED_AT
+}
+"""
+FriendshipWhereInput is used for filtering Friendship objects.
+Input was generated by ent.
+"""
+input FriendshipWhereInput {
+  not: FriendshipWhereInput
+  and: [FriendshipWhereInput!]
+  or: [FriendshipWhereInput!]
+  """id field predicates"""
+  id: ID
+  idNEQ: ID
+  idIn: [ID!]
+  idNotIn: [ID!]
+  idGT: ID
+  idGTE: ID
+  idLT: ID
+  idLTE: ID
+  """created_at field predicates"""
+  createdAt: Time
+  createdAtNEQ: Time
+  createdAtIn: [Time!]
+  createdAtNotIn: [Time!]
+  createdAtGT: Time
+  createdAtGTE: Time
+  createdAtLT: Time
+  createdAtLTE: Time
+  """user_id field predicates"""
+  userID: ID
+  userIDNEQ: ID
+  userIDIn: [ID!]
+  userIDNotIn: [ID!]
+  """friend_id field predicates"""
+  friendID: ID
+  friendIDNEQ: ID
+  friendIDIn: [ID!]
+  friendIDNotIn: [ID!]
+  """user edge predicates"""
+  hasUser: Boolean
+  hasUserWith: [UserWhereInput!]
+  """friend edge predicates"""
+  hasFriend: Boolean
+  hasFriendWith: [UserWhereInput!]
 }
 type Group implements Node {
   id: ID!
@@ -351,6 +627,17 @@ input GroupWhereInput {
   hasUsers: Boolean
   hasUsersWith: [UserWhereInput!]
 }
+type Mutation {
+  createCategory(input: CreateCategoryInput!): Category!
+  deleteCategory(id: ID!): ID!
+  upsertCategory(input: UpsertCategoryInput!): Category!
+  createTodo(input: CreateTodoInput!): Todo!
+  updateTodo(id: ID!, input: UpdateTodoInput!): Todo!
+  deleteTodo(id: ID!): ID!
+  createTodos(inputs: [CreateTodoInput!]!): [Todo!]!
+  updateTodos(where: TodoWhereInput!, input: UpdateTodoInput!): Int!
+  deleteTodos(where: TodoWhereInput!): Int!
+}
 type Query {
   """Fetches an object given its ID."""
   node(
@@ -392,11 +679,21 @@ type Query {
     last: Int
 
     """Ordering options for Todos returned from the connection."""
-    orderBy: TodoOrder
+    orderBy: [TodoOrder!]
 
     """Filtering options for Todos returned from the connection."""
     where: TodoWhereInput
+
+    """Includes the deleted Todos in the result."""
+    includeDeleted: Boolean
   ): TodoConnection!
+  todosAggregate(
+    """Filtering options for the aggregated Todos."""
+    where: TodoWhereInput
+
+    """The fields to group the aggregations by."""
+    groupBy: [TodoGroupField!]
+  ): [TodoAggregate!]!
   users(
     """Returns the elements in the list that come after the specified cursor."""
     after: Cursor
@@ -413,6 +710,24 @@ type Query {
     """Filtering options for Users returned from the connection."""
     where: UserWhereInput
   ): UserConnection!
+  usersPage(
+    """Skips the given number of elements."""
+    offset: Int
+
+    """Returns up to _n_ elements."""
+    limit: Int
+
+    """Filtering options for Users returned from the page."""
+    where: UserWhereInput
+  ): UserPage!
+}
+type Subscription {
+  """Emits the Todo objects that were created."""
+  todoCreated: Todo!
+  """Emits the Todo objects that were updated, optionally filtered by their id."""
+  todoUpdated(id: ID): Todo!
+  """Emits the ids of the Todo objects that were deleted."""
+  todoDeleted: ID!
 }
 type Todo implements Node {
   id: ID!
@@ -421,6 +736,9 @@ type Todo implements Node {
   priority: Int!
   text: String!
   categoryID: ID
+  deletedAt: Time
+  version: Int!
+  label: String
   parent: Todo
   children(
     """Returns the elements in the list that come after the specified cursor."""
@@ -436,13 +754,36 @@ type Todo implements Node {
     last: Int
 
     """Ordering options for Todos returned from the connection."""
-    orderBy: TodoOrder
+    orderBy: [TodoOrder!]
 
     """Filtering options for Todos returned from the connection."""
     where: TodoWhereInput
+
+    """Includes the deleted Todos in the result."""
+    includeDeleted: Boolean
   ): TodoConnection!
   category: Category
 }
+"""Aggregated values of a group of Todo nodes."""
+type TodoAggregate {
+  """The number of nodes in the group."""
+  count: Int!
+  """The status of the group, or null if the aggregations are not grouped by it."""
+  status: TodoStatus
+  """The priority of the group, or null if the aggregations are not grouped by it."""
+  priority: Int
+  """The label of the group, or null if the aggregations are not grouped by it."""
+  label: String
+  sum: TodoAggregateValues!
+  avg: TodoAggregateValues!
+  min: TodoAggregateValues!
+  max: TodoAggregateValues!
+}
+"""Results of an aggregation function applied on the numeric fields of Todo."""
+type TodoAggregateValues {
+  priority: Float
+  version: Float
+}
 """A connection to a list of items."""
 type TodoConnection {
   """A list of edges."""
@@ -459,6 +800,12 @@ type TodoEdge {
   """A cursor for use in pagination."""
   cursor: Cursor!
 }
+"""Properties by which Todo aggregations can be grouped."""
+enum TodoGroupField {
+  STATUS
+  PRIORITY
+  LABEL
+}
 """Ordering options for Todo connections"""
 input TodoOrder {
   """The ordering direction."""
@@ -472,6 +819,8 @@ enum TodoOrderField {
   STATUS
   PRIORITY
   TEXT
+  CHILDREN_COUNT
+  CATEGORY_TEXT
 }
 """TodoStatus is enum for the field status"""
 enum TodoStatus @goModel(model: "entgo.io/contrib/entgql/internal/todo/ent/todo.Status") {
@@ -539,6 +888,42 @@ input TodoWhereInput {
   categoryIDNotIn: [ID!]
   categoryIDIsNil: Boolean
   categoryIDNotNil: Boolean
+  """deleted_at field predicates"""
+  deletedAt: Time
+  deletedAtNEQ: Time
+  deletedAtIn: [Time!]
+  deletedAtNotIn: [Time!]
+  deletedAtGT: Time
+  deletedAtGTE: Time
+  deletedAtLT: Time
+  deletedAtLTE: Time
+  deletedAtIsNil: Boolean
+  deletedAtNotNil: Boolean
+  """version field predicates"""
+  version: Int
+  versionNEQ: Int
+  versionIn: [Int!]
+  versionNotIn: [Int!]
+  versionGT: Int
+  versionGTE: Int
+  versionLT: Int
+  versionLTE: Int
+  """label field predicates"""
+  label: String
+  labelNEQ: String
+  labelIn: [String!]
+  labelNotIn: [String!]
+  labelGT: String
+  labelGTE: String
+  labelLT: String
+  labelLTE: String
+  labelContains: String
+  labelHasPrefix: String
+  labelHasSuffix: String
+  labelIsNil: Boolean
+  labelNotNil: Boolean
+  labelEqualFold: String
+  labelContainsFold: String
   """parent edge predicates"""
   hasParent: Boolean
   hasParentWith: [TodoWhereInput!]
@@ -549,6 +934,42 @@ input TodoWhereInput {
   hasCategory: Boolean
   hasCategoryWith: [CategoryWhereInput!]
 }
+"""
+UpdateTodoInput is used for update Todo object.
+Input was generated by ent.
+"""
+input UpdateTodoInput {
+  status: TodoStatus
+  priority: Int
+  text: String @constraint(minLength: 1)
+  version: Int
+  clearLabel: Boolean
+  label: String
+  clearParent: Boolean
+  parentID: ID
+  addChildIDs: [ID!]
+  removeChildIDs: [ID!]
+  clearCategory: Boolean
+  categoryID: ID
+  clearSecret: Boolean
+  secretID: ID
+  createChildren: [CreateTodoInput!]
+  createCategory: CreateCategoryInput
+}
+"""
+UpsertCategoryInput is used for upsert Category object.
+Input was generated by ent.
+"""
+input UpsertCategoryInput {
+  text: String!
+  status: CategoryStatus!
+  config: CategoryConfig
+  duration: Duration
+  count: Uint64
+  strings: [String!]
+  types: CategoryTypes
+  slug: String!
+}
 type User implements Node {
   id: ID!
   name: String!
@@ -568,7 +989,35 @@ type User implements Node {
     """Filtering options for Groups returned from the connection."""
     where: GroupWhereInput
   ): GroupConnection!
-  friends: [User!]
+  friends(
+    """Skips the given number of elements."""
+    offset: Int
+
+    """Returns up to _n_ elements."""
+    limit: Int
+
+    """Filtering options for Users returned from the page."""
+    where: UserWhereInput
+  ): UserPage!
+  friendships(
+    """Returns the elements in the list that come after the specified cursor."""
+    after: Cursor
+
+    """Returns the first _n_ elements from the list."""
+    first: Int
+
+    """Returns the elements in the list that come before the specified cursor."""
+    before: Cursor
+
+    """Returns the last _n_ elements from the list."""
+    last: Int
+
+    """Ordering options for Friendships returned from the connection."""
+    orderBy: FriendshipOrder
+
+    """Filtering options for Friendships returned from the connection."""
+    where: FriendshipWhereInput
+  ): FriendshipConnection!
 }
 """A connection to a list of items."""
 type UserConnection {
@@ -586,6 +1035,17 @@ type UserEdge {
   """A cursor for use in pagination."""
   cursor: Cursor!
 }
+"""A page of Users returned by offset-based pagination."""
+type UserPage {
+  """The items of the page."""
+  nodes: [User!]!
+  """When paginating forwards, are there more items?"""
+  hasNextPage: Boolean!
+  """When paginating backwards, are there more items?"""
+  hasPreviousPage: Boolean!
+  """Identifies the total count of items."""
+  totalCount: Int!
+}
 """
 UserWhereInput is used for filtering User objects.
 Input was generated by ent.
@@ -623,6 +1083,9 @@ input UserWhereInput {
   """friends edge predicates"""
   hasFriends: Boolean
   hasFriendsWith: [UserWhereInput!]
+  """friendships edge predicates"""
+  hasFriendships: Boolean
+  hasFriendshipsWith: [FriendshipWhereInput!]
 }
 `, printSchema(schema))
 }
@@ -782,3 +1245,183 @@ func disableRelayConnection(g *gen.Graph) {
 		}
 	}
 }
+
+func TestSchema_federation(t *testing.T) {
+	plugin := newSchemaGenerator()
+	plugin.genSchema = true
+	plugin.relaySpec = false
+	plugin.federation = true
+	todo := &gen.Type{
+		Name: "Todo",
+		ID:   &gen.Field{Name: "id", Type: &field.TypeInfo{Type: field.TypeInt}},
+		Fields: []*gen.Field{
+			{
+				Name: "text",
+				Type: &field.TypeInfo{Type: field.TypeString},
+				Annotations: map[string]interface{}{
+					annotationName: Directives(Shareable()),
+				},
+			},
+		},
+	}
+	user := &gen.Type{
+		Name: "User",
+		ID:   &gen.Field{Name: "id", Type: &field.TypeInfo{Type: field.TypeInt}},
+		Fields: []*gen.Field{
+			{Name: "email", Type: &field.TypeInfo{Type: field.TypeString}},
+		},
+		Annotations: map[string]interface{}{
+			annotationName: Directives(Key("email")),
+		},
+	}
+	s, err := plugin.BuildSchema(&gen.Graph{
+		Config: &gen.Config{Package: "example.com/ent"},
+		Nodes:  []*gen.Type{todo, user},
+	})
+	require.NoError(t, err)
+	require.Equal(t, entityResolverDirective, s.Directives["entityResolver"])
+	types := &ast.Schema{}
+	types.AddTypes(s.Types["Todo"], s.Types["User"])
+	require.Equal(t, `type Todo @key(fields: "id") @entityResolver(multi: true) {
+  id: ID!
+  text: String! @shareable
+}
+type User @key(fields: "email") @entityResolver(multi: true) {
+  id: ID!
+  email: String!
+}
+`, printSchema(types))
+}
+
+func TestSchema_interfaces(t *testing.T) {
+	plugin := newSchemaGenerator()
+	plugin.genSchema = true
+	newType := func(name string) *gen.Type {
+		return &gen.Type{
+			Name: name,
+			ID:   &gen.Field{Name: "id", Type: &field.TypeInfo{Type: field.TypeInt}},
+			Fields: []*gen.Field{
+				{Name: "created_at", Type: &field.TypeInfo{Type: field.TypeTime}},
+				{Name: "text", Type: &field.TypeInfo{Type: field.TypeString}},
+			},
+			Annotations: map[string]interface{}{
+				annotationName: Interface("Timestamped", "created_at").QueryField("timestamped"),
+			},
+		}
+	}
+	s, err := plugin.BuildSchema(&gen.Graph{
+		Config: &gen.Config{Package: "example.com/ent"},
+		Nodes:  []*gen.Type{newType("Todo"), newType("User")},
+	})
+	require.NoError(t, err)
+	types := &ast.Schema{}
+	types.AddTypes(s.Types["Timestamped"], s.Types["Todo"], s.Types["User"])
+	require.Equal(t, `interface Timestamped {
+  id: ID!
+  createdAt: Time!
+}
+type Todo implements Node & Timestamped {
+  id: ID!
+  createdAt: Time!
+  text: String!
+}
+type User implements Node & Timestamped {
+  id: ID!
+  createdAt: Time!
+  text: String!
+}
+`, printSchema(types))
+	require.NotNil(t, s.Types["TimestampedConnection"])
+	require.Equal(t, "TimestampedConnection!", s.Types["Query"].Fields.ForName("timestamped").Type.String())
+
+	user := newType("User")
+	user.Fields[0].Type = &field.TypeInfo{Type: field.TypeString}
+	_, err = plugin.BuildSchema(&gen.Graph{
+		Config: &gen.Config{Package: "example.com/ent"},
+		Nodes:  []*gen.Type{newType("Todo"), user},
+	})
+	require.EqualError(t, err, "entgql: field User.created_at of type String! does not match the type Time! of the interface Timestamped")
+
+	secretType := func(name string, rules ...string) *gen.Type {
+		n := newType(name)
+		n.Annotations[annotationName] = &Annotation{
+			Interfaces: n.Annotations[annotationName].(interfaceAnnotation).Interfaces,
+			Authorize:  rules,
+		}
+		return n
+	}
+	s, err = plugin.BuildSchema(&gen.Graph{
+		Config: &gen.Config{Package: "example.com/ent"},
+		Nodes:  []*gen.Type{secretType("Todo", "admin"), secretType("User", "admin")},
+	})
+	require.NoError(t, err)
+	require.Equal(t, authorize([]string{"admin"}), s.Types["Query"].Fields.ForName("timestamped").Directives.ForName("authorize"))
+	_, err = plugin.BuildSchema(&gen.Graph{
+		Config: &gen.Config{Package: "example.com/ent"},
+		Nodes:  []*gen.Type{secretType("Todo", "admin"), newType("User")},
+	})
+	require.EqualError(t, err, "entgql: types Todo and User of the interface Timestamped are authorized by different rules")
+
+	plugin.relaySpec = false
+	_, err = plugin.BuildSchema(&gen.Graph{
+		Config: &gen.Config{Package: "example.com/ent"},
+		Nodes:  []*gen.Type{newType("Todo")},
+	})
+	require.ErrorIs(t, err, ErrRelaySpecDisabled)
+}
+
+func TestSchema_aggregate(t *testing.T) {
+	plugin := newSchemaGenerator()
+	plugin.genSchema = true
+	s, err := plugin.BuildSchema(&gen.Graph{
+		Config: &gen.Config{Package: "example.com/ent"},
+		Nodes: []*gen.Type{{
+			Name: "Todo",
+			ID:   &gen.Field{Name: "id", Type: &field.TypeInfo{Type: field.TypeInt}},
+			Fields: []*gen.Field{
+				{Name: "priority", Type: &field.TypeInfo{Type: field.TypeInt}},
+			},
+			Annotations: map[string]interface{}{
+				annotationName: &Annotation{
+					Aggregate: &FieldConfig{},
+					Authorize: []string{"admin"},
+				},
+			},
+		}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "[TodoAggregate!]!", s.Types["Query"].Fields.ForName("todosAggregate").Type.String())
+	require.Equal(t, authorize([]string{"admin"}), s.Types["TodoAggregate"].Directives.ForName("authorize"))
+	require.Nil(t, s.Types["TodoAggregateValues"].Directives.ForName("authorize"))
+}
+
+func TestSchema_compositeEdgeSchema(t *testing.T) {
+	plugin := newSchemaGenerator()
+	plugin.genSchema = true
+	user := &gen.Type{
+		Name: "User",
+		ID:   &gen.Field{Name: "id", Type: &field.TypeInfo{Type: field.TypeInt}},
+	}
+	friendship := &gen.Type{
+		Name: "Friendship",
+		Fields: []*gen.Field{
+			{Name: "user_id", Type: &field.TypeInfo{Type: field.TypeInt}},
+			{Name: "friend_id", Type: &field.TypeInfo{Type: field.TypeInt}},
+		},
+	}
+	friendship.EdgeSchema.ID = friendship.Fields
+	friendship.EdgeSchema.To = &gen.Edge{Name: "friends", Type: user}
+	friendship.EdgeSchema.From = friendship.EdgeSchema.To
+	graph := &gen.Graph{
+		Config: &gen.Config{Package: "example.com/ent"},
+		Nodes:  []*gen.Type{user, friendship},
+	}
+	_, err := plugin.BuildSchema(graph)
+	require.EqualError(t, err, "entgql: edge schema Friendship with a composite id is not supported, and must be skipped with entgql.Skip(entgql.SkipType)")
+
+	friendship.Annotations = map[string]interface{}{annotationName: Skip(SkipType)}
+	s, err := plugin.BuildSchema(graph)
+	require.NoError(t, err)
+	require.NotNil(t, s.Types["User"])
+	require.Nil(t, s.Types["Friendship"])
+}
@@ -0,0 +1,326 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// ChangeLevel describes how a schema change affects the existing clients.
+type ChangeLevel int
+
+// List of change levels.
+const (
+	// ChangeSafe is a change that does not affect the existing clients,
+	// such as adding a type, an optional argument or an output field.
+	ChangeSafe ChangeLevel = iota
+	// ChangeDangerous is a change that may affect the existing clients at
+	// runtime, such as adding an enum value or removing a deprecated field.
+	ChangeDangerous
+	// ChangeBreaking is a change that breaks the existing clients,
+	// such as removing a field or making an argument required.
+	ChangeBreaking
+)
+
+// String implements the fmt.Stringer interface.
+func (l ChangeLevel) String() string {
+	switch l {
+	case ChangeSafe:
+		return "safe"
+	case ChangeDangerous:
+		return "dangerous"
+	case ChangeBreaking:
+		return "breaking"
+	default:
+		return fmt.Sprintf("ChangeLevel(%d)", int(l))
+	}
+}
+
+// SchemaChange describes a change between two versions of a GraphQL schema.
+type SchemaChange struct {
+	// Level of the change.
+	Level ChangeLevel
+	// Path of the changed schema element. For example, "Todo" for a type,
+	// "Todo.text" for a field or an enum value, and "Query.todos.first"
+	// for an argument.
+	Path string
+	// Message describes the change.
+	Message string
+}
+
+// String implements the fmt.Stringer interface.
+func (c *SchemaChange) String() string {
+	return fmt.Sprintf("%s: %s", c.Level, c.Message)
+}
+
+// DiffSchema compares the types of the given schemas, and returns the list of
+// changes, sorted by their paths. Removing a deprecated field, argument or enum value is
+// reported as a dangerous change, and not as a breaking one.
+func DiffSchema(from, to *ast.Schema) []*SchemaChange {
+	d := &schemaDiff{}
+	for name, o := range from.Types {
+		n, ok := to.Types[name]
+		switch {
+		case !ok:
+			d.add(ChangeBreaking, name, "Type %s was removed.", name)
+		case o.Kind != n.Kind:
+			d.add(ChangeBreaking, name, "Type %s changed kind from %s to %s.", name, o.Kind, n.Kind)
+		default:
+			d.diffDefinition(o, n)
+		}
+	}
+	for name := range to.Types {
+		if _, ok := from.Types[name]; !ok {
+			d.add(ChangeSafe, name, "Type %s was added.", name)
+		}
+	}
+	sort.SliceStable(d.changes, func(i, j int) bool {
+		return d.changes[i].Path < d.changes[j].Path
+	})
+	return d.changes
+}
+
+// BreakingChangesError is returned by the code generation if the generated
+// GraphQL schema contains breaking changes that were not allowed.
+type BreakingChangesError struct {
+	// Path of the GraphQL schema file.
+	Path string
+	// Changes that break the existing clients.
+	Changes []*SchemaChange
+}
+
+// Error implements the error interface.
+func (e *BreakingChangesError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "entgql: breaking changes in %s:", e.Path)
+	for _, c := range e.Changes {
+		fmt.Fprintf(&b, "\n\t%s", c.Message)
+	}
+	return b.String()
+}
+
+// checkBreakingChanges compares the generated schema to the schema in the
+// given file, and returns a *BreakingChangesError if it contains breaking
+// changes that were not allowed. A missing file is ignored.
+func checkBreakingChanges(path string, s *ast.Schema, allowed map[string]bool) error {
+	old, err := loadSchemaFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var breaking []*SchemaChange
+	for _, c := range DiffSchema(old, s) {
+		if c.Level == ChangeBreaking && !allowed[c.Path] {
+			breaking = append(breaking, c)
+		}
+	}
+	if len(breaking) > 0 {
+		return &BreakingChangesError{Path: path, Changes: breaking}
+	}
+	return nil
+}
+
+// loadSchemaFile parses the schema in the given file without validating it, as the
+// generated schema may depend on types that are defined in other schema files.
+func loadSchemaFile(path string) (*ast.Schema, error) {
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	doc, gqlErr := parser.ParseSchema(&ast.Source{Name: path, Input: string(buf)})
+	if gqlErr != nil {
+		return nil, fmt.Errorf("entgql: parsing schema %s: %w", path, gqlErr)
+	}
+	s := &ast.Schema{Types: make(map[string]*ast.Definition)}
+	for _, def := range doc.Definitions {
+		s.Types[def.Name] = def
+	}
+	for _, ext := range doc.Extensions {
+		def, ok := s.Types[ext.Name]
+		if !ok {
+			s.Types[ext.Name] = ext
+			continue
+		}
+		def.Interfaces = append(def.Interfaces, ext.Interfaces...)
+		def.Fields = append(def.Fields, ext.Fields...)
+		def.EnumValues = append(def.EnumValues, ext.EnumValues...)
+		def.Types = append(def.Types, ext.Types...)
+	}
+	return s, nil
+}
+
+type schemaDiff struct {
+	changes []*SchemaChange
+}
+
+func (d *schemaDiff) add(level ChangeLevel, path, format string, args ...interface{}) {
+	d.changes = append(d.changes, &SchemaChange{
+		Level:   level,
+		Path:    path,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// removed adds a change for a removed element, that is
+// dangerous if the element was deprecated, or breaking otherwise.
+func (d *schemaDiff) removed(dirs ast.DirectiveList, path, kind string) {
+	if dirs.ForName("deprecated") != nil {
+		d.add(ChangeDangerous, path, "Deprecated %s %s was removed.", strings.ToLower(kind), path)
+	} else {
+		d.add(ChangeBreaking, path, "%s %s was removed.", kind, path)
+	}
+}
+
+func (d *schemaDiff) diffDefinition(o, n *ast.Definition) {
+	switch o.Kind {
+	case ast.Object, ast.Interface:
+		for _, i := range o.Interfaces {
+			if !contains(n.Interfaces, i) {
+				d.add(ChangeBreaking, o.Name, "Type %s no longer implements %s.", o.Name, i)
+			}
+		}
+		for _, i := range n.Interfaces {
+			if !contains(o.Interfaces, i) {
+				d.add(ChangeDangerous, o.Name, "Type %s implements %s.", o.Name, i)
+			}
+		}
+		d.diffFields(o, n, false)
+	case ast.InputObject:
+		d.diffFields(o, n, true)
+	case ast.Enum:
+		for _, v := range o.EnumValues {
+			if n.EnumValues.ForName(v.Name) == nil {
+				d.removed(v.Directives, o.Name+"."+v.Name, "Enum value")
+			}
+		}
+		for _, v := range n.EnumValues {
+			if o.EnumValues.ForName(v.Name) == nil {
+				d.add(ChangeDangerous, o.Name+"."+v.Name, "Enum value %s.%s was added.", o.Name, v.Name)
+			}
+		}
+	case ast.Union:
+		for _, t := range o.Types {
+			if !contains(n.Types, t) {
+				d.add(ChangeBreaking, o.Name, "Type %s was removed from union %s.", t, o.Name)
+			}
+		}
+		for _, t := range n.Types {
+			if !contains(o.Types, t) {
+				d.add(ChangeDangerous, o.Name, "Type %s was added to union %s.", t, o.Name)
+			}
+		}
+	}
+}
+
+func (d *schemaDiff) diffFields(o, n *ast.Definition, input bool) {
+	for _, of := range o.Fields {
+		path := o.Name + "." + of.Name
+		nf := n.Fields.ForName(of.Name)
+		if nf == nil {
+			d.removed(of.Directives, path, "Field")
+			continue
+		}
+		if input {
+			d.diffInputType(path, "Field", of.Type, nf.Type)
+			continue
+		}
+		if !isSubtype(nf.Type, of.Type) {
+			d.add(ChangeBreaking, path, "Field %s changed type from %s to %s.", path, of.Type, nf.Type)
+		}
+		for _, oa := range of.Arguments {
+			apath := path + "." + oa.Name
+			na := nf.Arguments.ForName(oa.Name)
+			if na == nil {
+				d.removed(oa.Directives, apath, "Argument")
+				continue
+			}
+			d.diffInputType(apath, "Argument", oa.Type, na.Type)
+			if oa.DefaultValue.String() != na.DefaultValue.String() {
+				d.add(ChangeDangerous, apath, "Argument %s changed its default value.", apath)
+			}
+		}
+		for _, na := range nf.Arguments {
+			if of.Arguments.ForName(na.Name) == nil {
+				d.added(path+"."+na.Name, "Argument", na.Type, na.DefaultValue)
+			}
+		}
+	}
+	for _, nf := range n.Fields {
+		if o.Fields.ForName(nf.Name) != nil {
+			continue
+		}
+		path := o.Name + "." + nf.Name
+		if input {
+			d.added(path, "Field", nf.Type, nf.DefaultValue)
+		} else {
+			d.add(ChangeSafe, path, "Field %s was added.", path)
+		}
+	}
+}
+
+// diffInputType adds a breaking change if the type of an input field or an
+// argument does not accept all values that were accepted by its old type.
+func (d *schemaDiff) diffInputType(path, kind string, o, n *ast.Type) {
+	switch {
+	case isSubtype(o, n) && o.String() != n.String():
+		d.add(ChangeSafe, path, "%s %s changed type from %s to %s.", kind, path, o, n)
+	case !isSubtype(o, n):
+		d.add(ChangeBreaking, path, "%s %s changed type from %s to %s.", kind, path, o, n)
+	}
+}
+
+// added adds a change for an added input field or argument,
+// that is breaking if it is required and has no default value.
+func (d *schemaDiff) added(path, kind string, t *ast.Type, def *ast.Value) {
+	if t.NonNull && def == nil {
+		d.add(ChangeBreaking, path, "Required %s %s was added.", strings.ToLower(kind), path)
+	} else {
+		d.add(ChangeSafe, path, "%s %s was added.", kind, path)
+	}
+}
+
+// isSubtype reports if the type t is a subtype of the type of, i.e. t
+// is equal to of, or it is a non-null (nested) variant of it.
+func isSubtype(t, of *ast.Type) bool {
+	switch {
+	case of.NonNull && !t.NonNull:
+		return false
+	case (t.Elem == nil) != (of.Elem == nil):
+		return false
+	case t.Elem != nil:
+		return isSubtype(t.Elem, of.Elem)
+	default:
+		return t.NamedType == of.NamedType
+	}
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
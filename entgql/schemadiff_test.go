@@ -0,0 +1,144 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const diffSchemaOld = `
+type Todo implements Node {
+  id: ID!
+  text: String!
+  priority: Int
+  blob: String @deprecated
+  parent: Todo
+}
+enum TodoStatus {
+  IN_PROGRESS
+  COMPLETED
+  UNKNOWN @deprecated
+}
+input TodoWhereInput {
+  text: String
+  priority: Int
+}
+type Category {
+  id: ID!
+}
+extend type Query {
+  todos(first: Int, last: Int = 10, where: TodoWhereInput): [Todo!]!
+}
+`
+
+const diffSchemaNew = `
+type Todo implements Node {
+  id: ID!
+  text: String
+  priority: Int!
+  parent: Todo
+  done: Boolean!
+}
+enum TodoStatus {
+  IN_PROGRESS
+  DONE
+}
+input TodoWhereInput {
+  text: String!
+  priority: Int
+  done: Boolean!
+  status: TodoStatus
+}
+type Query {
+  todos(first: Int!, last: Int = 20, orderBy: String, after: String!): [Todo!]!
+}
+`
+
+func writeSchema(t *testing.T, schema string) string {
+	path := filepath.Join(t.TempDir(), "ent.graphql")
+	require.NoError(t, ioutil.WriteFile(path, []byte(schema), 0644))
+	return path
+}
+
+func TestDiffSchema(t *testing.T) {
+	from, err := loadSchemaFile(writeSchema(t, diffSchemaOld))
+	require.NoError(t, err)
+	to, err := loadSchemaFile(writeSchema(t, diffSchemaNew))
+	require.NoError(t, err)
+	changes := DiffSchema(from, to)
+	var got []string
+	for _, c := range changes {
+		got = append(got, c.String())
+	}
+	require.Equal(t, []string{
+		"breaking: Type Category was removed.",
+		"breaking: Required argument Query.todos.after was added.",
+		"breaking: Argument Query.todos.first changed type from Int to Int!.",
+		"dangerous: Argument Query.todos.last changed its default value.",
+		"safe: Argument Query.todos.orderBy was added.",
+		"breaking: Argument Query.todos.where was removed.",
+		"dangerous: Deprecated field Todo.blob was removed.",
+		"safe: Field Todo.done was added.",
+		"breaking: Field Todo.text changed type from String! to String.",
+		"breaking: Enum value TodoStatus.COMPLETED was removed.",
+		"dangerous: Enum value TodoStatus.DONE was added.",
+		"dangerous: Deprecated enum value TodoStatus.UNKNOWN was removed.",
+		"breaking: Required field TodoWhereInput.done was added.",
+		"safe: Field TodoWhereInput.status was added.",
+		"breaking: Field TodoWhereInput.text changed type from String to String!.",
+	}, got)
+}
+
+func TestCheckBreakingChanges(t *testing.T) {
+	to, err := loadSchemaFile(writeSchema(t, diffSchemaNew))
+	require.NoError(t, err)
+	require.NoError(t, checkBreakingChanges(filepath.Join(t.TempDir(), "ent.graphql"), to, nil))
+
+	path := writeSchema(t, diffSchemaNew)
+	require.NoError(t, checkBreakingChanges(path, to, nil))
+
+	path = writeSchema(t, `
+type Todo {
+  id: ID!
+  text: String!
+  blob: String @deprecated(reason: "Use text instead.")
+}
+`)
+	to = &ast.Schema{Types: map[string]*ast.Definition{
+		"Todo": {
+			Kind: ast.Object,
+			Name: "Todo",
+			Fields: ast.FieldList{
+				{Name: "id", Type: ast.NonNullNamedType("ID", nil)},
+			},
+		},
+	}}
+	err = checkBreakingChanges(path, to, nil)
+	var berr *BreakingChangesError
+	require.True(t, errors.As(err, &berr))
+	require.Len(t, berr.Changes, 1)
+	require.Equal(t, "Todo.text", berr.Changes[0].Path)
+	require.EqualError(t, err, "entgql: breaking changes in "+path+":\n\tField Todo.text was removed.")
+	require.NoError(t, checkBreakingChanges(path, to, map[string]bool{"Todo.text": true}))
+
+	path = writeSchema(t, "type Todo {")
+	require.Error(t, checkBreakingChanges(path, to, nil))
+}
@@ -0,0 +1,32 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import "context"
+
+type includeDeletedCtxKey struct{}
+
+// NewIncludeDeletedContext returns a new context that includes the nodes of the types
+// annotated with SoftDelete in the queries generated by entgql, even if they were deleted.
+func NewIncludeDeletedContext(parent context.Context) context.Context {
+	return context.WithValue(parent, includeDeletedCtxKey{}, true)
+}
+
+// IncludeDeletedFromContext reports if the given context was
+// created by NewIncludeDeletedContext.
+func IncludeDeletedFromContext(ctx context.Context) bool {
+	include, _ := ctx.Value(includeDeletedCtxKey{}).(bool)
+	return include
+}
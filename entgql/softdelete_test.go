@@ -0,0 +1,31 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql_test
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/contrib/entgql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIncludeDeletedContext(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	require.False(t, entgql.IncludeDeletedFromContext(ctx))
+	ctx = entgql.NewIncludeDeletedContext(ctx)
+	require.True(t, entgql.IncludeDeletedFromContext(ctx))
+}
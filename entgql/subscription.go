@@ -0,0 +1,158 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// SubscriptionEvent describes the type of change published for a node.
+type SubscriptionEvent string
+
+// List of events published by the generated subscription hook.
+const (
+	SubscriptionCreated SubscriptionEvent = "created"
+	SubscriptionUpdated SubscriptionEvent = "updated"
+	SubscriptionDeleted SubscriptionEvent = "deleted"
+)
+
+// SubscriptionTopic returns the pub/sub topic used for publishing
+// the events of the given type. e.g. "Todo:created".
+func SubscriptionTopic(typ string, ev SubscriptionEvent) string {
+	return typ + ":" + string(ev)
+}
+
+// PubSub represents types that can publish and deliver messages. It is used
+// by the generated subscription hook for publishing the ids of the changed
+// nodes, and by the generated subscription resolvers for receiving them.
+type PubSub interface {
+	// Publish sends the message to all subscribers of the topic.
+	Publish(ctx context.Context, topic string, msg interface{}) error
+	// Subscribe returns a channel of the messages published to the topic.
+	// The channel is closed when the given context is done.
+	Subscribe(ctx context.Context, topic string) (<-chan interface{}, error)
+}
+
+// MemoryPubSub is an in-process implementation of the PubSub interface.
+// It is suitable for servers running in a single process, like the
+// gqlgen websocket transport with a single instance.
+type MemoryPubSub struct {
+	mu     sync.RWMutex
+	topics map[string]map[*memorySubscriber]struct{}
+}
+
+type memorySubscriber struct {
+	ch chan interface{}
+	// mu guards the channel from being closed while a message is sent.
+	mu     sync.Mutex
+	closed bool
+}
+
+// send buffers the message without blocking, and reports
+// if the subscriber is closed or the message was buffered.
+func (s *memorySubscriber) send(msg interface{}) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return true
+	}
+	select {
+	case s.ch <- msg:
+		return true
+	default:
+		return false
+	}
+}
+
+// close closes the channel of the subscriber, if it was not closed before.
+func (s *memorySubscriber) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.closed {
+		s.closed = true
+		close(s.ch)
+	}
+}
+
+var _ PubSub = (*MemoryPubSub)(nil)
+
+// memorySubscriberBuffer is the buffer size of the subscribers' channels.
+const memorySubscriberBuffer = 16
+
+// NewMemoryPubSub returns a new in-process pub/sub.
+func NewMemoryPubSub() *MemoryPubSub {
+	return &MemoryPubSub{
+		topics: make(map[string]map[*memorySubscriber]struct{}),
+	}
+}
+
+// Publish sends the message to all subscribers of the topic without blocking.
+// Subscribers that do not keep up with the published messages (i.e. their
+// buffer is full) are unsubscribed, and their channels are closed.
+func (p *MemoryPubSub) Publish(ctx context.Context, topic string, msg interface{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	p.mu.RLock()
+	subs := make([]*memorySubscriber, 0, len(p.topics[topic]))
+	for s := range p.topics[topic] {
+		subs = append(subs, s)
+	}
+	p.mu.RUnlock()
+	for _, s := range subs {
+		if !s.send(msg) {
+			p.unsubscribe(topic, s)
+		}
+	}
+	return nil
+}
+
+// Subscribe returns a channel of the messages published to the topic.
+// The channel is closed when the given context is done, or when the
+// subscriber does not keep up with the published messages.
+func (p *MemoryPubSub) Subscribe(ctx context.Context, topic string) (<-chan interface{}, error) {
+	if ctx.Done() == nil {
+		return nil, errors.New("entgql: subscription context must be cancelable")
+	}
+	s := &memorySubscriber{
+		ch: make(chan interface{}, memorySubscriberBuffer),
+	}
+	p.mu.Lock()
+	if p.topics[topic] == nil {
+		p.topics[topic] = make(map[*memorySubscriber]struct{})
+	}
+	p.topics[topic][s] = struct{}{}
+	p.mu.Unlock()
+	go func() {
+		<-ctx.Done()
+		p.unsubscribe(topic, s)
+	}()
+	return s.ch, nil
+}
+
+// unsubscribe removes the subscriber from the topic and closes its channel.
+func (p *MemoryPubSub) unsubscribe(topic string, s *memorySubscriber) {
+	p.mu.Lock()
+	if subs, ok := p.topics[topic]; ok {
+		delete(subs, s)
+		if len(subs) == 0 {
+			delete(p.topics, topic)
+		}
+	}
+	p.mu.Unlock()
+	s.close()
+}
@@ -0,0 +1,103 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscriptionTopic(t *testing.T) {
+	require.Equal(t, "Todo:created", entgql.SubscriptionTopic("Todo", entgql.SubscriptionCreated))
+	require.Equal(t, "Todo:updated", entgql.SubscriptionTopic("Todo", entgql.SubscriptionUpdated))
+	require.Equal(t, "Todo:deleted", entgql.SubscriptionTopic("Todo", entgql.SubscriptionDeleted))
+}
+
+func TestMemoryPubSub(t *testing.T) {
+	t.Parallel()
+	ps := entgql.NewMemoryPubSub()
+	_, err := ps.Subscribe(context.Background(), "topic")
+	require.Error(t, err, "context must be cancelable")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch1, err := ps.Subscribe(ctx, "topic")
+	require.NoError(t, err)
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	ch2, err := ps.Subscribe(ctx2, "topic")
+	require.NoError(t, err)
+	other, err := ps.Subscribe(ctx, "other")
+	require.NoError(t, err)
+
+	require.NoError(t, ps.Publish(context.Background(), "topic", 1))
+	require.Equal(t, 1, receive(t, ch1))
+	require.Equal(t, 1, receive(t, ch2))
+	select {
+	case msg := <-other:
+		t.Fatalf("unexpected message on other topic: %v", msg)
+	default:
+	}
+
+	// Canceling the subscription context closes its channel.
+	cancel2()
+	select {
+	case _, ok := <-ch2:
+		require.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("expect channel to be closed")
+	}
+	require.NoError(t, ps.Publish(context.Background(), "topic", 2))
+	require.Equal(t, 2, receive(t, ch1))
+}
+
+func TestMemoryPubSub_SlowSubscriber(t *testing.T) {
+	t.Parallel()
+	ps := entgql.NewMemoryPubSub()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	slow, err := ps.Subscribe(ctx, "topic")
+	require.NoError(t, err)
+	fast, err := ps.Subscribe(ctx, "topic")
+	require.NoError(t, err)
+	// Publishing does not block on the subscriber that never reads,
+	// and it is unsubscribed once its buffer is full.
+	for i := 0; i < 20; i++ {
+		require.NoError(t, ps.Publish(context.Background(), "topic", i))
+		require.Equal(t, i, receive(t, fast))
+	}
+	for i := 0; i < 16; i++ {
+		require.Equal(t, i, receive(t, slow))
+	}
+	_, ok := <-slow
+	require.False(t, ok, "expect the slow subscriber to be closed")
+
+	pctx, pcancel := context.WithCancel(context.Background())
+	pcancel()
+	require.ErrorIs(t, ps.Publish(pctx, "topic", 20), context.Canceled)
+}
+
+func receive(t *testing.T, ch <-chan interface{}) interface{} {
+	select {
+	case msg := <-ch:
+		return msg
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for message")
+		return nil
+	}
+}
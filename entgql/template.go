@@ -19,8 +19,10 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path"
 	"path/filepath"
 	"reflect"
+	"sort"
 	"strings"
 	"text/template"
 	"text/template/parse"
@@ -59,6 +61,43 @@ var (
 	// MutationInputTemplate adds a template for generating Create<T>Input and Update<T>Input for each schema type.
 	MutationInputTemplate = parseT("template/mutation_input.tmpl").SkipIf(skipMutationTemplate)
 
+	// MutationResolverTemplate adds a template for generating the resolvers of the mutation fields
+	// defined by the MutationFields annotation.
+	MutationResolverTemplate = parseT("template/mutation_resolver.tmpl").SkipIf(skipMutationResolverTemplate)
+
+	// SubscriptionTemplate adds a template for generating the hook and the resolvers
+	// of the subscription fields defined by the Subscriptions annotation.
+	SubscriptionTemplate = parseT("template/subscription.tmpl").SkipIf(skipSubscriptionTemplate)
+
+	// AggregateTemplate adds a template for generating the aggregation types
+	// and query methods of the types annotated with the Aggregate annotation.
+	AggregateTemplate = parseT("template/aggregate.tmpl").SkipIf(skipAggregateTemplate)
+
+	// InterfaceTemplate adds a template for generating the Go interfaces, and the pagination
+	// of their implementations, of the GraphQL interfaces defined by the Interface annotation.
+	InterfaceTemplate = parseT("template/interface.tmpl").SkipIf(skipInterfaceTemplate)
+
+	// ComplexityTemplate adds a template for generating the complexity functions of the
+	// Relay connection fields. See ComplexityLimit for more information.
+	ComplexityTemplate = parseT("template/complexity.tmpl").SkipIf(skipComplexityTemplate)
+
+	// AuthorizeTemplate adds a template for generating the implementation of the @authorize
+	// directive used by the Authorize annotation. See AuthorizePolicy for more information.
+	AuthorizeTemplate = parseT("template/authorize.tmpl").SkipIf(skipAuthorizeTemplate)
+
+	// FederationTemplate adds a template for generating the Go methods of the Apollo Federation
+	// entities. The template is added to the code generation by the WithFederation option.
+	FederationTemplate = parseT("template/federation.tmpl")
+
+	// CursorTemplate adds a template for generating the codec of the pagination cursors.
+	// The template is added to the code generation by the WithCursorCodec option.
+	CursorTemplate = parseT("template/cursor.tmpl")
+
+	// ClientTemplate adds a template for generating a typed Go client of the GraphQL schema
+	// in the gqlclient package. The template is added to the code generation by the WithGoClient
+	// option. See entgql.Client for more information.
+	ClientTemplate = parseT("template/client.tmpl")
+
 	// AllTemplates holds all templates for extending ent to support GraphQL.
 	AllTemplates = []*gen.Template{
 		CollectionTemplate,
@@ -68,24 +107,48 @@ var (
 		TransactionTemplate,
 		EdgeTemplate,
 		MutationInputTemplate,
+		MutationResolverTemplate,
+		SubscriptionTemplate,
+		ComplexityTemplate,
+		AggregateTemplate,
+		AuthorizeTemplate,
+		InterfaceTemplate,
 	}
 
 	// TemplateFuncs contains the extra template functions used by entgql.
 	TemplateFuncs = template.FuncMap{
+		"aggregateFields":     aggregateFields,
+		"aggregateNodes":      aggregateNodes,
+		"authorizeRules":      authorizeRules,
+		"clientFields":        clientFields,
+		"clientQuery":         clientQuery,
+		"paginatedFields":     paginatedFields,
+		"edgeOrderFields":     edgeOrderFields,
 		"fieldCollections":    fieldCollections,
+		"fieldProjections":    fieldProjections,
 		"filterEdges":         filterEdges,
 		"filterFields":        filterFields,
 		"filterNodes":         filterNodes,
 		"gqlIDType":           gqlIDType,
 		"gqlMarshaler":        gqlMarshaler,
 		"gqlUnmarshaler":      gqlUnmarshaler,
+		"hasAuthorize":        hasAuthorize,
+		"hasOffsetPage":       hasOffsetPage,
+		"hasOrderFields":      hasOrderFields,
 		"hasWhereInput":       hasWhereInput,
+		"interfaces":          interfaces,
+		"isOffsetPage":        isOffsetPage,
 		"isRelayConn":         isRelayConn,
 		"isSkipMode":          isSkipMode,
+		"mutationFields":      mutationFields,
 		"mutationInputs":      mutationInputs,
 		"nodePaginationNames": nodePaginationNames,
 		"orderFields":         orderFields,
 		"skipMode":            skipModeFromString,
+		"softDeleteField":     softDeleteField,
+		"subscriptionNodes":   subscriptionNodes,
+		"whereOpImports":      whereOpImports,
+		"whereOps":            whereOps,
 	}
 
 	//go:embed template/*
@@ -189,10 +252,51 @@ func fieldCollections(edges []*gen.Edge) ([]*fieldCollection, error) {
 	return collect, nil
 }
 
+// fieldProjection describes how the collected GraphQL fields
+// of a type are projected to the columns of its query.
+type fieldProjection struct {
+	// Always holds the fields that are selected regardless of the collected fields,
+	// because they are used by the eager-loading and the pagination of the type.
+	Always []*gen.Field
+	// Fields holds the fields that are selected only if they were collected.
+	Fields []*projectedField
+}
+
+type projectedField struct {
+	Field   *gen.Field
+	Mapping []string
+}
+
+// fieldProjections returns the field projection of the given type.
+func fieldProjections(t *gen.Type) (*fieldProjection, error) {
+	p := &fieldProjection{}
+	for _, f := range t.Fields {
+		ant, err := annotation(f.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case f.IsEdgeField(), ant.OrderField != "", ant.AlwaysLoad:
+			p.Always = append(p.Always, f)
+		default:
+			mapping := []string{camel(f.Name)}
+			if mapping[0] != f.Name {
+				mapping = append(mapping, f.Name)
+			}
+			p.Fields = append(p.Fields, &projectedField{Field: f, Mapping: mapping})
+		}
+	}
+	return p, nil
+}
+
 // MutationDescriptor holds information about a GraphQL mutation input.
 type MutationDescriptor struct {
 	*gen.Type
 	IsCreate bool
+	// IsUpsert indicates the input is an Upsert<T>Input, that holds the fields of
+	// the create input, and ConflictFields holds the conflict target of the upsert.
+	IsUpsert       bool
+	ConflictFields []string
 }
 
 // Input returns the input's name.
@@ -201,14 +305,22 @@ func (m *MutationDescriptor) Input() (string, error) {
 	if err != nil {
 		return "", err
 	}
-	if m.IsCreate {
+	switch {
+	case m.IsUpsert:
+		return fmt.Sprintf("Upsert%sInput", gqlType), nil
+	case m.IsCreate:
 		return fmt.Sprintf("Create%sInput", gqlType), nil
+	default:
+		return fmt.Sprintf("Update%sInput", gqlType), nil
 	}
-	return fmt.Sprintf("Update%sInput", gqlType), nil
 }
 
 // Builders return the builder's names to apply the input.
+// The upsert input is applied by the UpsertInput method of the client.
 func (m *MutationDescriptor) Builders() []string {
+	if m.IsUpsert {
+		return nil
+	}
 	if m.IsCreate {
 		return []string{m.Type.CreateName()}
 	}
@@ -225,6 +337,9 @@ type InputFieldDescriptor struct {
 	Nullable bool
 	// ClearOp indicates if the field has the Clear operator
 	ClearOp bool
+	// Version indicates if the field holds the expected version
+	// of the node, configured by the OptimisticLock annotation.
+	Version bool
 }
 
 // IsPointer returns true if the Go type should be a pointer
@@ -234,8 +349,16 @@ func (f *InputFieldDescriptor) IsPointer() bool {
 
 // InputFields returns the list of fields in the input type.
 func (m *MutationDescriptor) InputFields() ([]*InputFieldDescriptor, error) {
+	version, err := versionField(m.Type)
+	if err != nil {
+		return nil, err
+	}
 	fields := make([]*InputFieldDescriptor, 0, len(m.Type.Fields))
 	for _, f := range m.Type.Fields {
+		if !m.IsCreate && f == version {
+			fields = append(fields, &InputFieldDescriptor{Field: f, Nullable: true, Version: true})
+			continue
+		}
 		ant, err := annotation(f.Annotations)
 		if err != nil {
 			return nil, err
@@ -248,7 +371,7 @@ func (m *MutationDescriptor) InputFields() ([]*InputFieldDescriptor, error) {
 
 		fields = append(fields, &InputFieldDescriptor{
 			Field:    f,
-			Nullable: !m.IsCreate || f.Optional || f.Default || f.DefaultFunc(),
+			Nullable: (!m.IsCreate || f.Optional || f.Default || f.DefaultFunc()) && !m.isConflictField(f),
 			ClearOp:  !m.IsCreate && f.Optional,
 		})
 	}
@@ -256,11 +379,74 @@ func (m *MutationDescriptor) InputFields() ([]*InputFieldDescriptor, error) {
 	return fields, nil
 }
 
+// isConflictField reports if the given field is part of the conflict target of the upsert input.
+func (m *MutationDescriptor) isConflictField(f *gen.Field) bool {
+	for _, name := range m.ConflictFields {
+		if name == f.Name {
+			return m.IsUpsert
+		}
+	}
+	return false
+}
+
+// ConflictTarget returns the fields of the conflict target of the upsert input.
+// The fields must be a unique field of the type, or the fields of a unique index,
+// and they must be part of the upsert input.
+func (m *MutationDescriptor) ConflictTarget() ([]*gen.Field, error) {
+	if !m.IsUpsert {
+		return nil, nil
+	}
+	if len(m.ConflictFields) == 0 {
+		return nil, fmt.Errorf("entgql: MutationUpsert of %s requires the fields of the conflict target", m.Type.Name)
+	}
+	if enabled, err := m.Type.FeatureEnabled(gen.FeatureUpsert.Name); err != nil || !enabled {
+		return nil, fmt.Errorf("entgql: MutationUpsert of %s requires the %q feature flag", m.Type.Name, gen.FeatureUpsert.Name)
+	}
+	inputs, err := m.InputFields()
+	if err != nil {
+		return nil, err
+	}
+	target := make([]*gen.Field, 0, len(m.ConflictFields))
+	columns := make(map[string]bool, len(m.ConflictFields))
+	for _, name := range m.ConflictFields {
+		var field *gen.Field
+		for _, f := range inputs {
+			if f.Name == name {
+				field = f.Field
+			}
+		}
+		if field == nil {
+			return nil, fmt.Errorf("entgql: conflict field %s.%s was not found in the upsert input", m.Type.Name, name)
+		}
+		target = append(target, field)
+		columns[field.StorageKey()] = true
+	}
+	if len(target) == 1 && target[0].Unique {
+		return target, nil
+	}
+	for _, idx := range m.Type.Indexes {
+		if !idx.Unique || len(idx.Columns) != len(columns) {
+			continue
+		}
+		match := true
+		for _, c := range idx.Columns {
+			match = match && columns[c]
+		}
+		if match {
+			return target, nil
+		}
+	}
+	return nil, fmt.Errorf("entgql: conflict target %v of %s must be a unique field or the fields of a unique index", m.ConflictFields, m.Type.Name)
+}
+
 // InputEdges returns the list of fields in the input type.
 //
 // NOTE(giautm): This method should refactor to
 // return a list of InputFieldDescriptor.
 func (m *MutationDescriptor) InputEdges() ([]*gen.Edge, error) {
+	if m.IsUpsert {
+		return nil, nil
+	}
 	edges := make([]*gen.Edge, 0, len(m.Type.Edges))
 	for _, e := range m.Type.Edges {
 		ant, err := annotation(e.Annotations)
@@ -276,6 +462,63 @@ func (m *MutationDescriptor) InputEdges() ([]*gen.Edge, error) {
 	return edges, nil
 }
 
+// NestedEdgeDescriptor holds information about an edge whose
+// neighbors can be created together with the node in the input type.
+type NestedEdgeDescriptor struct {
+	*gen.Edge
+	// Input is the name of the input type for creating the edge neighbors.
+	Input string
+}
+
+// StructField returns the name of the field in the Go input type.
+func (e *NestedEdgeDescriptor) StructField() string {
+	return "Create" + pascal(e.Name)
+}
+
+// FieldName returns the name of the field in the GraphQL input type.
+func (e *NestedEdgeDescriptor) FieldName() string {
+	return camel("create_" + e.Name)
+}
+
+// NestedEdges returns the list of edges in the input type
+// whose neighbors can be created together with the node.
+func (m *MutationDescriptor) NestedEdges() ([]*NestedEdgeDescriptor, error) {
+	edges, err := m.InputEdges()
+	if err != nil {
+		return nil, err
+	}
+	var nested []*NestedEdgeDescriptor
+	for _, e := range edges {
+		if !m.IsNested(e) {
+			continue
+		}
+		ant, err := annotation(e.Type.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		var hasCreate bool
+		for _, i := range ant.MutationInputs {
+			hasCreate = hasCreate || (i.IsCreate && !i.IsUpsert && !ant.Skip.Is(SkipMutationCreateInput))
+		}
+		if !hasCreate {
+			return nil, fmt.Errorf("entgql: NestedCreate on edge %s.%s requires the create input of %s", m.Type.Name, e.Name, e.Type.Name)
+		}
+		input, err := (&MutationDescriptor{Type: e.Type, IsCreate: true}).Input()
+		if err != nil {
+			return nil, err
+		}
+		nested = append(nested, &NestedEdgeDescriptor{Edge: e, Input: input})
+	}
+	return nested, nil
+}
+
+// IsNested reports if the neighbors of the given edge
+// can be created together with the node in the input type.
+func (m *MutationDescriptor) IsNested(e *gen.Edge) bool {
+	ant, err := annotation(e.Annotations)
+	return err == nil && ant.NestedCreate
+}
+
 // mutationInputs returns the list of input types for the mutation.
 func mutationInputs(nodes []*gen.Type) ([]*MutationDescriptor, error) {
 	filteredNodes := make([]*MutationDescriptor, 0, len(nodes))
@@ -292,19 +535,146 @@ func mutationInputs(nodes []*gen.Type) ([]*MutationDescriptor, error) {
 			}
 
 			filteredNodes = append(filteredNodes, &MutationDescriptor{
-				Type:     n,
-				IsCreate: a.IsCreate,
+				Type:           n,
+				IsCreate:       a.IsCreate,
+				IsUpsert:       a.IsUpsert,
+				ConflictFields: a.ConflictFields,
 			})
 		}
 	}
 	return filteredNodes, nil
 }
 
+// MutationFieldDescriptor holds information about a GraphQL field in the Mutation object.
+type MutationFieldDescriptor struct {
+	*gen.Type
+	// Op is the operation executed by the field.
+	Op MutationFieldOp
+	// Name is the name of the field in the Mutation object.
+	Name string
+	// Bulk indicates the field mutates multiple nodes (e.g. createTodos).
+	Bulk bool
+	// Directives to add on the field.
+	Directives []Directive
+}
+
+// MutationFieldOp describes the operation executed by a mutation field.
+type MutationFieldOp string
+
+// List of operations executed by the mutation fields.
+const (
+	MutationFieldCreate MutationFieldOp = "create"
+	MutationFieldUpdate MutationFieldOp = "update"
+	MutationFieldDelete MutationFieldOp = "delete"
+	MutationFieldUpsert MutationFieldOp = "upsert"
+)
+
+// IsCreate reports if the field creates a new node.
+func (m *MutationFieldDescriptor) IsCreate() bool { return m.Op == MutationFieldCreate }
+
+// IsUpdate reports if the field updates a node.
+func (m *MutationFieldDescriptor) IsUpdate() bool { return m.Op == MutationFieldUpdate }
+
+// IsDelete reports if the field deletes a node.
+func (m *MutationFieldDescriptor) IsDelete() bool { return m.Op == MutationFieldDelete }
+
+// IsUpsert reports if the field creates or updates a node.
+func (m *MutationFieldDescriptor) IsUpsert() bool { return m.Op == MutationFieldUpsert }
+
+// Method returns the name of the resolver method.
+func (m *MutationFieldDescriptor) Method() string {
+	return pascal(m.Name)
+}
+
+// Input returns the name of the input type used by the field.
+// An empty string is returned for the delete<T> field.
+func (m *MutationFieldDescriptor) Input() (string, error) {
+	if m.IsDelete() {
+		return "", nil
+	}
+	return (&MutationDescriptor{Type: m.Type, IsCreate: m.IsCreate() || m.IsUpsert(), IsUpsert: m.IsUpsert()}).Input()
+}
+
+// nodeMutationFields returns the list of mutation fields of the node.
+func nodeMutationFields(t *gen.Type) ([]*MutationFieldDescriptor, error) {
+	gqlType, ant, err := gqlTypeFromNode(t)
+	if err != nil {
+		return nil, err
+	}
+	if ant.MutationFields == nil || ant.Skip.Is(SkipType) {
+		return nil, nil
+	}
+	var hasCreate, hasUpdate, hasUpsert bool
+	for _, i := range ant.MutationInputs {
+		switch {
+		case i.IsUpsert:
+			hasUpsert = hasUpsert || !ant.Skip.Is(SkipMutationCreateInput)
+		case i.IsCreate:
+			hasCreate = hasCreate || !ant.Skip.Is(SkipMutationCreateInput)
+		default:
+			hasUpdate = hasUpdate || !ant.Skip.Is(SkipMutationUpdateInput)
+		}
+	}
+	// The bulk update and delete fields filter the nodes using the WhereInput of the type.
+	hasWhere := !ant.Skip.Is(SkipWhereInput)
+	var fields []*MutationFieldDescriptor
+	for _, f := range []struct {
+		op      MutationFieldOp
+		cfg     *FieldConfig
+		bulk    bool
+		enabled bool
+	}{
+		{op: MutationFieldCreate, cfg: ant.MutationFields.Create, enabled: hasCreate},
+		{op: MutationFieldUpdate, cfg: ant.MutationFields.Update, enabled: hasUpdate},
+		{op: MutationFieldDelete, cfg: ant.MutationFields.Delete, enabled: true},
+		{op: MutationFieldUpsert, cfg: ant.MutationFields.Upsert, enabled: hasUpsert},
+		{op: MutationFieldCreate, cfg: ant.MutationFields.CreateMany, bulk: true, enabled: hasCreate},
+		{op: MutationFieldUpdate, cfg: ant.MutationFields.UpdateMany, bulk: true, enabled: hasUpdate && hasWhere},
+		{op: MutationFieldDelete, cfg: ant.MutationFields.DeleteMany, bulk: true, enabled: hasWhere},
+	} {
+		if f.cfg == nil || !f.enabled {
+			continue
+		}
+		name := f.cfg.Name
+		switch {
+		case name != "":
+		case f.bulk:
+			name = string(f.op) + plural(gqlType)
+		default:
+			name = string(f.op) + gqlType
+		}
+		fields = append(fields, &MutationFieldDescriptor{
+			Type:       t,
+			Op:         f.op,
+			Name:       name,
+			Bulk:       f.bulk,
+			Directives: f.cfg.Directives,
+		})
+	}
+	return fields, nil
+}
+
+// mutationFields returns the list of mutation fields of all nodes.
+func mutationFields(nodes []*gen.Type) ([]*MutationFieldDescriptor, error) {
+	var fields []*MutationFieldDescriptor
+	for _, n := range nodes {
+		if n.HasCompositeID() {
+			continue
+		}
+		nodeFields, err := nodeMutationFields(n)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, nodeFields...)
+	}
+	return fields, nil
+}
+
 // filterNodes filters out nodes that should not be included in the GraphQL schema.
 func filterNodes(nodes []*gen.Type, skip SkipMode) ([]*gen.Type, error) {
 	filteredNodes := make([]*gen.Type, 0, len(nodes))
 	for _, n := range nodes {
-		if n.IsEdgeSchema() {
+		if n.HasCompositeID() {
 			continue
 		}
 		ant, err := annotation(n.Annotations)
@@ -318,11 +688,341 @@ func filterNodes(nodes []*gen.Type, skip SkipMode) ([]*gen.Type, error) {
 	return filteredNodes, nil
 }
 
+// subscriptionNodes returns the nodes annotated with the Subscriptions annotation.
+func subscriptionNodes(nodes []*gen.Type) ([]*gen.Type, error) {
+	filteredNodes := make([]*gen.Type, 0, len(nodes))
+	for _, n := range nodes {
+		if n.HasCompositeID() {
+			continue
+		}
+		ant, err := annotation(n.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		if ant.Subscriptions && !ant.Skip.Is(SkipType) {
+			filteredNodes = append(filteredNodes, n)
+		}
+	}
+	return filteredNodes, nil
+}
+
+// InterfaceDescriptor describes a GraphQL interface that is
+// generated from the Interface annotations of its types.
+type InterfaceDescriptor struct {
+	InterfaceConfig
+	// Nodes are the types that implement the interface.
+	Nodes []*gen.Type
+}
+
+// NodeFields returns the id field of the given type, followed
+// by its fields that are declared by the interface.
+func (i *InterfaceDescriptor) NodeFields(t *gen.Type) ([]*gen.Field, error) {
+	fields := []*gen.Field{t.ID}
+	for _, name := range i.Fields {
+		var field *gen.Field
+		for _, f := range t.Fields {
+			if f.Name == name {
+				field = f
+				break
+			}
+		}
+		if field == nil {
+			return nil, fmt.Errorf("entgql: type %s does not have the field %q of the interface %s", t.Name, name, i.Name)
+		}
+		ant, err := annotation(field.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		if ant.Skip.Is(SkipType) {
+			return nil, fmt.Errorf("entgql: field %s.%s of the interface %s cannot be skipped", t.Name, name, i.Name)
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// AuthorizeRules returns the Authorize rules of the types that implement the
+// interface, which are used for authorizing its connection field. The types
+// are expected to be authorized by the same rules.
+func (i *InterfaceDescriptor) AuthorizeRules() ([]string, error) {
+	var rules []string
+	for j, n := range i.Nodes {
+		r, err := authorizeRules(n)
+		if err != nil {
+			return nil, err
+		}
+		if j > 0 && !reflect.DeepEqual(rules, r) {
+			return nil, fmt.Errorf("entgql: types %s and %s of the interface %s are authorized by different rules", i.Nodes[0].Name, n.Name, i.Name)
+		}
+		rules = r
+	}
+	return rules, nil
+}
+
+// interfaces returns the GraphQL interfaces that are defined by the Interface annotations
+// of the given types. An interface is expected to have the same fields in all its types.
+func interfaces(nodes []*gen.Type) ([]*InterfaceDescriptor, error) {
+	var (
+		list   []*InterfaceDescriptor
+		byName = make(map[string]*InterfaceDescriptor)
+	)
+	for _, n := range nodes {
+		if n.HasCompositeID() {
+			continue
+		}
+		ant, err := annotation(n.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		if ant.Skip.Is(SkipType) {
+			continue
+		}
+		for _, c := range ant.Interfaces {
+			d, ok := byName[c.Name]
+			switch {
+			case !ok:
+				d = &InterfaceDescriptor{InterfaceConfig: c}
+				byName[c.Name] = d
+				list = append(list, d)
+			case !reflect.DeepEqual(d.Fields, c.Fields):
+				return nil, fmt.Errorf("entgql: interface %s is defined with different fields by %s and %s", c.Name, d.Nodes[0].Name, n.Name)
+			case d.QueryField == nil:
+				d.QueryField = c.QueryField
+			}
+			if _, err := d.NodeFields(n); err != nil {
+				return nil, err
+			}
+			d.Nodes = append(d.Nodes, n)
+		}
+	}
+	return list, nil
+}
+
+// authorizeRules returns the rules of the Authorize annotation of the given type.
+func authorizeRules(t *gen.Type) ([]string, error) {
+	ant, err := annotation(t.Annotations)
+	if err != nil {
+		return nil, err
+	}
+	return ant.Authorize, nil
+}
+
+// softDeleteField returns the field of the SoftDelete annotation of
+// the given type, or nil if the type is not annotated with it.
+func softDeleteField(t *gen.Type) (*gen.Field, error) {
+	ant, err := annotation(t.Annotations)
+	if err != nil || ant.SoftDelete == "" {
+		return nil, err
+	}
+	for _, f := range t.Fields {
+		if f.Name != ant.SoftDelete {
+			continue
+		}
+		if !f.Optional {
+			return nil, fmt.Errorf("entgql: soft-delete field %s.%s must be optional", t.Name, f.Name)
+		}
+		return f, nil
+	}
+	return nil, fmt.Errorf("entgql: soft-delete field %s.%s was not found", t.Name, ant.SoftDelete)
+}
+
+// versionField returns the field of the OptimisticLock annotation of
+// the given type, or nil if the type is not annotated with it.
+func versionField(t *gen.Type) (*gen.Field, error) {
+	ant, err := annotation(t.Annotations)
+	if err != nil || ant.OptimisticLock == "" {
+		return nil, err
+	}
+	for _, f := range t.Fields {
+		if f.Name != ant.OptimisticLock {
+			continue
+		}
+		switch {
+		case f.Type == nil || !(f.IsTime() || f.Type.Numeric() && !f.Type.Type.Float()):
+			return nil, fmt.Errorf("entgql: version field %s.%s must be an integer or a time field", t.Name, f.Name)
+		case f.Optional || f.Immutable || f.IsEdgeField():
+			return nil, fmt.Errorf("entgql: version field %s.%s must be required and mutable", t.Name, f.Name)
+		}
+		return f, nil
+	}
+	return nil, fmt.Errorf("entgql: version field %s.%s was not found", t.Name, ant.OptimisticLock)
+}
+
+// hasAuthorize reports if the Authorize annotation is
+// used by any of the given nodes, fields or edges.
+func hasAuthorize(nodes []*gen.Type) (bool, error) {
+	for _, n := range nodes {
+		if n.HasCompositeID() {
+			continue
+		}
+		annotations := []gen.Annotations{n.Annotations}
+		for _, f := range allFields(n) {
+			annotations = append(annotations, f.Annotations)
+		}
+		for _, e := range n.Edges {
+			annotations = append(annotations, e.Annotations)
+		}
+		for _, a := range annotations {
+			ant, err := annotation(a)
+			if err != nil {
+				return false, err
+			}
+			if len(ant.Authorize) > 0 {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// aggregateNodes returns the nodes annotated with the Aggregate annotation.
+func aggregateNodes(nodes []*gen.Type) ([]*gen.Type, error) {
+	filteredNodes := make([]*gen.Type, 0, len(nodes))
+	for _, n := range nodes {
+		if n.HasCompositeID() {
+			continue
+		}
+		ant, err := annotation(n.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		if ant.Aggregate != nil && !ant.Skip.Is(SkipType) {
+			filteredNodes = append(filteredNodes, n)
+		}
+	}
+	return filteredNodes, nil
+}
+
+// AggregateFields holds the fields used by the aggregation types of a node.
+type AggregateFields struct {
+	// Group holds the fields that aggregations can be grouped by.
+	Group []*GroupField
+	// Numeric holds the fields that are aggregated by the numeric
+	// aggregation functions (i.e. sum, avg, min and max).
+	Numeric []*gen.Field
+}
+
+// GroupField is a field that aggregations can be grouped by.
+type GroupField struct {
+	*gen.Field
+	// Value is the value of the field in the <T>GroupField enum.
+	Value string
+}
+
+// aggregateFields returns the fields used by the aggregation types of the given node.
+// Fields that are protected by the Authorize annotation are not aggregated, as their
+// values would be exposed through the aggregation types without being authorized.
+func aggregateFields(t *gen.Type) (*AggregateFields, error) {
+	fields := &AggregateFields{}
+	for _, f := range t.Fields {
+		ant, err := annotation(f.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		if ant.GroupBy && !f.Type.Comparable() {
+			return nil, fmt.Errorf("entgql: group-by field %s.%s must be comparable", t.Name, f.Name)
+		}
+		if ant.Skip.Is(SkipType) || len(ant.Authorize) > 0 || f.Sensitive() || !f.Type.Comparable() {
+			continue
+		}
+		if ant.GroupBy {
+			fields.Group = append(fields.Group, &GroupField{Field: f, Value: strings.ToUpper(snake(f.Name))})
+		}
+		if f.Type.Numeric() && !f.IsEdgeField() {
+			fields.Numeric = append(fields.Numeric, f)
+		}
+	}
+	return fields, nil
+}
+
+// PaginatedField is a Relay connection field, or an offset-paginated
+// field, that its complexity is computed by its pagination arguments.
+type PaginatedField struct {
+	Name string
+	// Page indicates if the field is offset-paginated.
+	Page bool
+}
+
+// paginatedFields returns the Relay connection (including the connections of the
+// interfaces) and the offset-paginated fields of the schema, keyed by the name of
+// the GraphQL object defining them.
+func paginatedFields(nodes []*gen.Type) (map[string][]*PaginatedField, error) {
+	fields := make(map[string][]*PaginatedField)
+	for _, n := range nodes {
+		if n.HasCompositeID() {
+			continue
+		}
+		gqlType, ant, err := gqlTypeFromNode(n)
+		if err != nil {
+			return nil, err
+		}
+		if ant.Skip.Is(SkipType) {
+			continue
+		}
+		if ant.RelayConnection && ant.QueryField != nil {
+			fields["Query"] = append(fields["Query"], &PaginatedField{Name: ant.QueryField.fieldName(gqlType)})
+		}
+		if ant.OffsetPagination != nil {
+			name := ant.OffsetPagination.Name
+			if name == "" {
+				name = camel(plural(gqlType)) + "Page"
+			}
+			fields["Query"] = append(fields["Query"], &PaginatedField{Name: name, Page: true})
+		}
+		for _, e := range n.Edges {
+			if e.Unique || e.Type.HasCompositeID() {
+				continue
+			}
+			antE, err := annotation(e.Annotations)
+			if err != nil {
+				return nil, err
+			}
+			relayConn, err := isRelayConn(e)
+			if err != nil {
+				return nil, err
+			}
+			page := !relayConn && antE.OffsetPagination != nil
+			if !relayConn && !page || antE.Skip.Is(SkipType) {
+				continue
+			}
+			_, antT, err := gqlTypeFromNode(e.Type)
+			if err != nil {
+				return nil, err
+			}
+			if antT.Skip.Is(SkipType) {
+				continue
+			}
+			names := antE.Mapping
+			if len(names) == 0 {
+				names = []string{camel(e.Name)}
+			}
+			for _, name := range names {
+				fields[gqlType] = append(fields[gqlType], &PaginatedField{Name: name, Page: page})
+			}
+		}
+	}
+	list, err := interfaces(nodes)
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range list {
+		if i.QueryField != nil {
+			fields["Query"] = append(fields["Query"], &PaginatedField{Name: i.QueryField.fieldName(i.Name)})
+		}
+	}
+	for _, f := range fields {
+		sort.Slice(f, func(i, j int) bool {
+			return f[i].Name < f[j].Name
+		})
+	}
+	return fields, nil
+}
+
 // filterEdges filters out edges that should not be included in the GraphQL schema.
 func filterEdges(edges []*gen.Edge, skip SkipMode) ([]*gen.Edge, error) {
 	filteredEdges := make([]*gen.Edge, 0, len(edges))
 	for _, e := range edges {
-		if e.Type.IsEdgeSchema() {
+		if e.Type.HasCompositeID() {
 			continue
 		}
 		antE, err := annotation(e.Annotations)
@@ -355,6 +1055,123 @@ func filterFields(fields []*gen.Field, skip SkipMode) ([]*gen.Field, error) {
 	return filteredFields, nil
 }
 
+// whereOp is returned by the whereOps below to describe an additional
+// operator of a field in the WhereInput template.
+type whereOp struct {
+	WhereOp
+	// StructField is the name of the operator field in the WhereInput struct.
+	StructField string
+	// JSONName is the name of the operator field in the GraphQL schema.
+	JSONName string
+	// ValueType is the Go type of the operator field in the WhereInput struct.
+	ValueType string
+	// Deref indicates if the operator value is dereferenced
+	// before it is passed to the predicate function.
+	Deref bool
+	// Slice indicates if the operator value is a slice.
+	Slice bool
+	// FuncName is the name of the predicate function in the generated code.
+	FuncName string
+}
+
+// whereOps returns the additional WhereInput operators of the
+// given field, excluding the operators generated by ent.
+func whereOps(f *gen.Field) ([]*whereOp, error) {
+	ant, err := annotation(f.Annotations)
+	if err != nil {
+		return nil, err
+	}
+	if len(ant.WhereOps) == 0 {
+		return nil, nil
+	}
+	seen := make(map[string]bool)
+	if f.Type.Comparable() {
+		for _, op := range f.Ops() {
+			seen[op.Name()] = true
+		}
+	}
+	ops := make([]*whereOp, 0, len(ant.WhereOps))
+	for _, op := range ant.WhereOps {
+		if op.Name == "" || op.Func == "" {
+			return nil, fmt.Errorf("entgql: operator of field %q must define a name and a predicate function", f.Name)
+		}
+		if seen[op.Name] {
+			continue
+		}
+		seen[op.Name] = true
+		w := &whereOp{
+			WhereOp:     op,
+			StructField: f.StructField() + op.Name,
+			JSONName:    camel(f.Name + "_" + op.Name),
+			ValueType:   f.Type.String(),
+		}
+		if op.GoType != "" {
+			_, w.ValueType = goIdent(op.GoType)
+		}
+		_, w.FuncName = goIdent(op.Func)
+		switch {
+		case strings.HasPrefix(w.ValueType, "[]"):
+			w.Slice = true
+		case !strings.HasPrefix(w.ValueType, "*"):
+			w.ValueType = "*" + w.ValueType
+			w.Deref = true
+		}
+		ops = append(ops, w)
+	}
+	return ops, nil
+}
+
+// whereOpImports returns the packages imported by
+// the additional WhereInput operators of the nodes.
+func whereOpImports(nodes []*gen.Type) ([]string, error) {
+	nodes, err := filterNodes(nodes, SkipWhereInput)
+	if err != nil {
+		return nil, err
+	}
+	var imports []string
+	seen := make(map[string]bool)
+	for _, n := range nodes {
+		fields, err := filterFields(allFields(n), SkipWhereInput)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range fields {
+			ops, err := whereOps(f)
+			if err != nil {
+				return nil, err
+			}
+			for _, op := range ops {
+				for _, ident := range []string{op.GoType, op.Func} {
+					if pkg, _ := goIdent(ident); pkg != "" && !seen[pkg] {
+						seen[pkg] = true
+						imports = append(imports, pkg)
+					}
+				}
+			}
+		}
+	}
+	sort.Strings(imports)
+	return imports, nil
+}
+
+// goIdent splits the given qualified Go identifier or type into its
+// package path and its expression in the generated code. For example:
+//
+//	"example.com/pkg.Value"   => "example.com/pkg", "pkg.Value"
+//	"[]example.com/pkg.Value" => "example.com/pkg", "[]pkg.Value"
+//	"string"                  => "", "string"
+func goIdent(s string) (string, string) {
+	ident := strings.TrimLeft(s, "[]*")
+	prefix := s[:len(s)-len(ident)]
+	i := strings.LastIndexByte(ident, '/')
+	j := strings.IndexByte(ident[i+1:], '.')
+	if j == -1 {
+		return "", s
+	}
+	pkg := ident[:i+1+j]
+	return pkg, prefix + path.Base(pkg) + ident[i+1+j:]
+}
+
 // orderFields returns the fields of the given node with the `OrderField` annotation.
 func orderFields(n *gen.Type) ([]*gen.Field, error) {
 	var ordered []*gen.Field
@@ -374,6 +1191,89 @@ func orderFields(n *gen.Type) ([]*gen.Field, error) {
 	return ordered, nil
 }
 
+// EdgeOrderField describes an ordering field that is computed from
+// an edge of the node. e.g. the count of its neighbors, or a field of
+// its unique neighbor.
+type EdgeOrderField struct {
+	// Edge is the edge the ordering value is computed from.
+	Edge *gen.Edge
+	// Field is the neighbor field used for ordering. Nil
+	// in case the nodes are ordered by the neighbors count.
+	Field *gen.Field
+	// Name is the name of the ordering field in the GraphQL schema.
+	Name string
+}
+
+// IsCount reports if the nodes are ordered by the count of the edge neighbors.
+func (f *EdgeOrderField) IsCount() bool {
+	return f.Field == nil
+}
+
+// StructField returns the Go name suffix of the generated ordering field.
+func (f *EdgeOrderField) StructField() string {
+	if f.IsCount() {
+		return pascal(f.Edge.Name) + "Count"
+	}
+	return pascal(f.Edge.Name) + f.Field.StructField()
+}
+
+// edgeOrderFields returns the ordering fields of the given node that were
+// defined using the `OrderEdgeCount` and `OrderEdgeFields` annotations.
+func edgeOrderFields(n *gen.Type) ([]*EdgeOrderField, error) {
+	var ordered []*EdgeOrderField
+	for _, e := range n.Edges {
+		ant, err := annotation(e.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		if ant.Skip.Is(SkipOrderField) {
+			continue
+		}
+		prefix := strings.ToUpper(snake(e.Name))
+		if ant.OrderEdgeCount {
+			if e.Unique {
+				return nil, fmt.Errorf("entgql: count ordering is not supported on unique edge %s.%s", n.Name, e.Name)
+			}
+			ordered = append(ordered, &EdgeOrderField{Edge: e, Name: prefix + "_COUNT"})
+		}
+		seen := make(map[string]bool)
+		for _, name := range ant.OrderEdgeFields {
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			if !e.Unique {
+				return nil, fmt.Errorf("entgql: field ordering is supported only on unique edges, but %s.%s is not unique", n.Name, e.Name)
+			}
+			var f *gen.Field
+			for _, nf := range e.Type.Fields {
+				if nf.Name == name {
+					f = nf
+					break
+				}
+			}
+			switch {
+			case f == nil:
+				return nil, fmt.Errorf("entgql: ordered field %s.%s was not found on edge %s.%s", e.Type.Name, name, n.Name, e.Name)
+			case !f.Type.Comparable():
+				return nil, fmt.Errorf("entgql: ordered field %s.%s must be comparable", e.Type.Name, name)
+			}
+			ordered = append(ordered, &EdgeOrderField{Edge: e, Field: f, Name: prefix + "_" + strings.ToUpper(snake(f.Name))})
+		}
+	}
+	return ordered, nil
+}
+
+// hasOrderFields reports if the given node has any ordering fields.
+func hasOrderFields(n *gen.Type) (bool, error) {
+	fields, err := orderFields(n)
+	if err != nil || len(fields) > 0 {
+		return len(fields) > 0, err
+	}
+	edges, err := edgeOrderFields(n)
+	return len(edges) > 0, err
+}
+
 // hasWhereInput returns true if neither the edge nor its
 // node type has the SkipWhereInput annotation
 func hasWhereInput(n *gen.Edge) (v bool, err error) {
@@ -423,9 +1323,35 @@ func isRelayConn(e *gen.Edge) (bool, error) {
 	if err != nil {
 		return false, err
 	}
+	// Edges to edge schemas (e.g. User.friendships) are added by ent and cannot be
+	// annotated. Hence, they are Relay connections if the edge schema type is.
+	if !ant.RelayConnection && e.Type.IsEdgeSchema() {
+		ant, err = annotation(e.Type.Annotations)
+		if err != nil {
+			return false, err
+		}
+	}
 	return ant.RelayConnection, nil
 }
 
+// isOffsetPage reports if the edge is defined as an offset-paginated field.
+func isOffsetPage(e *gen.Edge) (bool, error) {
+	ant, err := annotation(e.Annotations)
+	if err != nil {
+		return false, err
+	}
+	return ant.OffsetPagination != nil, nil
+}
+
+// hasOffsetPage reports if the offset-based pagination is enabled for the type.
+func hasOffsetPage(t *gen.Type) (bool, error) {
+	ant, err := annotation(t.Annotations)
+	if err != nil {
+		return false, err
+	}
+	return ant.OffsetPagination != nil, nil
+}
+
 // PaginationNames holds the names of the pagination fields.
 type PaginationNames struct {
 	Connection string
@@ -433,7 +1359,12 @@ type PaginationNames struct {
 	Node       string
 	Order      string
 	OrderField string
+	Page       string
 	WhereInput string
+	// MultiOrder indicates the connections are ordered by a list of orders.
+	MultiOrder bool
+	// SoftDelete indicates the connections accept the includeDeleted argument.
+	SoftDelete bool
 }
 
 func (p *PaginationNames) TypeDefs() []*ast.Definition {
@@ -532,9 +1463,13 @@ func (p *PaginationNames) ConnectionField(name string, hasOrderBy, hasWhereInput
 		},
 	}
 	if hasOrderBy {
+		orderType := ast.NamedType(p.Order, nil)
+		if p.MultiOrder {
+			orderType = ast.ListType(ast.NonNullNamedType(p.Order, nil), nil)
+		}
 		def.Arguments = append(def.Arguments, &ast.ArgumentDefinition{
 			Name:        "orderBy",
-			Type:        ast.NamedType(p.Order, nil),
+			Type:        orderType,
 			Description: fmt.Sprintf("Ordering options for %s returned from the connection.", plural(p.Node)),
 		})
 	}
@@ -545,7 +1480,92 @@ func (p *PaginationNames) ConnectionField(name string, hasOrderBy, hasWhereInput
 			Description: fmt.Sprintf("Filtering options for %s returned from the connection.", plural(p.Node)),
 		})
 	}
+	if p.SoftDelete {
+		def.Arguments = append(def.Arguments, p.includeDeletedArg())
+	}
+
+	return def
+}
+
+// includeDeletedArg returns the definition of the includeDeleted argument.
+func (p *PaginationNames) includeDeletedArg() *ast.ArgumentDefinition {
+	return &ast.ArgumentDefinition{
+		Name:        "includeDeleted",
+		Type:        ast.NamedType("Boolean", nil),
+		Description: fmt.Sprintf("Includes the deleted %s in the result.", plural(p.Node)),
+	}
+}
 
+// PageTypeDef returns the definition of the <T>Page type.
+func (p *PaginationNames) PageTypeDef() *ast.Definition {
+	return &ast.Definition{
+		Name:        p.Page,
+		Kind:        ast.Object,
+		Description: fmt.Sprintf("A page of %s returned by offset-based pagination.", plural(p.Node)),
+		Fields: []*ast.FieldDefinition{
+			{
+				Name:        "nodes",
+				Type:        listNamedType(p.Node, false),
+				Description: "The items of the page.",
+			},
+			{
+				Name:        "hasNextPage",
+				Type:        ast.NonNullNamedType("Boolean", nil),
+				Description: "When paginating forwards, are there more items?",
+			},
+			{
+				Name:        "hasPreviousPage",
+				Type:        ast.NonNullNamedType("Boolean", nil),
+				Description: "When paginating backwards, are there more items?",
+			},
+			{
+				Name:        "totalCount",
+				Type:        ast.NonNullNamedType("Int", nil),
+				Description: "Identifies the total count of items.",
+			},
+		},
+	}
+}
+
+// PageField returns the definition of an offset-paginated field.
+func (p *PaginationNames) PageField(name string, hasOrderBy, hasWhereInput bool) *ast.FieldDefinition {
+	def := &ast.FieldDefinition{
+		Name: name,
+		Type: ast.NonNullNamedType(p.Page, nil),
+		Arguments: ast.ArgumentDefinitionList{
+			{
+				Name:        "offset",
+				Type:        ast.NamedType("Int", nil),
+				Description: "Skips the given number of elements.",
+			},
+			{
+				Name:        "limit",
+				Type:        ast.NamedType("Int", nil),
+				Description: "Returns up to _n_ elements.",
+			},
+		},
+	}
+	if hasOrderBy {
+		orderType := ast.NamedType(p.Order, nil)
+		if p.MultiOrder {
+			orderType = ast.ListType(ast.NonNullNamedType(p.Order, nil), nil)
+		}
+		def.Arguments = append(def.Arguments, &ast.ArgumentDefinition{
+			Name:        "orderBy",
+			Type:        orderType,
+			Description: fmt.Sprintf("Ordering options for %s returned from the page.", plural(p.Node)),
+		})
+	}
+	if hasWhereInput {
+		def.Arguments = append(def.Arguments, &ast.ArgumentDefinition{
+			Name:        "where",
+			Type:        ast.NamedType(p.WhereInput, nil),
+			Description: fmt.Sprintf("Filtering options for %s returned from the page.", plural(p.Node)),
+		})
+	}
+	if p.SoftDelete {
+		def.Arguments = append(def.Arguments, p.includeDeletedArg())
+	}
 	return def
 }
 
@@ -560,14 +1580,78 @@ func gqlTypeFromNode(t *gen.Type) (gqlType string, ant *Annotation, err error) {
 	return
 }
 
+// clientField describes a field that is selected by the
+// fragment of a type in the generated Go client.
+type clientField struct {
+	// Name is the name of the GraphQL field.
+	Name string
+	// StructField is the name of the Go field that holds its value.
+	StructField string
+}
+
+// clientFields returns the fields that are selected by the fragment of the type in the
+// generated Go client. JSON and Other fields that are mapped to custom GraphQL types are
+// skipped, because they may be mapped to object types that require a selection set.
+func clientFields(t *gen.Type) ([]*clientField, error) {
+	var fields []*clientField
+	for _, f := range allFields(t) {
+		ant, err := annotation(f.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		// JSON fields with custom types may be
+		// mapped to GraphQL objects, that require
+		// a selection set.
+		if ant.Skip.Is(SkipType) || f.IsOther() || f.IsJSON() && (ant.Type != "" || strings.ContainsRune(f.Type.Ident, '.')) {
+			continue
+		}
+		fields = append(fields, &clientField{Name: camel(f.Name), StructField: f.StructField()})
+	}
+	return fields, nil
+}
+
+// clientQueryField describes the connection field of a type
+// in the Query object that is used by the generated Go client.
+type clientQueryField struct {
+	*PaginationNames
+	// Name is the name of the field in the Query object.
+	Name string
+	// HasOrderBy and HasWhereInput indicate if the
+	// field accepts the orderBy and where arguments.
+	HasOrderBy, HasWhereInput bool
+}
+
+// clientQuery returns the connection field of the type in the Query
+// object, or nil if the type is not exposed as a Relay connection.
+func clientQuery(t *gen.Type) (*clientQueryField, error) {
+	gqlType, ant, err := gqlTypeFromNode(t)
+	if err != nil || !ant.RelayConnection || ant.QueryField == nil || ant.Skip.Is(SkipType) {
+		return nil, err
+	}
+	hasOrderBy, err := hasOrderFields(t)
+	if err != nil {
+		return nil, err
+	}
+	names := paginationNames(gqlType)
+	names.MultiOrder = ant.MultiOrder
+	return &clientQueryField{
+		PaginationNames: names,
+		Name:            ant.QueryField.fieldName(gqlType),
+		HasOrderBy:      hasOrderBy && !ant.Skip.Is(SkipOrderField),
+		HasWhereInput:   !ant.Skip.Is(SkipWhereInput),
+	}, nil
+}
+
 // nodePaginationNames returns the names of the pagination types for the node.
 func nodePaginationNames(t *gen.Type) (*PaginationNames, error) {
-	node, _, err := gqlTypeFromNode(t)
+	node, ant, err := gqlTypeFromNode(t)
 	if err != nil {
 		return nil, err
 	}
-
-	return paginationNames(node), nil
+	names := paginationNames(node)
+	names.MultiOrder = ant.MultiOrder
+	names.SoftDelete = ant.SoftDelete != ""
+	return names, nil
 }
 
 func paginationNames(node string) *PaginationNames {
@@ -577,6 +1661,7 @@ func paginationNames(node string) *PaginationNames {
 		Node:       node,
 		Order:      fmt.Sprintf("%sOrder", node),
 		OrderField: fmt.Sprintf("%sOrderField", node),
+		Page:       fmt.Sprintf("%sPage", node),
 		WhereInput: fmt.Sprintf("%sWhereInput", node),
 	}
 }
@@ -634,3 +1719,33 @@ func skipMutationTemplate(g *gen.Graph) bool {
 	}
 	return true
 }
+
+func skipMutationResolverTemplate(g *gen.Graph) bool {
+	fields, err := mutationFields(g.Nodes)
+	return err != nil || len(fields) == 0
+}
+
+func skipAggregateTemplate(g *gen.Graph) bool {
+	nodes, err := aggregateNodes(g.Nodes)
+	return err != nil || len(nodes) == 0
+}
+
+func skipInterfaceTemplate(g *gen.Graph) bool {
+	list, err := interfaces(g.Nodes)
+	return err != nil || len(list) == 0
+}
+
+func skipAuthorizeTemplate(g *gen.Graph) bool {
+	ok, err := hasAuthorize(g.Nodes)
+	return err != nil || !ok
+}
+
+func skipComplexityTemplate(g *gen.Graph) bool {
+	fields, err := paginatedFields(g.Nodes)
+	return err != nil || len(fields) == 0
+}
+
+func skipSubscriptionTemplate(g *gen.Graph) bool {
+	nodes, err := subscriptionNodes(g.Nodes)
+	return err != nil || len(nodes) == 0
+}
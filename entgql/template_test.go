@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
 	"github.com/stretchr/testify/require"
 )
 
@@ -218,3 +219,517 @@ func TestFilterFields(t *testing.T) {
 		},
 	}, fields)
 }
+
+func TestMutationFields(t *testing.T) {
+	nodes := []*gen.Type{
+		{
+			Name: "Todo",
+			Annotations: map[string]interface{}{
+				annotationName: Annotation{
+					MutationInputs: []MutationConfig{{IsCreate: true}, {}},
+					MutationFields: &MutationFieldsConfig{
+						Create:     &FieldConfig{Name: "addTodo"},
+						Update:     &FieldConfig{},
+						Delete:     &FieldConfig{},
+						UpdateMany: &FieldConfig{},
+						DeleteMany: &FieldConfig{Name: "purgeTodos"},
+					},
+				},
+			},
+		},
+		{
+			Name: "User",
+			Annotations: map[string]interface{}{
+				annotationName: Annotation{
+					MutationInputs: []MutationConfig{{IsCreate: true}},
+					MutationFields: &MutationFieldsConfig{
+						Create:     &FieldConfig{},
+						Update:     &FieldConfig{},
+						CreateMany: &FieldConfig{},
+						UpdateMany: &FieldConfig{},
+					},
+				},
+			},
+		},
+		{
+			Name: "Group",
+			Annotations: map[string]interface{}{
+				annotationName: Annotation{
+					MutationInputs: []MutationConfig{{IsCreate: true}},
+				},
+			},
+		},
+		{
+			Name: "Pet",
+			Annotations: map[string]interface{}{
+				annotationName: Annotation{
+					Skip:           SkipWhereInput,
+					MutationInputs: []MutationConfig{{IsCreate: true}},
+					MutationFields: &MutationFieldsConfig{
+						CreateMany: &FieldConfig{},
+						DeleteMany: &FieldConfig{},
+					},
+				},
+			},
+		},
+		{
+			Name: "Tag",
+			Annotations: map[string]interface{}{
+				annotationName: Annotation{
+					MutationInputs: []MutationConfig{{IsCreate: true, IsUpsert: true, ConflictFields: []string{"name"}}},
+					MutationFields: &MutationFieldsConfig{
+						Create: &FieldConfig{},
+						Upsert: &FieldConfig{},
+					},
+				},
+			},
+		},
+	}
+	fields, err := mutationFields(nodes)
+	require.NoError(t, err)
+	require.Len(t, fields, 9)
+	for i, f := range []struct {
+		op     MutationFieldOp
+		name   string
+		method string
+		input  string
+		bulk   bool
+	}{
+		{MutationFieldCreate, "addTodo", "AddTodo", "CreateTodoInput", false},
+		{MutationFieldUpdate, "updateTodo", "UpdateTodo", "UpdateTodoInput", false},
+		{MutationFieldDelete, "deleteTodo", "DeleteTodo", "", false},
+		{MutationFieldUpdate, "updateTodos", "UpdateTodos", "UpdateTodoInput", true},
+		{MutationFieldDelete, "purgeTodos", "PurgeTodos", "", true},
+		{MutationFieldCreate, "createUser", "CreateUser", "CreateUserInput", false},
+		{MutationFieldCreate, "createUsers", "CreateUsers", "CreateUserInput", true},
+		{MutationFieldCreate, "createPets", "CreatePets", "CreatePetInput", true},
+		{MutationFieldUpsert, "upsertTag", "UpsertTag", "UpsertTagInput", false},
+	} {
+		require.Equal(t, f.op, fields[i].Op)
+		require.Equal(t, f.bulk, fields[i].Bulk)
+		require.Equal(t, f.name, fields[i].Name)
+		require.Equal(t, f.method, fields[i].Method())
+		input, err := fields[i].Input()
+		require.NoError(t, err)
+		require.Equal(t, f.input, input)
+	}
+}
+
+func TestFieldProjections(t *testing.T) {
+	todo := &gen.Type{
+		Name: "Todo",
+		Fields: []*gen.Field{
+			{Name: "text"},
+			{Name: "created_at", Annotations: map[string]interface{}{annotationName: OrderField("CREATED_AT")}},
+			{Name: "blob", Annotations: map[string]interface{}{annotationName: AlwaysLoad()}},
+			{Name: "due_date"},
+		},
+	}
+	p, err := fieldProjections(todo)
+	require.NoError(t, err)
+	require.Equal(t, []*gen.Field{todo.Fields[1], todo.Fields[2]}, p.Always)
+	require.Equal(t, []*projectedField{
+		{Field: todo.Fields[0], Mapping: []string{"text"}},
+		{Field: todo.Fields[3], Mapping: []string{"dueDate", "due_date"}},
+	}, p.Fields)
+}
+
+func TestNestedEdges(t *testing.T) {
+	nested := map[string]interface{}{
+		annotationName: Annotation{NestedCreate: true},
+	}
+	category := &gen.Type{Name: "Category"}
+	todo := &gen.Type{
+		Name: "Todo",
+		Annotations: map[string]interface{}{
+			annotationName: Annotation{MutationInputs: []MutationConfig{{IsCreate: true}}},
+		},
+	}
+	todo.Edges = []*gen.Edge{
+		{Name: "children", Type: todo, Annotations: nested},
+		{Name: "parent", Type: todo, Unique: true},
+	}
+	edges, err := (&MutationDescriptor{Type: todo, IsCreate: true}).NestedEdges()
+	require.NoError(t, err)
+	require.Len(t, edges, 1)
+	require.Equal(t, "children", edges[0].Name)
+	require.Equal(t, "CreateTodoInput", edges[0].Input)
+	require.Equal(t, "CreateChildren", edges[0].StructField())
+	require.Equal(t, "createChildren", edges[0].FieldName())
+
+	todo.Edges = append(todo.Edges, &gen.Edge{Name: "category", Type: category, Unique: true, Annotations: nested})
+	_, err = (&MutationDescriptor{Type: todo, IsCreate: true}).NestedEdges()
+	require.EqualError(t, err, "entgql: NestedCreate on edge Todo.category requires the create input of Category")
+}
+
+func TestHasAuthorize(t *testing.T) {
+	authorize := map[string]interface{}{
+		annotationName: Annotation{Authorize: []string{"admin"}},
+	}
+	todo := &gen.Type{Name: "Todo"}
+	ok, err := hasAuthorize([]*gen.Type{todo})
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	for _, n := range []*gen.Type{
+		{Name: "Todo", Annotations: authorize},
+		{Name: "Todo", Fields: []*gen.Field{{Name: "text", Annotations: authorize}}},
+		{Name: "Todo", Edges: []*gen.Edge{{Name: "owner", Annotations: authorize}}},
+	} {
+		ok, err := hasAuthorize([]*gen.Type{todo, n})
+		require.NoError(t, err)
+		require.True(t, ok)
+	}
+
+	rules, err := authorizeRules(&gen.Type{Name: "Todo", Annotations: authorize})
+	require.NoError(t, err)
+	require.Equal(t, []string{"admin"}, rules)
+	rules, err = authorizeRules(todo)
+	require.NoError(t, err)
+	require.Empty(t, rules)
+}
+
+func TestSoftDeleteField(t *testing.T) {
+	todo := &gen.Type{
+		Name: "Todo",
+		Fields: []*gen.Field{
+			{Name: "text"},
+			{Name: "deleted_at", Optional: true},
+		},
+	}
+	f, err := softDeleteField(todo)
+	require.NoError(t, err)
+	require.Nil(t, f)
+
+	todo.Annotations = map[string]interface{}{annotationName: SoftDelete("deleted_at")}
+	f, err = softDeleteField(todo)
+	require.NoError(t, err)
+	require.Equal(t, todo.Fields[1], f)
+
+	todo.Annotations = map[string]interface{}{annotationName: SoftDelete("text")}
+	_, err = softDeleteField(todo)
+	require.EqualError(t, err, "entgql: soft-delete field Todo.text must be optional")
+
+	todo.Annotations = map[string]interface{}{annotationName: SoftDelete("removed_at")}
+	_, err = softDeleteField(todo)
+	require.EqualError(t, err, "entgql: soft-delete field Todo.removed_at was not found")
+}
+
+func TestInterfaces(t *testing.T) {
+	newType := func(name string, ant interface{}) *gen.Type {
+		return &gen.Type{
+			Name: name,
+			ID:   &gen.Field{Name: "id", Type: &field.TypeInfo{Type: field.TypeInt}},
+			Fields: []*gen.Field{
+				{Name: "created_at", Type: &field.TypeInfo{Type: field.TypeTime}},
+			},
+			Annotations: map[string]interface{}{annotationName: ant},
+		}
+	}
+	todo := newType("Todo", Interface("Timestamped", "created_at"))
+	user := newType("User", Interface("Timestamped", "created_at").QueryField())
+	group := newType("Group", Annotation{})
+	list, err := interfaces([]*gen.Type{group, todo, user})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Equal(t, "Timestamped", list[0].Name)
+	require.Equal(t, []*gen.Type{todo, user}, list[0].Nodes)
+	require.NotNil(t, list[0].QueryField)
+	fields, err := list[0].NodeFields(user)
+	require.NoError(t, err)
+	require.Equal(t, []*gen.Field{user.ID, user.Fields[0]}, fields)
+
+	group = newType("Group", Interface("Timestamped", "updated_at"))
+	_, err = interfaces([]*gen.Type{group})
+	require.EqualError(t, err, `entgql: type Group does not have the field "updated_at" of the interface Timestamped`)
+	_, err = interfaces([]*gen.Type{todo, group})
+	require.EqualError(t, err, "entgql: interface Timestamped is defined with different fields by Todo and Group")
+	group = newType("Group", Interface("Timestamped", "created_at").Merge(Skip()))
+	list, err = interfaces([]*gen.Type{todo, group})
+	require.NoError(t, err)
+	require.Equal(t, []*gen.Type{todo}, list[0].Nodes)
+}
+
+func TestPaginatedFields(t *testing.T) {
+	user := &gen.Type{
+		Name: "User",
+		ID:   &gen.Field{Name: "id", Type: &field.TypeInfo{Type: field.TypeInt}},
+		Fields: []*gen.Field{
+			{Name: "created_at", Type: &field.TypeInfo{Type: field.TypeTime}},
+		},
+		Annotations: map[string]interface{}{
+			annotationName: Annotation{
+				RelayConnection:  true,
+				QueryField:       &FieldConfig{},
+				OffsetPagination: &FieldConfig{},
+				Interfaces: []InterfaceConfig{
+					{Name: "Timestamped", Fields: []string{"created_at"}, QueryField: &FieldConfig{Name: "timestamped"}},
+				},
+			},
+		},
+	}
+	user.Edges = []*gen.Edge{
+		{Name: "friends", Type: user, Annotations: map[string]interface{}{annotationName: OffsetPagination()}},
+		{Name: "followers", Type: user, Annotations: map[string]interface{}{annotationName: RelayConnection()}},
+		{Name: "following", Type: user},
+		{Name: "manager", Type: user, Unique: true},
+	}
+	fields, err := paginatedFields([]*gen.Type{user})
+	require.NoError(t, err)
+	require.Equal(t, map[string][]*PaginatedField{
+		"Query": {
+			{Name: "timestamped"},
+			{Name: "users"},
+			{Name: "usersPage", Page: true},
+		},
+		"User": {
+			{Name: "followers"},
+			{Name: "friends", Page: true},
+		},
+	}, fields)
+}
+
+func TestAggregateFields(t *testing.T) {
+	todo := &gen.Type{
+		Name: "Todo",
+		Fields: []*gen.Field{
+			{Name: "text", Type: &field.TypeInfo{Type: field.TypeString}, Annotations: map[string]interface{}{annotationName: GroupBy()}},
+			{Name: "priority", Type: &field.TypeInfo{Type: field.TypeInt}, Annotations: map[string]interface{}{annotationName: GroupBy()}},
+			{Name: "cost", Type: &field.TypeInfo{Type: field.TypeInt}},
+			{Name: "budget", Type: &field.TypeInfo{Type: field.TypeFloat64}, Annotations: map[string]interface{}{annotationName: Authorize("admin").Merge(GroupBy())}},
+			{Name: "blob", Type: &field.TypeInfo{Type: field.TypeBytes}},
+		},
+	}
+	fields, err := aggregateFields(todo)
+	require.NoError(t, err)
+	require.Len(t, fields.Group, 2)
+	require.Equal(t, "TEXT", fields.Group[0].Value)
+	require.Equal(t, "PRIORITY", fields.Group[1].Value)
+	require.Equal(t, []*gen.Field{todo.Fields[1], todo.Fields[2]}, fields.Numeric)
+
+	todo.Fields[4].Annotations = map[string]interface{}{annotationName: GroupBy()}
+	_, err = aggregateFields(todo)
+	require.EqualError(t, err, "entgql: group-by field Todo.blob must be comparable")
+}
+
+func TestVersionField(t *testing.T) {
+	todo := &gen.Type{
+		Name: "Todo",
+		Fields: []*gen.Field{
+			{Name: "text", Type: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "version", Type: &field.TypeInfo{Type: field.TypeInt}},
+			{Name: "updated_at", Type: &field.TypeInfo{Type: field.TypeTime}, Optional: true},
+		},
+		Annotations: map[string]interface{}{annotationName: OptimisticLock("version")},
+	}
+	f, err := versionField(todo)
+	require.NoError(t, err)
+	require.Equal(t, todo.Fields[1], f)
+
+	fields, err := (&MutationDescriptor{Type: todo}).InputFields()
+	require.NoError(t, err)
+	require.Len(t, fields, 3)
+	require.True(t, fields[1].Version)
+	require.True(t, fields[1].IsPointer())
+
+	todo.Annotations = map[string]interface{}{annotationName: OptimisticLock("text")}
+	_, err = versionField(todo)
+	require.EqualError(t, err, "entgql: version field Todo.text must be an integer or a time field")
+
+	todo.Annotations = map[string]interface{}{annotationName: OptimisticLock("updated_at")}
+	_, err = versionField(todo)
+	require.EqualError(t, err, "entgql: version field Todo.updated_at must be required and mutable")
+
+	todo.Annotations = map[string]interface{}{annotationName: OptimisticLock("revision")}
+	_, err = versionField(todo)
+	require.EqualError(t, err, "entgql: version field Todo.revision was not found")
+}
+
+func TestConflictTarget(t *testing.T) {
+	tag := &gen.Type{
+		Name:   "Tag",
+		Config: &gen.Config{},
+		Fields: []*gen.Field{
+			{Name: "name", Type: &field.TypeInfo{Type: field.TypeString}, Unique: true, Default: true},
+			{Name: "owner", Type: &field.TypeInfo{Type: field.TypeString}, Default: true},
+			{Name: "slug", Type: &field.TypeInfo{Type: field.TypeString}, Optional: true},
+			{Name: "color", Type: &field.TypeInfo{Type: field.TypeString}, Default: true},
+		},
+		Indexes: []*gen.Index{{Unique: true, Columns: []string{"owner", "slug"}}},
+	}
+	upsert := func(fields ...string) *MutationDescriptor {
+		return &MutationDescriptor{Type: tag, IsCreate: true, IsUpsert: true, ConflictFields: fields}
+	}
+	_, err := upsert("name").ConflictTarget()
+	require.EqualError(t, err, `entgql: MutationUpsert of Tag requires the "sql/upsert" feature flag`)
+	tag.Config.Features = []gen.Feature{gen.FeatureUpsert}
+
+	target, err := upsert("name").ConflictTarget()
+	require.NoError(t, err)
+	require.Equal(t, []*gen.Field{tag.Fields[0]}, target)
+	target, err = upsert("slug", "owner").ConflictTarget()
+	require.NoError(t, err)
+	require.Equal(t, []*gen.Field{tag.Fields[2], tag.Fields[1]}, target)
+
+	// The conflict fields are required in the upsert input.
+	fields, err := upsert("slug", "owner").InputFields()
+	require.NoError(t, err)
+	require.Len(t, fields, 4)
+	require.False(t, fields[2].Nullable)
+	require.True(t, fields[3].Nullable)
+	name, err := upsert("name").Input()
+	require.NoError(t, err)
+	require.Equal(t, "UpsertTagInput", name)
+	require.Empty(t, upsert("name").Builders())
+
+	_, err = upsert().ConflictTarget()
+	require.EqualError(t, err, "entgql: MutationUpsert of Tag requires the fields of the conflict target")
+	_, err = upsert("color").ConflictTarget()
+	require.EqualError(t, err, "entgql: conflict target [color] of Tag must be a unique field or the fields of a unique index")
+	_, err = upsert("owner").ConflictTarget()
+	require.EqualError(t, err, "entgql: conflict target [owner] of Tag must be a unique field or the fields of a unique index")
+	_, err = upsert("id").ConflictTarget()
+	require.EqualError(t, err, "entgql: conflict field Tag.id was not found in the upsert input")
+}
+
+func TestWhereOps(t *testing.T) {
+	custom := WhereOp{Name: "Like", Type: "String", GoType: "string", Func: "example.com/pkg/predicates.Like"}
+	f := &gen.Field{
+		Name: "text",
+		Type: &field.TypeInfo{Type: field.TypeString},
+		Annotations: map[string]interface{}{
+			annotationName: WhereOps(OpContainsFold, custom, custom, OpValueEQ, WhereOp{Name: "Range", GoType: "[]int", Func: "example.com/pkg.Range"}),
+		},
+	}
+	ops, err := whereOps(f)
+	require.NoError(t, err)
+	require.Len(t, ops, 4)
+	for i, op := range []struct {
+		name, field, value, fn string
+		deref, slice           bool
+	}{
+		{"ContainsFold", "TextContainsFold", "*string", "entgql.ContainsFold", true, false},
+		{"Like", "TextLike", "*string", "predicates.Like", true, false},
+		{"ValueEQ", "TextValueEQ", "*entgql.JSONPathValue", "entgql.JSONValueEQ", true, false},
+		{"Range", "TextRange", "[]int", "pkg.Range", false, true},
+	} {
+		require.Equal(t, op.name, ops[i].Name)
+		require.Equal(t, op.field, ops[i].StructField)
+		require.Equal(t, camel("text_"+op.name), ops[i].JSONName)
+		require.Equal(t, op.value, ops[i].ValueType)
+		require.Equal(t, op.fn, ops[i].FuncName)
+		require.Equal(t, op.deref, ops[i].Deref)
+		require.Equal(t, op.slice, ops[i].Slice)
+	}
+
+	// Operators generated by ent are skipped.
+	f.Annotations[annotationName] = WhereOps(WhereOp{Name: "HasPrefix", Func: "example.com/pkg.HasPrefix"})
+	ops, err = whereOps(f)
+	require.NoError(t, err)
+	require.Empty(t, ops)
+
+	f.Annotations[annotationName] = WhereOps(WhereOp{Name: "Like"})
+	_, err = whereOps(f)
+	require.EqualError(t, err, `entgql: operator of field "text" must define a name and a predicate function`)
+
+	f.Annotations[annotationName] = WhereOps(custom, OpHasKey)
+	imports, err := whereOpImports([]*gen.Type{{Name: "Todo", Fields: []*gen.Field{f}}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"entgo.io/contrib/entgql", "example.com/pkg/predicates"}, imports)
+}
+
+func TestGoIdent(t *testing.T) {
+	for _, tt := range []struct {
+		in, pkg, expr string
+	}{
+		{"string", "", "string"},
+		{"[]int", "", "[]int"},
+		{"time.Time", "time", "time.Time"},
+		{"example.com/pkg.Value", "example.com/pkg", "pkg.Value"},
+		{"*example.com/pkg.Value", "example.com/pkg", "*pkg.Value"},
+		{"[]example.com/v1.2/pkg.Value", "example.com/v1.2/pkg", "[]pkg.Value"},
+	} {
+		pkg, expr := goIdent(tt.in)
+		require.Equal(t, tt.pkg, pkg, tt.in)
+		require.Equal(t, tt.expr, expr, tt.in)
+	}
+}
+
+func TestEdgeOrderFields(t *testing.T) {
+	category := &gen.Type{
+		Name: "Category",
+		Fields: []*gen.Field{
+			{Name: "text", Type: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "tags", Type: &field.TypeInfo{Type: field.TypeJSON}},
+		},
+	}
+	todo := &gen.Type{
+		Name: "Todo",
+		Edges: []*gen.Edge{
+			{
+				Name: "children",
+				Type: &gen.Type{Name: "Todo"},
+				Annotations: map[string]interface{}{
+					annotationName: map[string]interface{}{"OrderEdgeCount": true},
+				},
+			},
+			{
+				Name:   "category",
+				Type:   category,
+				Unique: true,
+				Annotations: map[string]interface{}{
+					annotationName: map[string]interface{}{"OrderEdgeFields": []string{"text", "text"}},
+				},
+			},
+			{
+				Name: "skipped",
+				Type: &gen.Type{Name: "Todo"},
+				Annotations: map[string]interface{}{
+					annotationName: map[string]interface{}{"OrderEdgeCount": true, "Skip": SkipOrderField},
+				},
+			},
+		},
+	}
+	fields, err := edgeOrderFields(todo)
+	require.NoError(t, err)
+	require.Len(t, fields, 2)
+	require.Equal(t, "CHILDREN_COUNT", fields[0].Name)
+	require.True(t, fields[0].IsCount())
+	require.Equal(t, "ChildrenCount", fields[0].StructField())
+	require.Equal(t, "CATEGORY_TEXT", fields[1].Name)
+	require.False(t, fields[1].IsCount())
+	require.Equal(t, "CategoryText", fields[1].StructField())
+
+	for _, tt := range []struct {
+		edge *gen.Edge
+		err  string
+	}{
+		{
+			edge: &gen.Edge{Name: "category", Type: category, Unique: true, Annotations: map[string]interface{}{
+				annotationName: map[string]interface{}{"OrderEdgeCount": true},
+			}},
+			err: "entgql: count ordering is not supported on unique edge Todo.category",
+		},
+		{
+			edge: &gen.Edge{Name: "categories", Type: category, Annotations: map[string]interface{}{
+				annotationName: map[string]interface{}{"OrderEdgeFields": []string{"text"}},
+			}},
+			err: "entgql: field ordering is supported only on unique edges, but Todo.categories is not unique",
+		},
+		{
+			edge: &gen.Edge{Name: "category", Type: category, Unique: true, Annotations: map[string]interface{}{
+				annotationName: map[string]interface{}{"OrderEdgeFields": []string{"name"}},
+			}},
+			err: "entgql: ordered field Category.name was not found on edge Todo.category",
+		},
+		{
+			edge: &gen.Edge{Name: "category", Type: category, Unique: true, Annotations: map[string]interface{}{
+				annotationName: map[string]interface{}{"OrderEdgeFields": []string{"tags"}},
+			}},
+			err: "entgql: ordered field Category.tags must be comparable",
+		},
+	} {
+		_, err := edgeOrderFields(&gen.Type{Name: "Todo", Edges: []*gen.Edge{tt.edge}})
+		require.EqualError(t, err, tt.err)
+	}
+}